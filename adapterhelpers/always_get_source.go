@@ -7,6 +7,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/overmindtech/aws-source/metrics"
+	"github.com/overmindtech/aws-source/tracing"
 	"github.com/overmindtech/discovery"
 	"github.com/overmindtech/sdp-go"
 	"github.com/overmindtech/sdpcache"
@@ -152,6 +154,12 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 }
 
 func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStruct, Options]) Get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
+	return ObserveGet(ctx, s.ItemType, scope, func(ctx context.Context) (*sdp.Item, error) {
+		return s.get(ctx, scope, query, ignoreCache)
+	})
+}
+
+func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStruct, Options]) get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
 	if scope != s.Scopes()[0] {
 		return nil, &sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -168,6 +176,7 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_GET, scope, s.ItemType, query, ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		return nil, qErr
 	}
@@ -198,6 +207,12 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 // List Lists all available items. This is done by running the ListFunc, then
 // passing these results to GetFunc in order to get the details
 func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStruct, Options]) ListStream(ctx context.Context, scope string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	ObserveStream(ctx, s.ItemType, scope, sdp.QueryMethod_LIST, stream, func(ctx context.Context, stream *discovery.QueryResultStream) {
+		s.listStream(ctx, scope, ignoreCache, stream)
+	})
+}
+
+func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStruct, Options]) listStream(ctx context.Context, scope string, ignoreCache bool, stream *discovery.QueryResultStream) {
 	if scope != s.Scopes()[0] {
 		stream.SendError(&sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -219,6 +234,7 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_LIST, scope, s.ItemType, "", ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		stream.SendError(qErr)
 		return
@@ -237,10 +253,12 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 	paginator := s.ListFuncPaginatorBuilder(s.Client, input)
 	var newGetInputs []GetInput
 
+	var page int
 	for paginator.HasMorePages() {
 		p := pool.New().WithContext(ctx).WithMaxGoroutines(s.MaxParallel.Value())
 
-		output, err := paginator.NextPage(ctx)
+		page++
+		output, err := paginator.NextPage(tracing.WithPage(ctx, page))
 
 		if err != nil {
 			err := WrapAWSError(err)
@@ -286,6 +304,12 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 
 // Search Searches for AWS resources by ARN
 func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStruct, Options]) SearchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	ObserveStream(ctx, s.ItemType, scope, sdp.QueryMethod_SEARCH, stream, func(ctx context.Context, stream *discovery.QueryResultStream) {
+		s.searchStream(ctx, scope, query, ignoreCache, stream)
+	})
+}
+
+func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStruct, Options]) searchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
 	if scope != s.Scopes()[0] {
 		stream.SendError(&sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -320,6 +344,7 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStruct, Options]) SearchCustom(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_SEARCH, scope, s.ItemType, query, ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		stream.SendError(qErr)
 		return
@@ -399,7 +424,7 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 		return
 	}
 
-	item, err := s.Get(ctx, scope, a.ResourceID(), ignoreCache)
+	item, err := s.get(ctx, scope, a.ResourceID(), ignoreCache)
 	if err != nil {
 		stream.SendError(WrapAWSError(err))
 		return
@@ -8,6 +8,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/overmindtech/aws-source/metrics"
+	"github.com/overmindtech/aws-source/tracing"
 	"github.com/overmindtech/discovery"
 	"github.com/overmindtech/sdp-go"
 	"github.com/overmindtech/sdpcache"
@@ -172,6 +174,12 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) Scopes() []s
 // this adapter to timeout or be cancelled when executing potentially
 // long-running actions
 func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) Get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
+	return ObserveGet(ctx, s.ItemType, scope, func(ctx context.Context) (*sdp.Item, error) {
+		return s.get(ctx, scope, query, ignoreCache)
+	})
+}
+
+func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
 	if scope != s.Scopes()[0] {
 		return nil, &sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -191,6 +199,7 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) Get(ctx cont
 
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_GET, scope, s.ItemType, query, ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		return nil, qErr
 	}
@@ -267,6 +276,12 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) Get(ctx cont
 
 // List Lists all items in a given scope
 func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) ListStream(ctx context.Context, scope string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	ObserveStream(ctx, s.ItemType, scope, sdp.QueryMethod_LIST, stream, func(ctx context.Context, stream *discovery.QueryResultStream) {
+		s.listStream(ctx, scope, ignoreCache, stream)
+	})
+}
+
+func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) listStream(ctx context.Context, scope string, ignoreCache bool, stream *discovery.QueryResultStream) {
 	if scope != s.Scopes()[0] {
 		stream.SendError(&sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -291,6 +306,7 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) ListStream(c
 
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_LIST, scope, s.ItemType, "", ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		stream.SendError(qErr)
 		return
@@ -314,6 +330,12 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) ListStream(c
 
 // Search Searches for AWS resources by ARN
 func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) SearchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	ObserveStream(ctx, s.ItemType, scope, sdp.QueryMethod_SEARCH, stream, func(ctx context.Context, stream *discovery.QueryResultStream) {
+		s.searchStream(ctx, scope, query, ignoreCache, stream)
+	})
+}
+
+func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) searchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
 	if scope != s.Scopes()[0] {
 		stream.SendError(&sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -358,7 +380,7 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) searchARN(ct
 	}
 
 	// this already uses the cache, so needs no extra handling
-	item, err := s.Get(ctx, scope, a.ResourceID(), ignoreCache)
+	item, err := s.get(ctx, scope, a.ResourceID(), ignoreCache)
 	if err != nil {
 		stream.SendError(err)
 		return
@@ -372,6 +394,7 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) searchCustom
 	// We need to cache here since this is the only place it'll be called
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_SEARCH, scope, s.ItemType, query, ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		stream.SendError(qErr)
 		return
@@ -417,8 +440,10 @@ func (s *DescribeOnlyAdapter[Input, Output, ClientStruct, Options]) describe(ctx
 	if s.Paginated() {
 		paginator := s.PaginatorBuilder(s.Client, input)
 
+		var page int
 		for paginator.HasMorePages() {
-			output, err := paginator.NextPage(ctx)
+			page++
+			output, err := paginator.NextPage(tracing.WithPage(ctx, page))
 			if err != nil {
 				stream.SendError(s.processError(err, ck))
 				return
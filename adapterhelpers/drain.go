@@ -0,0 +1,140 @@
+package adapterhelpers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+// drainCancelGrace How long to wait for queries to return once they have been
+// cancelled. Adapters should return promptly once their context is cancelled,
+// but we don't want to hang forever on one that doesn't
+const drainCancelGrace = 5 * time.Second
+
+// queryTracker Tracks in-flight queries so that the source can wait for them
+// to finish before shutting down
+type queryTracker struct {
+	mu sync.Mutex
+	// Set once draining starts. Queries are still accepted while draining
+	// since the engine is still subscribed to them
+	draining bool
+	// Set once draining has finished, after which queries are rejected
+	stopped bool
+	// Closed once draining and there are no queries running
+	idle     chan struct{}
+	idleOnce sync.Once
+	cancels  map[uint64]context.CancelFunc
+	nextID   uint64
+}
+
+func newQueryTracker() *queryTracker {
+	return &queryTracker{
+		idle:    make(chan struct{}),
+		cancels: make(map[uint64]context.CancelFunc),
+	}
+}
+
+// queries The in-flight queries for all adapters
+var queries = newQueryTracker()
+
+// start Registers a new in-flight query. This returns a context that will be
+// cancelled if the drain deadline passes, and a function that must be called
+// once the query has finished. If draining has finished then an error is
+// returned and the query must not be run
+func (t *queryTracker) start(ctx context.Context, scope string) (context.Context, func(), *sdp.QueryError) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopped {
+		return nil, nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "source is shutting down and is not accepting new queries",
+			Scope:       scope,
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	id := t.nextID
+	t.nextID++
+	t.cancels[id] = cancel
+
+	return ctx, func() {
+		t.mu.Lock()
+		delete(t.cancels, id)
+		t.checkIdle()
+		t.mu.Unlock()
+
+		cancel()
+	}, nil
+}
+
+// checkIdle Stops accepting queries and signals the drain once there are no
+// queries running. Must be called with the lock held
+func (t *queryTracker) checkIdle() {
+	if t.draining && len(t.cancels) == 0 {
+		t.stopped = true
+		t.idleOnce.Do(func() {
+			close(t.idle)
+		})
+	}
+}
+
+// drain Waits for in-flight queries to finish, including any that start while
+// draining, then stops accepting new queries. If `ctx` is done first, the
+// remaining queries are cancelled. Returns the number of queries that had to
+// be cancelled
+func (t *queryTracker) drain(ctx context.Context) int {
+	t.mu.Lock()
+	t.draining = true
+	t.checkIdle()
+	t.mu.Unlock()
+
+	select {
+	case <-t.idle:
+		return 0
+	case <-ctx.Done():
+	}
+
+	// Don't start anything else while the remaining queries are cancelled
+	t.mu.Lock()
+	t.stopped = true
+	cancelled := len(t.cancels)
+	for _, cancel := range t.cancels {
+		cancel()
+	}
+	t.mu.Unlock()
+
+	select {
+	case <-t.idle:
+	case <-time.After(drainCancelGrace):
+	}
+
+	return cancelled
+}
+
+// inFlightCount Returns the number of queries that are currently running
+func (t *queryTracker) inFlightCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return len(t.cancels)
+}
+
+// Drain Waits for in-flight queries to finish. The engine is still subscribed
+// while draining, so queries that arrive are run rather than failed, and are
+// waited for too. Once there are no queries running, new queries are rejected
+// since the engine is about to be stopped. If `ctx` is done before then, the
+// remaining queries are cancelled. Returns the number of queries that had to
+// be cancelled. This should be called before stopping the engine when the
+// source is shutting down
+func Drain(ctx context.Context) int {
+	return queries.drain(ctx)
+}
+
+// InFlightQueries Returns the number of queries that are currently being run
+// by adapters
+func InFlightQueries() int {
+	return queries.inFlightCount()
+}
@@ -0,0 +1,129 @@
+package adapterhelpers
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestQueryTrackerDrain(t *testing.T) {
+	t.Run("with no queries", func(t *testing.T) {
+		tracker := newQueryTracker()
+
+		cancelled := tracker.drain(context.Background())
+		if cancelled != 0 {
+			t.Errorf("expected 0 cancelled queries, got %v", cancelled)
+		}
+
+		_, _, qErr := tracker.start(context.Background(), "test")
+		if qErr == nil {
+			t.Error("expected an error starting a query once drained")
+		}
+	})
+
+	t.Run("with a query that finishes", func(t *testing.T) {
+		tracker := newQueryTracker()
+
+		_, done, qErr := tracker.start(context.Background(), "test")
+		if qErr != nil {
+			t.Fatal(qErr)
+		}
+
+		if tracker.inFlightCount() != 1 {
+			t.Errorf("expected 1 in-flight query, got %v", tracker.inFlightCount())
+		}
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			done()
+		}()
+
+		cancelled := tracker.drain(context.Background())
+		if cancelled != 0 {
+			t.Errorf("expected 0 cancelled queries, got %v", cancelled)
+		}
+
+		if tracker.inFlightCount() != 0 {
+			t.Errorf("expected 0 in-flight queries, got %v", tracker.inFlightCount())
+		}
+	})
+
+	t.Run("with a query that starts while draining", func(t *testing.T) {
+		tracker := newQueryTracker()
+
+		_, done, qErr := tracker.start(context.Background(), "test")
+		if qErr != nil {
+			t.Fatal(qErr)
+		}
+
+		drained := make(chan int)
+		go func() {
+			drained <- tracker.drain(context.Background())
+		}()
+
+		// Wait for draining to start
+		for {
+			tracker.mu.Lock()
+			draining := tracker.draining
+			tracker.mu.Unlock()
+
+			if draining {
+				break
+			}
+
+			time.Sleep(time.Millisecond)
+		}
+
+		// The engine is still subscribed so this should be run, not failed
+		_, lateDone, qErr := tracker.start(context.Background(), "test")
+		if qErr != nil {
+			t.Fatalf("expected query to be accepted while draining, got %v", qErr)
+		}
+
+		done()
+
+		select {
+		case <-drained:
+			t.Fatal("expected drain to wait for the query that started while draining")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		lateDone()
+
+		if cancelled := <-drained; cancelled != 0 {
+			t.Errorf("expected 0 cancelled queries, got %v", cancelled)
+		}
+
+		_, _, qErr = tracker.start(context.Background(), "test")
+		if qErr == nil {
+			t.Error("expected an error starting a query once drained")
+		}
+	})
+
+	t.Run("with a query that doesn't finish before the deadline", func(t *testing.T) {
+		tracker := newQueryTracker()
+
+		queryCtx, done, qErr := tracker.start(context.Background(), "test")
+		if qErr != nil {
+			t.Fatal(qErr)
+		}
+
+		// Simulate a query that only returns once it is cancelled
+		go func() {
+			<-queryCtx.Done()
+			done()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		cancelled := tracker.drain(ctx)
+		if cancelled != 1 {
+			t.Errorf("expected 1 cancelled query, got %v", cancelled)
+		}
+
+		if queryCtx.Err() == nil {
+			t.Error("expected query context to be cancelled")
+		}
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/overmindtech/aws-source/metrics"
+	"github.com/overmindtech/aws-source/tracing"
 	"github.com/overmindtech/discovery"
 	"github.com/overmindtech/sdp-go"
 	"github.com/overmindtech/sdpcache"
@@ -164,6 +166,12 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 // cache settings. It uses the defined `GetFunc`, `ItemMapper`, and
 // `ListTagsFunc` to retrieve and map the item.
 func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]) Get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
+	return ObserveGet(ctx, s.ItemType, scope, func(ctx context.Context) (*sdp.Item, error) {
+		return s.get(ctx, scope, query, ignoreCache)
+	})
+}
+
+func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]) get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
 	if !s.hasScope(scope) {
 		return nil, &sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -173,6 +181,7 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_GET, scope, s.ItemType, query, ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		return nil, qErr
 	}
@@ -215,6 +224,12 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 // List Lists all available items. This is done by running the ListFunc, then
 // passing these results to GetFunc in order to get the details
 func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]) ListStream(ctx context.Context, scope string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	ObserveStream(ctx, s.ItemType, scope, sdp.QueryMethod_LIST, stream, func(ctx context.Context, stream *discovery.QueryResultStream) {
+		s.listStream(ctx, scope, ignoreCache, stream)
+	})
+}
+
+func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]) listStream(ctx context.Context, scope string, ignoreCache bool, stream *discovery.QueryResultStream) {
 	if !s.hasScope(scope) {
 		stream.SendError(&sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -237,6 +252,7 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_LIST, scope, s.ItemType, "", ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		stream.SendError(qErr)
 		return
@@ -288,8 +304,10 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 	if s.ListFuncPaginatorBuilder != nil {
 		paginator := s.ListFuncPaginatorBuilder(s.Client, listInput)
 
+		var page int
 		for paginator.HasMorePages() {
-			out, err := paginator.NextPage(ctx)
+			page++
+			out, err := paginator.NextPage(tracing.WithPage(ctx, page))
 			if err != nil {
 				stream.SendError(WrapAWSError(err))
 				return
@@ -313,6 +331,12 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 // ARN and pass this to a Get request, or a custom search function that can be
 // used to search for items in a different, adapter-specific way
 func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]) SearchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	ObserveStream(ctx, s.ItemType, scope, sdp.QueryMethod_SEARCH, stream, func(ctx context.Context, stream *discovery.QueryResultStream) {
+		s.searchStream(ctx, scope, query, ignoreCache, stream)
+	})
+}
+
+func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]) searchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
 	if !s.hasScope(scope) {
 		stream.SendError(&sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -348,7 +372,7 @@ func (s *GetListAdapterV2[ListInput, ListOutput, AWSItem, ClientStruct, Options]
 
 	// Since this gits the Get method, and this method implements caching, we
 	// don't need to implement it here
-	item, err := s.Get(ctx, scope, a.ResourceID(), ignoreCache)
+	item, err := s.get(ctx, scope, a.ResourceID(), ignoreCache)
 
 	if err != nil {
 		stream.SendError(err)
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/overmindtech/aws-source/metrics"
 	"github.com/overmindtech/sdp-go"
 	"github.com/overmindtech/sdpcache"
 )
@@ -136,6 +137,12 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) hasScope(scope string)
 // cache settings. It uses the defined `GetFunc`, `ItemMapper`, and
 // `ListTagsFunc` to retrieve and map the item.
 func (s *GetListAdapter[AWSItem, ClientStruct, Options]) Get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
+	return ObserveGet(ctx, s.ItemType, scope, func(ctx context.Context) (*sdp.Item, error) {
+		return s.get(ctx, scope, query, ignoreCache)
+	})
+}
+
+func (s *GetListAdapter[AWSItem, ClientStruct, Options]) get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
 	if !s.hasScope(scope) {
 		return nil, &sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -145,6 +152,7 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) Get(ctx context.Context
 
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_GET, scope, s.ItemType, query, ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		return nil, qErr
 	}
@@ -187,6 +195,12 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) Get(ctx context.Context
 // List Lists all available items. This is done by running the ListFunc, then
 // passing these results to GetFunc in order to get the details
 func (s *GetListAdapter[AWSItem, ClientStruct, Options]) List(ctx context.Context, scope string, ignoreCache bool) ([]*sdp.Item, error) {
+	return ObserveItems(ctx, s.ItemType, scope, sdp.QueryMethod_LIST, func(ctx context.Context) ([]*sdp.Item, error) {
+		return s.list(ctx, scope, ignoreCache)
+	})
+}
+
+func (s *GetListAdapter[AWSItem, ClientStruct, Options]) list(ctx context.Context, scope string, ignoreCache bool) ([]*sdp.Item, error) {
 	if !s.hasScope(scope) {
 		return nil, &sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -200,6 +214,7 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) List(ctx context.Contex
 
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_LIST, scope, s.ItemType, "", ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		return nil, qErr
 	}
@@ -238,6 +253,12 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) List(ctx context.Contex
 // ARN and pass this to a Get request, or a custom search function that can be
 // used to search for items in a different, adapter-specific way
 func (s *GetListAdapter[AWSItem, ClientStruct, Options]) Search(ctx context.Context, scope string, query string, ignoreCache bool) ([]*sdp.Item, error) {
+	return ObserveItems(ctx, s.ItemType, scope, sdp.QueryMethod_SEARCH, func(ctx context.Context) ([]*sdp.Item, error) {
+		return s.search(ctx, scope, query, ignoreCache)
+	})
+}
+
+func (s *GetListAdapter[AWSItem, ClientStruct, Options]) search(ctx context.Context, scope string, query string, ignoreCache bool) ([]*sdp.Item, error) {
 	if !s.hasScope(scope) {
 		return nil, &sdp.QueryError{
 			ErrorType:   sdp.QueryError_NOSCOPE,
@@ -281,7 +302,7 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) SearchARN(ctx context.C
 
 	// Since this gits the Get method, and this method implements caching, we
 	// don't need to implement it here
-	item, err := s.Get(ctx, scope, a.ResourceID(), ignoreCache)
+	item, err := s.get(ctx, scope, a.ResourceID(), ignoreCache)
 
 	if err != nil {
 		return nil, WrapAWSError(err)
@@ -296,6 +317,7 @@ func (s *GetListAdapter[AWSItem, ClientStruct, Options]) SearchCustom(ctx contex
 	// We need to cache here since this is the only place it'll be called
 	s.ensureCache()
 	cacheHit, ck, cachedItems, qErr := s.cache.Lookup(ctx, s.Name(), sdp.QueryMethod_SEARCH, scope, s.ItemType, query, ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
 	if qErr != nil {
 		return nil, qErr
 	}
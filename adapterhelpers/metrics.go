@@ -0,0 +1,84 @@
+package adapterhelpers
+
+import (
+	"context"
+	"time"
+
+	"github.com/overmindtech/aws-source/metrics"
+	"github.com/overmindtech/discovery"
+	"github.com/overmindtech/sdp-go"
+)
+
+// ObserveGet Runs a GET query and records its metrics against the adapter's
+// type and scope. The query is tracked so that it can be drained on shutdown,
+// and won't be run at all once the source has finished draining
+func ObserveGet(ctx context.Context, itemType string, scope string, get func(ctx context.Context) (*sdp.Item, error)) (*sdp.Item, error) {
+	start := time.Now()
+
+	ctx, done, qErr := queries.start(ctx, scope)
+	if qErr != nil {
+		metrics.ObserveQueryError(itemType, scope, sdp.QueryMethod_GET, qErr)
+		return nil, qErr
+	}
+	defer done()
+
+	item, err := get(ctx)
+
+	metrics.ObserveQueryError(itemType, scope, sdp.QueryMethod_GET, err)
+	metrics.ObserveQuery(itemType, scope, sdp.QueryMethod_GET, time.Since(start))
+
+	return item, err
+}
+
+// ObserveItems Runs a non-streaming LIST or SEARCH query and records its
+// metrics against the adapter's type and scope. The query is tracked so that
+// it can be drained on shutdown
+func ObserveItems(ctx context.Context, itemType string, scope string, method sdp.QueryMethod, query func(ctx context.Context) ([]*sdp.Item, error)) ([]*sdp.Item, error) {
+	start := time.Now()
+
+	ctx, done, qErr := queries.start(ctx, scope)
+	if qErr != nil {
+		metrics.ObserveQueryError(itemType, scope, method, qErr)
+		return nil, qErr
+	}
+	defer done()
+
+	items, err := query(ctx)
+
+	metrics.ObserveQueryError(itemType, scope, method, err)
+	metrics.ObserveQuery(itemType, scope, method, time.Since(start))
+
+	return items, err
+}
+
+// ObserveStream Runs a streaming LIST or SEARCH query and records its metrics
+// against the adapter's type and scope. Since errors are sent on the stream
+// rather than returned, the query is given a stream that counts errors as they
+// pass through on their way to the original stream. The query is tracked so
+// that it can be drained on shutdown
+func ObserveStream(ctx context.Context, itemType string, scope string, method sdp.QueryMethod, stream *discovery.QueryResultStream, query func(ctx context.Context, stream *discovery.QueryResultStream)) {
+	start := time.Now()
+
+	ctx, done, qErr := queries.start(ctx, scope)
+	if qErr != nil {
+		metrics.ObserveQueryError(itemType, scope, method, qErr)
+		stream.SendError(qErr)
+		return
+	}
+	defer done()
+
+	observed := discovery.NewQueryResultStream(
+		stream.SendItem,
+		func(err error) {
+			metrics.ObserveQueryError(itemType, scope, method, err)
+			stream.SendError(err)
+		},
+	)
+
+	query(ctx, observed)
+
+	// Wait for everything to be passed through before recording the duration
+	observed.Close()
+
+	metrics.ObserveQuery(itemType, scope, method, time.Since(start))
+}
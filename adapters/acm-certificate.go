@@ -0,0 +1,269 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type acmClient interface {
+	DescribeCertificate(ctx context.Context, params *acm.DescribeCertificateInput, optFns ...func(*acm.Options)) (*acm.DescribeCertificateOutput, error)
+	ListCertificates(ctx context.Context, params *acm.ListCertificatesInput, optFns ...func(*acm.Options)) (*acm.ListCertificatesOutput, error)
+	ListTagsForCertificate(ctx context.Context, params *acm.ListTagsForCertificateInput, optFns ...func(*acm.Options)) (*acm.ListTagsForCertificateOutput, error)
+}
+
+// certificateInUseByLink Converts the ARN of a resource that is using a
+// certificate into a link to that resource
+func certificateInUseByLink(inUseBy string, scope string) []*sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(inUseBy)
+	if err != nil {
+		return nil
+	}
+
+	bp := &sdp.BlastPropagation{
+		// The resource using the certificate doesn't affect it
+		In: false,
+		// If the certificate expires or is deleted, the resource will break
+		Out: true,
+	}
+
+	switch a.Service {
+	case "elasticloadbalancing":
+		if strings.HasPrefix(a.Resource, "loadbalancer/app/") || strings.HasPrefix(a.Resource, "loadbalancer/net/") {
+			return []*sdp.LinkedItemQuery{{
+				Query: &sdp.Query{
+					Type:   "elbv2-load-balancer",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  inUseBy,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: bp,
+			}}
+		}
+
+		return []*sdp.LinkedItemQuery{{
+			Query: &sdp.Query{
+				Type:   "elb-load-balancer",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  inUseBy,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: bp,
+		}}
+	case "apigateway":
+		// API Gateway ARNs don't include the account e.g.
+		// arn:aws:apigateway:us-east-1::/domainnames/example.com
+		if name, found := strings.CutPrefix(a.Resource, "/domainnames/"); found {
+			accountID, _, err := adapterhelpers.ParseScope(scope)
+			if err != nil {
+				return nil
+			}
+
+			return []*sdp.LinkedItemQuery{{
+				Query: &sdp.Query{
+					Type:   "apigateway-domain-name",
+					Method: sdp.QueryMethod_GET,
+					Query:  name,
+					Scope:  adapterhelpers.FormatScope(accountID, a.Region),
+				},
+				BlastPropagation: bp,
+			}}
+		}
+
+		return nil
+	default:
+		// Everything else follows the naming convention closely enough that
+		// the fallback is a good guess
+		return fallbackQueryExtractor.ExtractorFunc(inUseBy, nil)
+	}
+}
+
+func getCertificateFunc(ctx context.Context, client acmClient, scope string, input *acm.DescribeCertificateInput) (*sdp.Item, error) {
+	output, err := client.DescribeCertificate(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if output.Certificate == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "describe certificate response was nil",
+		}
+	}
+
+	cert := output.Certificate
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	item := &sdp.Item{
+		Type:            "acm-certificate",
+		UniqueAttribute: "CertificateArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// If we can't get the tags we still want to return the certificate
+	tags, err := client.ListTagsForCertificate(ctx, &acm.ListTagsForCertificateInput{
+		CertificateArn: input.CertificateArn,
+	})
+	if err == nil {
+		item.Tags = make(map[string]string)
+		for _, tag := range tags.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				item.Tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	switch cert.Status {
+	case types.CertificateStatusIssued:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+
+		if cert.RenewalSummary != nil && cert.RenewalSummary.RenewalStatus == types.RenewalStatusFailed {
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		if health := certificateExpiryHealth(cert.NotAfter, time.Now()); health != nil {
+			item.Health = health
+		}
+	case types.CertificateStatusPendingValidation:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.CertificateStatusInactive:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.CertificateStatusExpired, types.CertificateStatusRevoked, types.CertificateStatusFailed, types.CertificateStatusValidationTimedOut:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	for _, inUseBy := range cert.InUseBy {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, certificateInUseByLink(inUseBy, scope)...)
+	}
+
+	if cert.CertificateAuthorityArn != nil {
+		if a, err := adapterhelpers.ParseARN(*cert.CertificateAuthorityArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-pca-certificate-authority",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *cert.CertificateAuthorityArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the CA is revoked or deleted the certificate won't be
+					// trusted or renewed
+					In: true,
+					// The certificate doesn't affect the CA
+					Out: false,
+				},
+			})
+		}
+	}
+
+	for _, name := range cert.SubjectAlternativeNames {
+		// Wildcard names can't be resolved
+		if strings.HasPrefix(name, "*") {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  name,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS changes can stop the certificate from being validated
+				// or renewed
+				In: true,
+				// The certificate doesn't affect DNS
+				Out: false,
+			},
+		})
+	}
+
+	return item, nil
+}
+
+func NewACMCertificateAdapter(client acmClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*acm.ListCertificatesInput, *acm.ListCertificatesOutput, *acm.DescribeCertificateInput, *acm.DescribeCertificateOutput, acmClient, *acm.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*acm.ListCertificatesInput, *acm.ListCertificatesOutput, *acm.DescribeCertificateInput, *acm.DescribeCertificateOutput, acmClient, *acm.Options]{
+		ItemType:  "acm-certificate",
+		Client:    client,
+		AccountID: accountID,
+		Region:    region,
+		ListInput: &acm.ListCertificatesInput{
+			// By default only RSA_2048 certificates are returned
+			Includes: &types.Filters{
+				KeyTypes: types.KeyAlgorithm("").Values(),
+			},
+		},
+		AdapterMetadata: acmCertificateAdapterMetadata,
+		GetInputMapper: func(scope, query string) *acm.DescribeCertificateInput {
+			return &acm.DescribeCertificateInput{
+				CertificateArn: &query,
+			}
+		},
+		SearchGetInputMapper: func(scope, query string) (*acm.DescribeCertificateInput, error) {
+			// Certificates can only be looked up by their full ARN
+			if _, err := adapterhelpers.ParseARN(query); err != nil {
+				return nil, err
+			}
+
+			return &acm.DescribeCertificateInput{
+				CertificateArn: &query,
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client acmClient, input *acm.ListCertificatesInput) adapterhelpers.Paginator[*acm.ListCertificatesOutput, *acm.Options] {
+			return acm.NewListCertificatesPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *acm.ListCertificatesOutput, input *acm.ListCertificatesInput) ([]*acm.DescribeCertificateInput, error) {
+			var inputs []*acm.DescribeCertificateInput
+			for _, summary := range output.CertificateSummaryList {
+				inputs = append(inputs, &acm.DescribeCertificateInput{
+					CertificateArn: summary.CertificateArn,
+				})
+			}
+			return inputs, nil
+		},
+		GetFunc: getCertificateFunc,
+	}
+}
+
+var acmCertificateAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "acm-certificate",
+	DescriptiveName: "ACM Certificate",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a certificate by ARN",
+		ListDescription:   "List all certificates",
+		SearchDescription: "Search for a certificate by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_acm_certificate.arn",
+		},
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_acm_certificate_validation.certificate_arn",
+		},
+	},
+	PotentialLinks: []string{"elbv2-load-balancer", "elb-load-balancer", "apigateway-domain-name", "cloudfront-distribution", "acm-pca-certificate-authority", "dns"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var acmCertificateAdapterIAMActions = IAMActions.Register(acmCertificateAdapterMetadata,
+	"acm:DescribeCertificate",
+	"acm:ListCertificates",
+	"acm:ListTagsForCertificate",
+)
@@ -0,0 +1,175 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type testACMClient struct {
+	NotAfter time.Time
+}
+
+func (t testACMClient) DescribeCertificate(ctx context.Context, params *acm.DescribeCertificateInput, optFns ...func(*acm.Options)) (*acm.DescribeCertificateOutput, error) {
+	return &acm.DescribeCertificateOutput{
+		Certificate: &types.CertificateDetail{
+			CertificateArn:          params.CertificateArn,
+			CertificateAuthorityArn: adapterhelpers.PtrString("arn:aws:acm-pca:eu-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"),
+			CreatedAt:               adapterhelpers.PtrTime(time.Now().Add(-300 * 24 * time.Hour)),
+			DomainName:              adapterhelpers.PtrString("example.com"),
+			InUseBy: []string{
+				"arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+				"arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/my-classic-elb",
+				"arn:aws:apigateway:eu-west-2::/domainnames/api.example.com",
+				"arn:aws:cloudfront::123456789012:distribution/E1234567890ABC",
+			},
+			IssuedAt:           adapterhelpers.PtrTime(time.Now().Add(-300 * 24 * time.Hour)),
+			KeyAlgorithm:       types.KeyAlgorithmRsa2048,
+			NotAfter:           &t.NotAfter,
+			NotBefore:          adapterhelpers.PtrTime(time.Now().Add(-300 * 24 * time.Hour)),
+			RenewalEligibility: types.RenewalEligibilityEligible,
+			RenewalSummary: &types.RenewalSummary{
+				RenewalStatus: types.RenewalStatusPendingAutoRenewal,
+				UpdatedAt:     adapterhelpers.PtrTime(time.Now()),
+			},
+			Status: types.CertificateStatusIssued,
+			SubjectAlternativeNames: []string{
+				"example.com",
+				"*.example.com",
+				"api.example.com",
+			},
+			Type: types.CertificateTypePrivate,
+		},
+	}, nil
+}
+
+func (t testACMClient) ListCertificates(ctx context.Context, params *acm.ListCertificatesInput, optFns ...func(*acm.Options)) (*acm.ListCertificatesOutput, error) {
+	return &acm.ListCertificatesOutput{
+		CertificateSummaryList: []types.CertificateSummary{
+			{
+				CertificateArn: adapterhelpers.PtrString("arn:aws:acm:eu-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"),
+				DomainName:     adapterhelpers.PtrString("example.com"),
+			},
+		},
+	}, nil
+}
+
+func (t testACMClient) ListTagsForCertificate(ctx context.Context, params *acm.ListTagsForCertificateInput, optFns ...func(*acm.Options)) (*acm.ListTagsForCertificateOutput, error) {
+	return &acm.ListTagsForCertificateOutput{
+		Tags: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("Name"),
+				Value: adapterhelpers.PtrString("example"),
+			},
+		},
+	}, nil
+}
+
+func TestGetCertificateFunc(t *testing.T) {
+	client := testACMClient{
+		NotAfter: time.Now().Add(60 * 24 * time.Hour),
+	}
+
+	item, err := getCertificateFunc(context.Background(), client, "123456789012.eu-west-2", &acm.DescribeCertificateInput{
+		CertificateArn: adapterhelpers.PtrString("arn:aws:acm:eu-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["Name"] != "example" {
+		t.Errorf("expected tag Name to be example, got %v", item.GetTags()["Name"])
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elb-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/my-classic-elb",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigateway-domain-name",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api.example.com",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cloudfront-distribution",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:cloudfront::123456789012:distribution/E1234567890ABC",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "acm-pca-certificate-authority",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm-pca:eu-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "api.example.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	for _, link := range item.GetLinkedItemQueries() {
+		if link.GetQuery().GetQuery() == "*.example.com" {
+			t.Error("expected wildcard names not to be linked")
+		}
+	}
+}
+
+func TestGetCertificateFuncExpiring(t *testing.T) {
+	client := testACMClient{
+		NotAfter: time.Now().Add(3 * 24 * time.Hour),
+	}
+
+	item, err := getCertificateFunc(context.Background(), client, "123456789012.eu-west-2", &acm.DescribeCertificateInput{
+		CertificateArn: adapterhelpers.PtrString("arn:aws:acm:eu-west-2:123456789012:certificate/12345678-1234-1234-1234-123456789012"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+}
+
+func TestNewACMCertificateAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := acm.NewFromConfig(config)
+
+	adapter := NewACMCertificateAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,233 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func certificateAuthorityGetFunc(ctx context.Context, client *acmpca.Client, scope string, query string) (*types.CertificateAuthority, error) {
+	out, err := client.DescribeCertificateAuthority(ctx, &acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.CertificateAuthority == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "certificate authority " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return out.CertificateAuthority, nil
+}
+
+func certificateAuthorityListFunc(ctx context.Context, client *acmpca.Client, scope string) ([]*types.CertificateAuthority, error) {
+	authorities := make([]*types.CertificateAuthority, 0)
+
+	paginator := acmpca.NewListCertificateAuthoritiesPaginator(client, &acmpca.ListCertificateAuthoritiesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.CertificateAuthorities {
+			authorities = append(authorities, &out.CertificateAuthorities[i])
+		}
+	}
+
+	return authorities, nil
+}
+
+// certificateAuthoritySearchFunc Certificate authorities can only be looked
+// up by their full ARN, so the ARN search is the same as a Get
+func certificateAuthoritySearchFunc(ctx context.Context, client *acmpca.Client, scope string, query string) ([]*types.CertificateAuthority, error) {
+	if _, err := adapterhelpers.ParseARN(query); err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be a certificate authority ARN",
+			Scope:       scope,
+		}
+	}
+
+	authority, err := certificateAuthorityGetFunc(ctx, client, scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*types.CertificateAuthority{authority}, nil
+}
+
+func certificateAuthorityListTagsFunc(ctx context.Context, authority *types.CertificateAuthority, client *acmpca.Client) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	if authority.Arn == nil {
+		return tags, nil
+	}
+
+	paginator := acmpca.NewListTagsPaginator(client, &acmpca.ListTagsInput{
+		CertificateAuthorityArn: authority.Arn,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+func certificateAuthorityItemMapper(_, scope string, authority *types.CertificateAuthority) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(authority)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "acm-pca-certificate-authority",
+		UniqueAttribute: "Arn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch authority.Status {
+	case types.CertificateAuthorityStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+
+		// Once the CA certificate expires, nothing it has issued can be
+		// trusted
+		if health := certificateExpiryHealth(authority.NotAfter, time.Now()); health != nil {
+			item.Health = health
+		}
+	case types.CertificateAuthorityStatusCreating, types.CertificateAuthorityStatusPendingCertificate:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.CertificateAuthorityStatusDisabled, types.CertificateAuthorityStatusDeleted:
+		// The CA can't issue certificates, but can be re-enabled or restored
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.CertificateAuthorityStatusExpired, types.CertificateAuthorityStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if revocation := authority.RevocationConfiguration; revocation != nil {
+		if crl := revocation.CrlConfiguration; crl != nil && crl.Enabled != nil && *crl.Enabled {
+			if crl.S3BucketName != nil {
+				accountID, _, err := adapterhelpers.ParseScope(scope)
+				if err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "s3-bucket",
+							Method: sdp.QueryMethod_GET,
+							Query:  *crl.S3BucketName,
+							Scope:  adapterhelpers.FormatScope(accountID, ""),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// If the bucket is deleted the CRL can't be
+							// published
+							In: true,
+							// The CA writes the CRL to the bucket
+							Out: true,
+						},
+					})
+				}
+			}
+
+			if crl.CustomCname != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "dns",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *crl.CustomCname,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the name stops resolving clients can't check
+						// the CRL
+						In: true,
+						// The CA doesn't affect DNS
+						Out: false,
+					},
+				})
+			}
+		}
+
+		if ocsp := revocation.OcspConfiguration; ocsp != nil && ocsp.Enabled != nil && *ocsp.Enabled && ocsp.OcspCustomCname != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *ocsp.OcspCustomCname,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the name stops resolving clients can't check
+					// revocation status
+					In: true,
+					// The CA doesn't affect DNS
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewACMPCACertificateAuthorityAdapter(client *acmpca.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.CertificateAuthority, *acmpca.Client, *acmpca.Options] {
+	return &adapterhelpers.GetListAdapter[*types.CertificateAuthority, *acmpca.Client, *acmpca.Options]{
+		ItemType:        "acm-pca-certificate-authority",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: certificateAuthorityAdapterMetadata,
+		GetFunc:         certificateAuthorityGetFunc,
+		ListFunc:        certificateAuthorityListFunc,
+		SearchFunc:      certificateAuthoritySearchFunc,
+		ListTagsFunc:    certificateAuthorityListTagsFunc,
+		ItemMapper:      certificateAuthorityItemMapper,
+	}
+}
+
+var certificateAuthorityAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "acm-pca-certificate-authority",
+	DescriptiveName: "ACM Private Certificate Authority",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a private certificate authority by ARN",
+		ListDescription:   "List all private certificate authorities",
+		SearchDescription: "Search for a private certificate authority by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_acmpca_certificate_authority.arn",
+		},
+	},
+	PotentialLinks: []string{"s3-bucket", "dns"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var certificateAuthorityAdapterIAMActions = IAMActions.Register(certificateAuthorityAdapterMetadata,
+	"acm-pca:DescribeCertificateAuthority",
+	"acm-pca:ListCertificateAuthorities",
+	"acm-pca:ListTags",
+)
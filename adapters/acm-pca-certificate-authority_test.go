@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCertificateAuthorityItemMapper(t *testing.T) {
+	authority := &types.CertificateAuthority{
+		Arn: adapterhelpers.PtrString("arn:aws:acm-pca:eu-west-2:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"),
+		CertificateAuthorityConfiguration: &types.CertificateAuthorityConfiguration{
+			KeyAlgorithm:     types.KeyAlgorithmRsa2048,
+			SigningAlgorithm: types.SigningAlgorithmSha256withrsa,
+			Subject: &types.ASN1Subject{
+				CommonName: adapterhelpers.PtrString("example.com"),
+			},
+		},
+		CreatedAt:         adapterhelpers.PtrTime(time.Now().Add(-365 * 24 * time.Hour)),
+		LastStateChangeAt: adapterhelpers.PtrTime(time.Now().Add(-365 * 24 * time.Hour)),
+		NotAfter:          adapterhelpers.PtrTime(time.Now().Add(20 * 24 * time.Hour)),
+		NotBefore:         adapterhelpers.PtrTime(time.Now().Add(-365 * 24 * time.Hour)),
+		OwnerAccount:      adapterhelpers.PtrString("123456789012"),
+		RevocationConfiguration: &types.RevocationConfiguration{
+			CrlConfiguration: &types.CrlConfiguration{
+				Enabled:          adapterhelpers.PtrBool(true),
+				CustomCname:      adapterhelpers.PtrString("crl.example.com"),
+				ExpirationInDays: adapterhelpers.PtrInt32(7),
+				S3BucketName:     adapterhelpers.PtrString("my-crl-bucket"),
+			},
+			OcspConfiguration: &types.OcspConfiguration{
+				Enabled:         adapterhelpers.PtrBool(true),
+				OcspCustomCname: adapterhelpers.PtrString("ocsp.example.com"),
+			},
+		},
+		Serial: adapterhelpers.PtrString("1234"),
+		Status: types.CertificateAuthorityStatusActive,
+		Type:   types.CertificateAuthorityTypeRoot,
+	}
+
+	item, err := certificateAuthorityItemMapper("", "123456789012.eu-west-2", authority)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The CA certificate expires in 20 days
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "my-crl-bucket",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "crl.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "ocsp.example.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewACMPCACertificateAuthorityAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := acmpca.NewFromConfig(config)
+
+	adapter := NewACMPCACertificateAuthorityAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"time"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+// certificateExpiryWarning How long before a certificate expires that it is
+// flagged with a warning. ACM attempts to renew managed certificates 60 days
+// before they expire, so one that gets this close hasn't been renewed
+const certificateExpiryWarning = 30 * 24 * time.Hour
+
+// certificateExpiryError How long before a certificate expires that it is
+// flagged as an error
+const certificateExpiryError = 7 * 24 * time.Hour
+
+// certificateExpiryHealth Returns the health of a certificate based on how
+// close it is to expiring, or nil if it isn't close to expiring
+func certificateExpiryHealth(notAfter *time.Time, now time.Time) *sdp.Health {
+	if notAfter == nil {
+		return nil
+	}
+
+	remaining := notAfter.Sub(now)
+
+	switch {
+	case remaining < certificateExpiryError:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	case remaining < certificateExpiryWarning:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,62 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCertificateExpiryHealth(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		Name     string
+		NotAfter *time.Time
+		Expected *sdp.Health
+	}{
+		{
+			Name:     "no expiry",
+			NotAfter: nil,
+			Expected: nil,
+		},
+		{
+			Name:     "far from expiry",
+			NotAfter: adapterhelpers.PtrTime(now.Add(90 * 24 * time.Hour)),
+			Expected: nil,
+		},
+		{
+			Name:     "close to expiry",
+			NotAfter: adapterhelpers.PtrTime(now.Add(20 * 24 * time.Hour)),
+			Expected: sdp.Health_HEALTH_WARNING.Enum(),
+		},
+		{
+			Name:     "about to expire",
+			NotAfter: adapterhelpers.PtrTime(now.Add(2 * 24 * time.Hour)),
+			Expected: sdp.Health_HEALTH_ERROR.Enum(),
+		},
+		{
+			Name:     "expired",
+			NotAfter: adapterhelpers.PtrTime(now.Add(-24 * time.Hour)),
+			Expected: sdp.Health_HEALTH_ERROR.Enum(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			health := certificateExpiryHealth(test.NotAfter, now)
+
+			if test.Expected == nil {
+				if health != nil {
+					t.Errorf("expected no health, got %v", health)
+				}
+				return
+			}
+
+			if health == nil || *health != *test.Expected {
+				t.Errorf("expected health %v, got %v", test.Expected, health)
+			}
+		})
+	}
+}
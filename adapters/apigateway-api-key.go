@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// convertGetApiKeyOutputToApiKey The value of the key is never requested, but
+// it is left out here too so that it can't end up in the item
+func convertGetApiKeyOutputToApiKey(output *apigateway.GetApiKeyOutput) *types.ApiKey {
+	return &types.ApiKey{
+		CreatedDate:     output.CreatedDate,
+		CustomerId:      output.CustomerId,
+		Description:     output.Description,
+		Enabled:         output.Enabled,
+		Id:              output.Id,
+		LastUpdatedDate: output.LastUpdatedDate,
+		Name:            output.Name,
+		StageKeys:       output.StageKeys,
+		Tags:            output.Tags,
+	}
+}
+
+func apiKeyOutputMapper(_, scope string, awsItem *types.ApiKey) (*sdp.Item, error) {
+	// Only the metadata of the key is included, never the value
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags", "Value")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigateway-api-key",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	// Disabling a key is deliberate, so disabled keys don't report a health
+	if awsItem.Enabled {
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	// Stage keys are in the format {rest-api-id}/{stage-name}
+	for _, stageKey := range awsItem.StageKeys {
+		if len(strings.Split(stageKey, "/")) != 2 {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-stage",
+				Method: sdp.QueryMethod_GET,
+				Query:  stageKey,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the stage means the key can't be used with it
+				In: true,
+				// Changing the key affects the clients calling the stage
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayApiKeyAdapter(client *apigateway.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ApiKey, *apigateway.Client, *apigateway.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ApiKey, *apigateway.Client, *apigateway.Options]{
+		ItemType:        "apigateway-api-key",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayApiKeyAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigateway.Client, scope, query string) (*types.ApiKey, error) {
+			out, err := client.GetApiKey(ctx, &apigateway.GetApiKeyInput{
+				ApiKey:       &query,
+				IncludeValue: adapterhelpers.PtrBool(false),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetApiKeyOutputToApiKey(out), nil
+		},
+		ListFunc: func(ctx context.Context, client *apigateway.Client, scope string) ([]*types.ApiKey, error) {
+			var apiKeys []*types.ApiKey
+
+			paginator := apigateway.NewGetApiKeysPaginator(client, &apigateway.GetApiKeysInput{
+				IncludeValues: adapterhelpers.PtrBool(false),
+			})
+
+			for paginator.HasMorePages() {
+				out, err := paginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, apiKey := range out.Items {
+					apiKeys = append(apiKeys, &apiKey)
+				}
+			}
+
+			return apiKeys, nil
+		},
+		ItemMapper: apiKeyOutputMapper,
+	}
+}
+
+var apiGatewayApiKeyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-api-key",
+	DescriptiveName: "API Gateway API Key",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an API Key by ID",
+		ListDescription:   "List API Keys",
+		SearchDescription: "Search API Keys by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_api_gateway_api_key.id"},
+	},
+	PotentialLinks: []string{"apigateway-stage"},
+})
+
+var apiGatewayApiKeyAdapterIAMActions = IAMActions.Register(apiGatewayApiKeyAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestApiKeyOutputMapper(t *testing.T) {
+	apiKey := &types.ApiKey{
+		CreatedDate: adapterhelpers.PtrTime(time.Now()),
+		Enabled:     true,
+		Id:          adapterhelpers.PtrString("api-key-id"),
+		Name:        adapterhelpers.PtrString("partner"),
+		StageKeys:   []string{"rest-api-id/prod"},
+		Value:       adapterhelpers.PtrString("secret-value"),
+	}
+
+	item, err := apiKeyOutputMapper("", "scope", apiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := item.GetAttributes().Get("Value"); err == nil {
+		t.Error("expected the value of the key to be excluded")
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigateway-stage",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id/prod",
+			ExpectedScope:  "scope",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayApiKeyAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigateway.NewFromConfig(config)
+
+	adapter := NewAPIGatewayApiKeyAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
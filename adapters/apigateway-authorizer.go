@@ -0,0 +1,181 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetAuthorizerOutputToAuthorizer(output *apigateway.GetAuthorizerOutput) *types.Authorizer {
+	return &types.Authorizer{
+		AuthType:                     output.AuthType,
+		AuthorizerCredentials:        output.AuthorizerCredentials,
+		AuthorizerResultTtlInSeconds: output.AuthorizerResultTtlInSeconds,
+		AuthorizerUri:                output.AuthorizerUri,
+		Id:                           output.Id,
+		IdentitySource:               output.IdentitySource,
+		IdentityValidationExpression: output.IdentityValidationExpression,
+		Name:                         output.Name,
+		ProviderARNs:                 output.ProviderARNs,
+		Type:                         output.Type,
+	}
+}
+
+// query: rest-api-id/authorizer-id for get request
+// query: rest-api-id for search request
+func authorizerOutputMapper(query, scope string, awsItem *types.Authorizer) (*sdp.Item, error) {
+	var restApiID string
+
+	f := strings.Split(query, "/")
+
+	switch len(f) {
+	case 1, 2:
+		restApiID = f[0]
+	default:
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format of: the rest-api-id/authorizer-id or rest-api-id, but found: %s", query),
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", restApiID, *awsItem.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigateway-authorizer",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayRestAPILink(restApiID, scope))
+
+	// TOKEN and REQUEST authorizers call a Lambda function
+	if awsItem.AuthorizerUri != nil {
+		if link := apigatewayLambdaLink(*awsItem.AuthorizerUri); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.AuthorizerCredentials != nil {
+		if link := apigatewayCredentialsLink(*awsItem.AuthorizerCredentials); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// COGNITO_USER_POOLS authorizers validate tokens against user pools
+	for _, providerARN := range awsItem.ProviderARNs {
+		if a, err := adapterhelpers.ParseARN(providerARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "cognito-idp-user-pool",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  providerARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the user pool could affect who is authorized
+					In: true,
+					// The authorizer won't affect the user pool
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayAuthorizerAdapter(client *apigateway.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Authorizer, *apigateway.Client, *apigateway.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Authorizer, *apigateway.Client, *apigateway.Options]{
+		ItemType:        "apigateway-authorizer",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayAuthorizerAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigateway.Client, scope, query string) (*types.Authorizer, error) {
+			f := strings.Split(query, "/")
+			if len(f) != 2 {
+				return nil, &sdp.QueryError{
+					ErrorType:   sdp.QueryError_NOTFOUND,
+					ErrorString: fmt.Sprintf("query must be in the format of: the rest-api-id/authorizer-id, but found: %s", query),
+				}
+			}
+
+			out, err := client.GetAuthorizer(ctx, &apigateway.GetAuthorizerInput{
+				RestApiId:    &f[0], // rest-api-id
+				AuthorizerId: &f[1], // authorizer-id
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetAuthorizerOutputToAuthorizer(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigateway.Client, scope string, query string) ([]*types.Authorizer, error) {
+			var authorizers []*types.Authorizer
+
+			input := &apigateway.GetAuthorizersInput{
+				RestApiId: &query,
+			}
+
+			for {
+				out, err := client.GetAuthorizers(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, authorizer := range out.Items {
+					authorizers = append(authorizers, &authorizer)
+				}
+
+				if out.Position == nil || *out.Position == "" {
+					break
+				}
+
+				input.Position = out.Position
+			}
+
+			return authorizers, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.Authorizer) (*sdp.Item, error) {
+			return authorizerOutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayAuthorizerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-authorizer",
+	DescriptiveName: "API Gateway Authorizer",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an Authorizer by rest-api-id/authorizer-id",
+		SearchDescription: "Search Authorizers by REST API ID",
+	},
+	PotentialLinks: []string{
+		"apigateway-rest-api",
+		"lambda-function",
+		"iam-role",
+		"cognito-idp-user-pool",
+	},
+})
+
+var apiGatewayAuthorizerAdapterIAMActions = IAMActions.Register(apiGatewayAuthorizerAdapterMetadata,
+	"apigateway:GET",
+)
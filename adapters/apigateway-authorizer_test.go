@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestAuthorizerOutputMapper(t *testing.T) {
+	authorizer := &types.Authorizer{
+		AuthType:                     adapterhelpers.PtrString("custom"),
+		AuthorizerCredentials:        adapterhelpers.PtrString("arn:aws:iam::123456789012:role/authorizer-invoke"),
+		AuthorizerResultTtlInSeconds: adapterhelpers.PtrInt32(300),
+		AuthorizerUri:                adapterhelpers.PtrString("arn:aws:apigateway:eu-west-2:lambda:path/2015-03-31/functions/arn:aws:lambda:eu-west-2:123456789012:function:authorizer/invocations"),
+		Id:                           adapterhelpers.PtrString("authorizer-id"),
+		IdentitySource:               adapterhelpers.PtrString("method.request.header.Authorization"),
+		Name:                         adapterhelpers.PtrString("token-authorizer"),
+		ProviderARNs:                 []string{"arn:aws:cognito-idp:eu-west-2:123456789012:userpool/eu-west-2_abcdef"},
+		Type:                         types.AuthorizerTypeToken,
+	}
+
+	item, err := authorizerOutputMapper("rest-api-id", "123456789012.eu-west-2", authorizer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigateway-rest-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:authorizer",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/authorizer-invoke",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:cognito-idp:eu-west-2:123456789012:userpool/eu-west-2_abcdef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayAuthorizerAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigateway.NewFromConfig(config)
+
+	adapter := NewAPIGatewayAuthorizerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
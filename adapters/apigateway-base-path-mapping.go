@@ -0,0 +1,178 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// basePathMappingNone The base path that API Gateway uses for mappings that
+// don't have one, i.e. the API is served from the root of the domain
+const basePathMappingNone = "(none)"
+
+// query: domain-name/base-path for get request
+// query: domain-name for search request
+func basePathMappingOutputMapper(query, scope string, awsItem *types.BasePathMapping) (*sdp.Item, error) {
+	// Domain names can't contain slashes but base paths can
+	domainName, _, _ := strings.Cut(query, "/")
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+	if err != nil {
+		return nil, err
+	}
+
+	basePath := basePathMappingNone
+	if awsItem.BasePath != nil && *awsItem.BasePath != "" {
+		basePath = *awsItem.BasePath
+	}
+
+	err = attributes.Set("DomainName", domainName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", domainName, basePath))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigateway-base-path-mapping",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "apigateway-domain-name",
+			Method: sdp.QueryMethod_GET,
+			Query:  domainName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the domain name deletes its mappings
+			In: true,
+			// Changing the mapping changes what is served on the domain
+			Out: true,
+		},
+	})
+
+	if awsItem.RestApiId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayRestAPILink(*awsItem.RestApiId, scope))
+
+		if awsItem.Stage != nil && *awsItem.Stage != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "apigateway-stage",
+					Method: sdp.QueryMethod_GET,
+					Query:  fmt.Sprintf("%s/%s", *awsItem.RestApiId, *awsItem.Stage),
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The stage is what serves requests on this path
+					In: true,
+					// The mapping won't affect the stage
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayBasePathMappingAdapter(client *apigateway.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.BasePathMapping, *apigateway.Client, *apigateway.Options] {
+	return &adapterhelpers.GetListAdapter[*types.BasePathMapping, *apigateway.Client, *apigateway.Options]{
+		ItemType:        "apigateway-base-path-mapping",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayBasePathMappingAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigateway.Client, scope, query string) (*types.BasePathMapping, error) {
+			domainName, basePath, found := strings.Cut(query, "/")
+			if !found || domainName == "" {
+				return nil, &sdp.QueryError{
+					ErrorType:   sdp.QueryError_NOTFOUND,
+					ErrorString: fmt.Sprintf("query must be in the format of: the domain-name/base-path, but found: %s", query),
+				}
+			}
+
+			// Terraform uses an empty base path for the root of the domain
+			if basePath == "" {
+				basePath = basePathMappingNone
+			}
+
+			out, err := client.GetBasePathMapping(ctx, &apigateway.GetBasePathMappingInput{
+				DomainName: &domainName,
+				BasePath:   &basePath,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.BasePathMapping{
+				BasePath:  out.BasePath,
+				RestApiId: out.RestApiId,
+				Stage:     out.Stage,
+			}, nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigateway.Client, scope string, query string) ([]*types.BasePathMapping, error) {
+			var mappings []*types.BasePathMapping
+
+			paginator := apigateway.NewGetBasePathMappingsPaginator(client, &apigateway.GetBasePathMappingsInput{
+				DomainName: &query,
+			})
+
+			for paginator.HasMorePages() {
+				out, err := paginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, mapping := range out.Items {
+					mappings = append(mappings, &mapping)
+				}
+			}
+
+			return mappings, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.BasePathMapping) (*sdp.Item, error) {
+			return basePathMappingOutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayBasePathMappingAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-base-path-mapping",
+	DescriptiveName: "API Gateway Base Path Mapping",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a Base Path Mapping by domain-name/base-path",
+		SearchDescription: "Search Base Path Mappings by domain name",
+	},
+	PotentialLinks: []string{
+		"apigateway-domain-name",
+		"apigateway-rest-api",
+		"apigateway-stage",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_api_gateway_base_path_mapping.id",
+		},
+	},
+})
+
+var apiGatewayBasePathMappingAdapterIAMActions = IAMActions.Register(apiGatewayBasePathMappingAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestBasePathMappingOutputMapper(t *testing.T) {
+	mapping := &types.BasePathMapping{
+		BasePath:  adapterhelpers.PtrString("v1/orders"),
+		RestApiId: adapterhelpers.PtrString("rest-api-id"),
+		Stage:     adapterhelpers.PtrString("prod"),
+	}
+
+	item, err := basePathMappingOutputMapper("api.example.com/v1/orders", "123456789012.eu-west-2", mapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "api.example.com/v1/orders" {
+		t.Errorf("expected unique attribute api.example.com/v1/orders, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigateway-domain-name",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api.example.com",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigateway-rest-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigateway-stage",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id/prod",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// Searches by domain name, and mappings at the root of the domain have no
+	// base path
+	item, err = basePathMappingOutputMapper("api.example.com", "123456789012.eu-west-2", &types.BasePathMapping{
+		BasePath:  adapterhelpers.PtrString("(none)"),
+		RestApiId: adapterhelpers.PtrString("rest-api-id"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.UniqueAttributeValue() != "api.example.com/(none)" {
+		t.Errorf("expected unique attribute api.example.com/(none), got %v", item.UniqueAttributeValue())
+	}
+}
+
+func TestNewAPIGatewayBasePathMappingAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigateway.NewFromConfig(config)
+
+	adapter := NewAPIGatewayBasePathMappingAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
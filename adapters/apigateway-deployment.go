@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetDeploymentOutputToDeployment(output *apigateway.GetDeploymentOutput) *types.Deployment {
+	return &types.Deployment{
+		ApiSummary:  output.ApiSummary,
+		CreatedDate: output.CreatedDate,
+		Description: output.Description,
+		Id:          output.Id,
+	}
+}
+
+// query: rest-api-id/deployment-id for get request
+// query: rest-api-id for search request
+func deploymentOutputMapper(query, scope string, awsItem *types.Deployment) (*sdp.Item, error) {
+	var restApiID string
+
+	f := strings.Split(query, "/")
+
+	switch len(f) {
+	case 1, 2:
+		restApiID = f[0]
+	default:
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format of: the rest-api-id/deployment-id or rest-api-id, but found: %s", query),
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", restApiID, *awsItem.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigateway-deployment",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayRestAPILink(restApiID, scope))
+
+	return &item, nil
+}
+
+func NewAPIGatewayDeploymentAdapter(client *apigateway.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Deployment, *apigateway.Client, *apigateway.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Deployment, *apigateway.Client, *apigateway.Options]{
+		ItemType:        "apigateway-deployment",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayDeploymentAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigateway.Client, scope, query string) (*types.Deployment, error) {
+			f := strings.Split(query, "/")
+			if len(f) != 2 {
+				return nil, &sdp.QueryError{
+					ErrorType:   sdp.QueryError_NOTFOUND,
+					ErrorString: fmt.Sprintf("query must be in the format of: the rest-api-id/deployment-id, but found: %s", query),
+				}
+			}
+
+			out, err := client.GetDeployment(ctx, &apigateway.GetDeploymentInput{
+				RestApiId:    &f[0], // rest-api-id
+				DeploymentId: &f[1], // deployment-id
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetDeploymentOutputToDeployment(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigateway.Client, scope string, query string) ([]*types.Deployment, error) {
+			var deployments []*types.Deployment
+
+			paginator := apigateway.NewGetDeploymentsPaginator(client, &apigateway.GetDeploymentsInput{
+				RestApiId: &query,
+			})
+
+			for paginator.HasMorePages() {
+				out, err := paginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, deployment := range out.Items {
+					deployments = append(deployments, &deployment)
+				}
+			}
+
+			return deployments, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.Deployment) (*sdp.Item, error) {
+			return deploymentOutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayDeploymentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-deployment",
+	DescriptiveName: "API Gateway Deployment",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a Deployment by rest-api-id/deployment-id",
+		SearchDescription: "Search Deployments by REST API ID",
+	},
+	PotentialLinks: []string{"apigateway-rest-api"},
+})
+
+var apiGatewayDeploymentAdapterIAMActions = IAMActions.Register(apiGatewayDeploymentAdapterMetadata,
+	"apigateway:GET",
+)
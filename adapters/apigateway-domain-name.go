@@ -173,6 +173,24 @@ func domainNameOutputMapper(_, scope string, awsItem *types.DomainName) (*sdp.It
 		}
 	}
 
+	if awsItem.DomainName != nil {
+		//+overmind:link apigateway-base-path-mapping
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-base-path-mapping",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.DomainName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing a mapping won't affect the domain name
+				In: false,
+				// Deleting the domain name deletes its mappings
+				Out: true,
+			},
+		})
+	}
+
 	// TODO: if cloudfront distribution supports searching by name, link it here via awsItem.DistributionDomainName
 
 	return &item, nil
@@ -233,8 +251,12 @@ var apiGatewayDomainNameAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 		List:              true,
 		ListDescription:   "List Domain Names",
 	},
-	PotentialLinks: []string{"acm-certificate"},
+	PotentialLinks: []string{"acm-certificate", "route53-hosted-zone", "apigateway-domain-name", "apigateway-base-path-mapping"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_api_gateway_domain_name.domain_name"},
 	},
 })
+
+var apiGatewayDomainNameAdapterIAMActions = IAMActions.Register(apiGatewayDomainNameAdapterMetadata,
+	"apigateway:GET",
+)
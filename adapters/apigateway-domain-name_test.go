@@ -113,6 +113,12 @@ func TestDomainNameOutputMapper(t *testing.T) {
 			ExpectedQuery:  "regional-domain-name",
 			ExpectedScope:  "scope",
 		},
+		{
+			ExpectedType:   "apigateway-base-path-mapping",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "domain-name",
+			ExpectedScope:  "scope",
+		},
 	}
 
 	tests.Execute(t, item)
@@ -0,0 +1,190 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func apiGatewayIntegrationGetFunc(ctx context.Context, client apigatewayClient, scope string, input *apigateway.GetIntegrationInput) (*sdp.Item, error) {
+	if input == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format of: the rest-api-id/resource-id/http-method",
+		}
+	}
+
+	output, err := client.GetIntegration(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(output, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	// We create a custom ID of {rest-api-id}/{resource-id}/{http-method} e.g.
+	// rest-api-id/resource-id/GET. This is the same as the ID of the method
+	// since each method has only one integration
+	integrationID := fmt.Sprintf(
+		"%s/%s/%s",
+		*input.RestApiId,
+		*input.ResourceId,
+		*input.HttpMethod,
+	)
+	err = attributes.Set("IntegrationID", integrationID)
+	if err != nil {
+		return nil, err
+	}
+
+	item := &sdp.Item{
+		Type:            "apigateway-integration",
+		UniqueAttribute: "IntegrationID",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "apigateway-method",
+			Method: sdp.QueryMethod_GET,
+			Query:  integrationID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// They are tightly coupled
+			In:  true,
+			Out: true,
+		},
+	})
+
+	if output.Uri != nil {
+		switch output.Type {
+		case types.IntegrationTypeAws, types.IntegrationTypeAwsProxy:
+			// Only Lambda integrations can be linked, other AWS service
+			// integrations call an API action rather than a resource
+			if link := apigatewayLambdaLink(*output.Uri); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		case types.IntegrationTypeHttp, types.IntegrationTypeHttpProxy:
+			// URIs can contain path parameters such as {proxy}, which won't
+			// resolve, so only link the full URI when there aren't any
+			if !strings.Contains(*output.Uri, "{") {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "http",
+						Method: sdp.QueryMethod_GET,
+						Query:  *output.Uri,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the backend is unhealthy, requests will fail
+						In: true,
+						// Requests are sent to the backend
+						Out: true,
+					},
+				})
+			}
+
+			if u, err := url.Parse(*output.Uri); err == nil && u.Hostname() != "" && !strings.Contains(u.Hostname(), "{") {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "dns",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  u.Hostname(),
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the backend's DNS changes, requests will go
+						// somewhere else
+						In: true,
+						// Requests are sent to the backend
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if output.ConnectionType == types.ConnectionTypeVpcLink && output.ConnectionId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-vpc-link",
+				Method: sdp.QueryMethod_GET,
+				Query:  *output.ConnectionId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Requests to the backend go through the VPC link
+				In: true,
+				// The integration won't affect the VPC link
+				Out: false,
+			},
+		})
+	}
+
+	if output.Credentials != nil {
+		if link := apigatewayCredentialsLink(*output.Credentials); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return item, nil
+}
+
+func NewAPIGatewayIntegrationAdapter(client apigatewayClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*apigateway.GetIntegrationInput, *apigateway.GetIntegrationOutput, *apigateway.GetIntegrationInput, *apigateway.GetIntegrationOutput, apigatewayClient, *apigateway.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*apigateway.GetIntegrationInput, *apigateway.GetIntegrationOutput, *apigateway.GetIntegrationInput, *apigateway.GetIntegrationOutput, apigatewayClient, *apigateway.Options]{
+		ItemType:        "apigateway-integration",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayIntegrationAdapterMetadata,
+		GetFunc:         apiGatewayIntegrationGetFunc,
+		GetInputMapper: func(scope, query string) *apigateway.GetIntegrationInput {
+			// We are using a custom id of {rest-api-id}/{resource-id}/{http-method} e.g.
+			// rest-api-id/resource-id/GET
+			f := strings.Split(query, "/")
+			if len(f) != 3 {
+				return nil
+			}
+
+			return &apigateway.GetIntegrationInput{
+				RestApiId:  &f[0],
+				ResourceId: &f[1],
+				HttpMethod: &f[2],
+			}
+		},
+		DisableList: true,
+	}
+}
+
+var apiGatewayIntegrationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-integration",
+	DescriptiveName: "API Gateway Integration",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		GetDescription:    "Get an Integration by rest-api id, resource id and http-method",
+		Search:            true,
+		SearchDescription: "Search Integrations by ARN",
+	},
+	PotentialLinks: []string{
+		"apigateway-method",
+		"apigateway-vpc-link",
+		"lambda-function",
+		"http",
+		"dns",
+		"iam-role",
+	},
+})
+
+var apiGatewayIntegrationAdapterIAMActions = IAMActions.Register(apiGatewayIntegrationAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,117 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func (m *mockAPIGatewayClient) GetIntegration(ctx context.Context, params *apigateway.GetIntegrationInput, optFns ...func(*apigateway.Options)) (*apigateway.GetIntegrationOutput, error) {
+	return &apigateway.GetIntegrationOutput{
+		CacheKeyParameters: []string{},
+		CacheNamespace:     aws.String("y9h6rt"),
+		ConnectionId:       aws.String("vpc-link-id"),
+		ConnectionType:     types.ConnectionTypeVpcLink,
+		Credentials:        aws.String("arn:aws:iam::123456789012:role/apigateway-backend"),
+		HttpMethod:         aws.String("GET"),
+		TimeoutInMillis:    29000,
+		Type:               types.IntegrationTypeHttpProxy,
+		Uri:                aws.String("https://backend.example.com/orders"),
+	}, nil
+}
+
+func TestApiGatewayIntegrationGetFunc(t *testing.T) {
+	ctx := context.Background()
+	cli := mockAPIGatewayClient{}
+
+	input := &apigateway.GetIntegrationInput{
+		RestApiId:  aws.String("rest-api-id"),
+		ResourceId: aws.String("resource-id"),
+		HttpMethod: aws.String("GET"),
+	}
+
+	item, err := apiGatewayIntegrationGetFunc(ctx, &cli, "123456789012.eu-west-2", input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigateway-method",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id/resource-id/GET",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://backend.example.com/orders",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "backend.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "apigateway-vpc-link",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-link-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/apigateway-backend",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestApigatewayLambdaLink(t *testing.T) {
+	link := apigatewayLambdaLink("arn:aws:apigateway:us-west-2:lambda:path/2015-03-31/functions/arn:aws:lambda:us-west-2:123412341234:function:My_Function/invocations")
+	if link == nil {
+		t.Fatal("expected a link")
+	}
+
+	if link.GetQuery().GetQuery() != "arn:aws:lambda:us-west-2:123412341234:function:My_Function" {
+		t.Errorf("unexpected query %v", link.GetQuery().GetQuery())
+	}
+
+	if link.GetQuery().GetScope() != "123412341234.us-west-2" {
+		t.Errorf("unexpected scope %v", link.GetQuery().GetScope())
+	}
+
+	if link := apigatewayLambdaLink("arn:aws:apigateway:us-west-2:sqs:path/123412341234/my-queue"); link != nil {
+		t.Errorf("expected no link for a non-Lambda integration, got %v", link)
+	}
+}
+
+func TestNewAPIGatewayIntegrationAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigateway.NewFromConfig(config)
+
+	adapter := NewAPIGatewayIntegrationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -104,3 +104,7 @@ var apiGatewayMethodResponseAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 		SearchDescription: "Search Method Responses by ARN",
 	},
 })
+
+var apiGatewayMethodResponseAdapterIAMActions = IAMActions.Register(apiGatewayMethodResponseAdapterMetadata,
+	"apigateway:GET",
+)
@@ -13,6 +13,7 @@ import (
 type apigatewayClient interface {
 	GetMethod(ctx context.Context, params *apigateway.GetMethodInput, optFns ...func(*apigateway.Options)) (*apigateway.GetMethodOutput, error)
 	GetMethodResponse(ctx context.Context, params *apigateway.GetMethodResponseInput, optFns ...func(*apigateway.Options)) (*apigateway.GetMethodResponseOutput, error)
+	GetIntegration(ctx context.Context, params *apigateway.GetIntegrationInput, optFns ...func(*apigateway.Options)) (*apigateway.GetIntegrationOutput, error)
 }
 
 func apiGatewayMethodGetFunc(ctx context.Context, client apigatewayClient, scope string, input *apigateway.GetMethodInput) (*sdp.Item, error) {
@@ -167,3 +168,7 @@ var apiGatewayMethodAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		"apigateway-method-response",
 	},
 })
+
+var apiGatewayMethodAdapterIAMActions = IAMActions.Register(apiGatewayMethodAdapterMetadata,
+	"apigateway:GET",
+)
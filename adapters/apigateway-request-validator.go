@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetRequestValidatorOutputToRequestValidator(output *apigateway.GetRequestValidatorOutput) *types.RequestValidator {
+	return &types.RequestValidator{
+		Id:                        output.Id,
+		Name:                      output.Name,
+		ValidateRequestBody:       output.ValidateRequestBody,
+		ValidateRequestParameters: output.ValidateRequestParameters,
+	}
+}
+
+// query: rest-api-id/request-validator-id for get request
+// query: rest-api-id for search request
+func requestValidatorOutputMapper(query, scope string, awsItem *types.RequestValidator) (*sdp.Item, error) {
+	var restApiID string
+
+	f := strings.Split(query, "/")
+
+	switch len(f) {
+	case 1, 2:
+		restApiID = f[0]
+	default:
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format of: the rest-api-id/request-validator-id or rest-api-id, but found: %s", query),
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", restApiID, *awsItem.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigateway-request-validator",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayRestAPILink(restApiID, scope))
+
+	return &item, nil
+}
+
+func NewAPIGatewayRequestValidatorAdapter(client *apigateway.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.RequestValidator, *apigateway.Client, *apigateway.Options] {
+	return &adapterhelpers.GetListAdapter[*types.RequestValidator, *apigateway.Client, *apigateway.Options]{
+		ItemType:        "apigateway-request-validator",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayRequestValidatorAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigateway.Client, scope, query string) (*types.RequestValidator, error) {
+			f := strings.Split(query, "/")
+			if len(f) != 2 {
+				return nil, &sdp.QueryError{
+					ErrorType:   sdp.QueryError_NOTFOUND,
+					ErrorString: fmt.Sprintf("query must be in the format of: the rest-api-id/request-validator-id, but found: %s", query),
+				}
+			}
+
+			out, err := client.GetRequestValidator(ctx, &apigateway.GetRequestValidatorInput{
+				RestApiId:          &f[0], // rest-api-id
+				RequestValidatorId: &f[1], // request-validator-id
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetRequestValidatorOutputToRequestValidator(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigateway.Client, scope string, query string) ([]*types.RequestValidator, error) {
+			var validators []*types.RequestValidator
+
+			input := &apigateway.GetRequestValidatorsInput{
+				RestApiId: &query,
+			}
+
+			for {
+				out, err := client.GetRequestValidators(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, validator := range out.Items {
+					validators = append(validators, &validator)
+				}
+
+				if out.Position == nil || *out.Position == "" {
+					break
+				}
+
+				input.Position = out.Position
+			}
+
+			return validators, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.RequestValidator) (*sdp.Item, error) {
+			return requestValidatorOutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayRequestValidatorAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-request-validator",
+	DescriptiveName: "API Gateway Request Validator",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a Request Validator by rest-api-id/request-validator-id",
+		SearchDescription: "Search Request Validators by REST API ID",
+	},
+	PotentialLinks: []string{"apigateway-rest-api"},
+})
+
+var apiGatewayRequestValidatorAdapterIAMActions = IAMActions.Register(apiGatewayRequestValidatorAdapterMetadata,
+	"apigateway:GET",
+)
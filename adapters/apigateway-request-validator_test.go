@@ -0,0 +1,48 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func TestRequestValidatorOutputMapper(t *testing.T) {
+	validator := &types.RequestValidator{
+		Id:                        adapterhelpers.PtrString("validator-id"),
+		Name:                      adapterhelpers.PtrString("body-only"),
+		ValidateRequestBody:       true,
+		ValidateRequestParameters: false,
+	}
+
+	item, err := requestValidatorOutputMapper("rest-api-id/validator-id", "scope", validator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "rest-api-id/validator-id" {
+		t.Errorf("expected unique attribute rest-api-id/validator-id, got %v", item.UniqueAttributeValue())
+	}
+}
+
+func TestNewAPIGatewayRequestValidatorAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigateway.NewFromConfig(config)
+
+	adapter := NewAPIGatewayRequestValidatorAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -141,3 +141,7 @@ var apiGatewayResourceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		"apigateway-method",
 	},
 })
+
+var apiGatewayResourceAdapterIAMActions = IAMActions.Register(apiGatewayResourceAdapterMetadata,
+	"apigateway:GET",
+)
@@ -139,6 +139,36 @@ func restApiOutputMapper(scope string, awsItem *types.RestApi) (*sdp.Item, error
 		},
 	})
 
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "apigateway-stage",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *awsItem.Id,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Updating a stage won't affect the REST API
+			In: false,
+			// Updating the REST API will affect the stages
+			Out: true,
+		},
+	})
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "apigateway-authorizer",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *awsItem.Id,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Updating an authorizer won't affect the REST API
+			In: false,
+			// Updating the REST API will affect the authorizers
+			Out: true,
+		},
+	})
+
 	return &item, nil
 }
 
@@ -195,5 +225,9 @@ var restApiAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_api_gateway_rest_api.id"},
 	},
-	PotentialLinks: []string{"ec2-vpc-endpoint", "apigateway-resource"},
+	PotentialLinks: []string{"ec2-vpc-endpoint", "apigateway-resource", "apigateway-stage", "apigateway-authorizer"},
 })
+
+var restApiAdapterIAMActions = IAMActions.Register(restApiAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,242 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetStageOutputToStage(output *apigateway.GetStageOutput) *types.Stage {
+	return &types.Stage{
+		AccessLogSettings:    output.AccessLogSettings,
+		CacheClusterEnabled:  output.CacheClusterEnabled,
+		CacheClusterSize:     output.CacheClusterSize,
+		CacheClusterStatus:   output.CacheClusterStatus,
+		CanarySettings:       output.CanarySettings,
+		ClientCertificateId:  output.ClientCertificateId,
+		CreatedDate:          output.CreatedDate,
+		DeploymentId:         output.DeploymentId,
+		Description:          output.Description,
+		DocumentationVersion: output.DocumentationVersion,
+		LastUpdatedDate:      output.LastUpdatedDate,
+		MethodSettings:       output.MethodSettings,
+		StageName:            output.StageName,
+		Tags:                 output.Tags,
+		TracingEnabled:       output.TracingEnabled,
+		Variables:            output.Variables,
+		WebAclArn:            output.WebAclArn,
+	}
+}
+
+// query: rest-api-id/stage-name for get request
+// query: rest-api-id for search request
+func stageOutputMapper(query, scope string, awsItem *types.Stage) (*sdp.Item, error) {
+	var restApiID string
+
+	f := strings.Split(query, "/")
+
+	switch len(f) {
+	case 1, 2:
+		restApiID = f[0]
+	default:
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format of: the rest-api-id/stage-name or rest-api-id, but found: %s", query),
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", restApiID, *awsItem.StageName))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigateway-stage",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	switch awsItem.CacheClusterStatus {
+	case types.CacheClusterStatusAvailable, "":
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.CacheClusterStatusCreateInProgress, types.CacheClusterStatusDeleteInProgress, types.CacheClusterStatusFlushInProgress:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.CacheClusterStatusNotAvailable:
+		// The status is NOT_AVAILABLE when caching is disabled
+		if awsItem.CacheClusterEnabled {
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		} else {
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayRestAPILink(restApiID, scope))
+
+	if awsItem.DeploymentId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-deployment",
+				Method: sdp.QueryMethod_GET,
+				Query:  fmt.Sprintf("%s/%s", restApiID, *awsItem.DeploymentId),
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The deployment is the snapshot of the API that the stage
+				// serves
+				In: true,
+				// The stage won't affect the deployment
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.CanarySettings != nil && awsItem.CanarySettings.DeploymentId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-deployment",
+				Method: sdp.QueryMethod_GET,
+				Query:  fmt.Sprintf("%s/%s", restApiID, *awsItem.CanarySettings.DeploymentId),
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The canary deployment serves a percentage of the traffic
+				In: true,
+				// The stage won't affect the deployment
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.WebAclArn != nil {
+		// Stages can also use WAF Classic regional ACLs, but there is no
+		// adapter for those so they aren't linked
+		if a, err := adapterhelpers.ParseARN(*awsItem.WebAclArn); err == nil && a.Service == "wafv2" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "wafv2-web-acl",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.WebAclArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the ACL could affect the stage
+					In: true,
+					// The stage could not affect the ACL
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.AccessLogSettings != nil && awsItem.AccessLogSettings.DestinationArn != nil {
+		// The destination is either a log group or a Firehose delivery stream
+		if link := logDestinationLink(*awsItem.AccessLogSettings.DestinationArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.ClientCertificateId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-client-certificate",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ClientCertificateId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Backends use the certificate to authenticate API Gateway,
+				// so changing it can break requests
+				In: true,
+				// The stage won't affect the certificate
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayStageAdapter(client *apigateway.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Stage, *apigateway.Client, *apigateway.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Stage, *apigateway.Client, *apigateway.Options]{
+		ItemType:        "apigateway-stage",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayStageAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigateway.Client, scope, query string) (*types.Stage, error) {
+			f := strings.Split(query, "/")
+			if len(f) != 2 {
+				return nil, &sdp.QueryError{
+					ErrorType:   sdp.QueryError_NOTFOUND,
+					ErrorString: fmt.Sprintf("query must be in the format of: the rest-api-id/stage-name, but found: %s", query),
+				}
+			}
+
+			out, err := client.GetStage(ctx, &apigateway.GetStageInput{
+				RestApiId: &f[0], // rest-api-id
+				StageName: &f[1], // stage-name
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetStageOutputToStage(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigateway.Client, scope string, query string) ([]*types.Stage, error) {
+			out, err := client.GetStages(ctx, &apigateway.GetStagesInput{
+				RestApiId: &query,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			var stages []*types.Stage
+			for _, stage := range out.Item {
+				stages = append(stages, &stage)
+			}
+
+			return stages, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.Stage) (*sdp.Item, error) {
+			return stageOutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayStageAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-stage",
+	DescriptiveName: "API Gateway Stage",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a Stage by rest-api-id/stage-name",
+		SearchDescription: "Search Stages by REST API ID",
+	},
+	PotentialLinks: []string{
+		"apigateway-rest-api",
+		"apigateway-deployment",
+		"apigateway-client-certificate",
+		"wafv2-web-acl",
+		"logs-log-group",
+		"firehose-delivery-stream",
+	},
+})
+
+var apiGatewayStageAdapterIAMActions = IAMActions.Register(apiGatewayStageAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,120 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestStageOutputMapper(t *testing.T) {
+	stage := &types.Stage{
+		AccessLogSettings: &types.AccessLogSettings{
+			DestinationArn: adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:api-access-logs"),
+			Format:         adapterhelpers.PtrString("$context.requestId"),
+		},
+		CacheClusterEnabled: false,
+		CacheClusterStatus:  types.CacheClusterStatusNotAvailable,
+		ClientCertificateId: adapterhelpers.PtrString("client-cert-id"),
+		CreatedDate:         adapterhelpers.PtrTime(time.Now()),
+		DeploymentId:        adapterhelpers.PtrString("deployment-id"),
+		StageName:           adapterhelpers.PtrString("prod"),
+		Tags: map[string]string{
+			"env": "prod",
+		},
+		TracingEnabled: true,
+		WebAclArn:      adapterhelpers.PtrString("arn:aws:wafv2:eu-west-2:123456789012:regional/webacl/my-acl/a1b2c3d4"),
+	}
+
+	item, err := stageOutputMapper("rest-api-id/prod", "123456789012.eu-west-2", stage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "rest-api-id/prod" {
+		t.Errorf("expected unique attribute rest-api-id/prod, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigateway-rest-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigateway-deployment",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id/deployment-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "wafv2-web-acl",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:wafv2:eu-west-2:123456789012:regional/webacl/my-acl/a1b2c3d4",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:123456789012:log-group:api-access-logs",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigateway-client-certificate",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "client-cert-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestStageOutputMapperWAFClassic(t *testing.T) {
+	stage := &types.Stage{
+		DeploymentId: adapterhelpers.PtrString("deployment-id"),
+		StageName:    adapterhelpers.PtrString("prod"),
+		WebAclArn:    adapterhelpers.PtrString("arn:aws:waf-regional:eu-west-2:123456789012:webacl/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+	}
+
+	item, err := stageOutputMapper("rest-api-id/prod", "123456789012.eu-west-2", stage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// There is no adapter for WAF Classic regional ACLs
+	for _, link := range item.GetLinkedItemQueries() {
+		if strings.HasPrefix(link.GetQuery().GetType(), "waf") {
+			t.Errorf("expected no link to the WAF Classic regional ACL, got %v", link.GetQuery().GetType())
+		}
+	}
+}
+
+func TestNewAPIGatewayStageAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigateway.NewFromConfig(config)
+
+	adapter := NewAPIGatewayStageAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,141 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetUsagePlanOutputToUsagePlan(output *apigateway.GetUsagePlanOutput) *types.UsagePlan {
+	return &types.UsagePlan{
+		ApiStages:   output.ApiStages,
+		Description: output.Description,
+		Id:          output.Id,
+		Name:        output.Name,
+		ProductCode: output.ProductCode,
+		Quota:       output.Quota,
+		Tags:        output.Tags,
+		Throttle:    output.Throttle,
+	}
+}
+
+func usagePlanOutputMapper(_, scope string, awsItem *types.UsagePlan) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigateway-usage-plan",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	for _, apiStage := range awsItem.ApiStages {
+		if apiStage.ApiId == nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-rest-api",
+				Method: sdp.QueryMethod_GET,
+				Query:  *apiStage.ApiId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the API removes it from the plan
+				In: true,
+				// The plan's quotas and throttling apply to the API
+				Out: true,
+			},
+		})
+
+		if apiStage.Stage != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "apigateway-stage",
+					Method: sdp.QueryMethod_GET,
+					Query:  fmt.Sprintf("%s/%s", *apiStage.ApiId, *apiStage.Stage),
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the stage removes it from the plan
+					In: true,
+					// The plan's quotas and throttling apply to the stage
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayUsagePlanAdapter(client *apigateway.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.UsagePlan, *apigateway.Client, *apigateway.Options] {
+	return &adapterhelpers.GetListAdapter[*types.UsagePlan, *apigateway.Client, *apigateway.Options]{
+		ItemType:        "apigateway-usage-plan",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayUsagePlanAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigateway.Client, scope, query string) (*types.UsagePlan, error) {
+			out, err := client.GetUsagePlan(ctx, &apigateway.GetUsagePlanInput{
+				UsagePlanId: &query,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetUsagePlanOutputToUsagePlan(out), nil
+		},
+		ListFunc: func(ctx context.Context, client *apigateway.Client, scope string) ([]*types.UsagePlan, error) {
+			var usagePlans []*types.UsagePlan
+
+			paginator := apigateway.NewGetUsagePlansPaginator(client, &apigateway.GetUsagePlansInput{})
+
+			for paginator.HasMorePages() {
+				out, err := paginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, usagePlan := range out.Items {
+					usagePlans = append(usagePlans, &usagePlan)
+				}
+			}
+
+			return usagePlans, nil
+		},
+		ItemMapper: usagePlanOutputMapper,
+	}
+}
+
+var apiGatewayUsagePlanAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-usage-plan",
+	DescriptiveName: "API Gateway Usage Plan",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Usage Plan by ID",
+		ListDescription:   "List Usage Plans",
+		SearchDescription: "Search Usage Plans by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_api_gateway_usage_plan.id"},
+	},
+	PotentialLinks: []string{"apigateway-rest-api", "apigateway-stage"},
+})
+
+var apiGatewayUsagePlanAdapterIAMActions = IAMActions.Register(apiGatewayUsagePlanAdapterMetadata,
+	"apigateway:GET",
+)
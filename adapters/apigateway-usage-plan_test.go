@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestUsagePlanOutputMapper(t *testing.T) {
+	usagePlan := &types.UsagePlan{
+		ApiStages: []types.ApiStage{
+			{
+				ApiId: adapterhelpers.PtrString("rest-api-id"),
+				Stage: adapterhelpers.PtrString("prod"),
+			},
+		},
+		Id:   adapterhelpers.PtrString("usage-plan-id"),
+		Name: adapterhelpers.PtrString("basic"),
+		Quota: &types.QuotaSettings{
+			Limit:  1000,
+			Period: types.QuotaPeriodTypeDay,
+		},
+		Throttle: &types.ThrottleSettings{
+			BurstLimit: 10,
+			RateLimit:  5,
+		},
+	}
+
+	item, err := usagePlanOutputMapper("", "scope", usagePlan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigateway-rest-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id",
+			ExpectedScope:  "scope",
+		},
+		{
+			ExpectedType:   "apigateway-stage",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rest-api-id/prod",
+			ExpectedScope:  "scope",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayUsagePlanAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigateway.NewFromConfig(config)
+
+	adapter := NewAPIGatewayUsagePlanAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
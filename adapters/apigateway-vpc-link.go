@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetVpcLinkOutputToVpcLink(output *apigateway.GetVpcLinkOutput) *types.VpcLink {
+	return &types.VpcLink{
+		Description:   output.Description,
+		Id:            output.Id,
+		Name:          output.Name,
+		Status:        output.Status,
+		StatusMessage: output.StatusMessage,
+		Tags:          output.Tags,
+		TargetArns:    output.TargetArns,
+	}
+}
+
+func vpcLinkOutputMapper(_, scope string, awsItem *types.VpcLink) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigateway-vpc-link",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	switch awsItem.Status {
+	case types.VpcLinkStatusAvailable:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.VpcLinkStatusPending, types.VpcLinkStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.VpcLinkStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	// REST API VPC links always target Network Load Balancers
+	for _, targetARN := range awsItem.TargetArns {
+		if a, err := adapterhelpers.ParseARN(targetARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elbv2-load-balancer",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  targetARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the load balancer is unhealthy, requests through the
+					// link will fail
+					In: true,
+					// Requests through the link are sent to the load balancer
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayVpcLinkAdapter(client *apigateway.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.VpcLink, *apigateway.Client, *apigateway.Options] {
+	return &adapterhelpers.GetListAdapter[*types.VpcLink, *apigateway.Client, *apigateway.Options]{
+		ItemType:        "apigateway-vpc-link",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayVpcLinkAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigateway.Client, scope, query string) (*types.VpcLink, error) {
+			out, err := client.GetVpcLink(ctx, &apigateway.GetVpcLinkInput{
+				VpcLinkId: &query,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetVpcLinkOutputToVpcLink(out), nil
+		},
+		ListFunc: func(ctx context.Context, client *apigateway.Client, scope string) ([]*types.VpcLink, error) {
+			var vpcLinks []*types.VpcLink
+
+			paginator := apigateway.NewGetVpcLinksPaginator(client, &apigateway.GetVpcLinksInput{})
+
+			for paginator.HasMorePages() {
+				out, err := paginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, vpcLink := range out.Items {
+					vpcLinks = append(vpcLinks, &vpcLink)
+				}
+			}
+
+			return vpcLinks, nil
+		},
+		ItemMapper: vpcLinkOutputMapper,
+	}
+}
+
+var apiGatewayVpcLinkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigateway-vpc-link",
+	DescriptiveName: "API Gateway VPC Link",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a VPC Link by ID",
+		ListDescription:   "List VPC Links",
+		SearchDescription: "Search VPC Links by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_api_gateway_vpc_link.id"},
+	},
+	PotentialLinks: []string{"elbv2-load-balancer"},
+})
+
+var apiGatewayVpcLinkAdapterIAMActions = IAMActions.Register(apiGatewayVpcLinkAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,62 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVpcLinkOutputMapper(t *testing.T) {
+	vpcLink := &types.VpcLink{
+		Id:     adapterhelpers.PtrString("vpc-link-id"),
+		Name:   adapterhelpers.PtrString("internal-services"),
+		Status: types.VpcLinkStatusAvailable,
+		Tags: map[string]string{
+			"env": "prod",
+		},
+		TargetArns: []string{"arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/net/internal/1234567890abcdef"},
+	}
+
+	item, err := vpcLinkOutputMapper("", "123456789012.eu-west-2", vpcLink)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/net/internal/1234567890abcdef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayVpcLinkAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigateway.NewFromConfig(config)
+
+	adapter := NewAPIGatewayVpcLinkAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,76 @@
+package adapters
+
+import (
+	"strings"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// apigatewayLambdaLink Links to the Lambda function that is invoked by an
+// integration or authorizer. The URI looks like:
+//
+// arn:aws:apigateway:{region}:lambda:path/2015-03-31/functions/{functionArn}/invocations
+func apigatewayLambdaLink(uri string) *sdp.LinkedItemQuery {
+	_, functionARN, found := strings.Cut(uri, ":lambda:path/")
+	if !found {
+		return nil
+	}
+
+	_, functionARN, found = strings.Cut(functionARN, "/functions/")
+	if !found {
+		return nil
+	}
+
+	functionARN = strings.TrimSuffix(functionARN, "/invocations")
+
+	a, err := adapterhelpers.ParseARN(functionARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "lambda-function",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  functionARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the function changes how requests are handled
+			In: true,
+			// Requests are sent to the function
+			Out: true,
+		},
+	}
+}
+
+// apigatewayCredentialsLink Links to the role that API Gateway assumes to
+// call a backend. Credentials can also be arn:aws:iam::*:user/*, which means
+// the caller's identity is used, so only roles are linked
+func apigatewayCredentialsLink(credentials string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(credentials)
+	if err != nil || a.Type() != "role" {
+		return nil
+	}
+
+	return iamRoleLink(credentials)
+}
+
+// apigatewayRestAPILink Links a child of a REST API back to the API
+func apigatewayRestAPILink(restAPIID string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "apigateway-rest-api",
+			Method: sdp.QueryMethod_GET,
+			Query:  restAPIID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the REST API deletes everything in it
+			In: true,
+			// Changes to a part of the API don't affect the API itself
+			Out: false,
+		},
+	}
+}
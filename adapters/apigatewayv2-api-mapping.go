@@ -0,0 +1,185 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetApiMappingOutputToApiMapping(output *apigatewayv2.GetApiMappingOutput) *types.ApiMapping {
+	return &types.ApiMapping{
+		ApiId:         output.ApiId,
+		ApiMappingId:  output.ApiMappingId,
+		ApiMappingKey: output.ApiMappingKey,
+		Stage:         output.Stage,
+	}
+}
+
+// query: domain-name/api-mapping-id for get request
+// query: domain-name for search request
+func apiMappingOutputMapper(query, scope string, awsItem *types.ApiMapping) (*sdp.Item, error) {
+	var domainName string
+
+	f := strings.Split(query, "/")
+
+	switch len(f) {
+	case 1, 2:
+		domainName = f[0]
+	default:
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format of: the domain-name/api-mapping-id or domain-name, but found: %s", query),
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", domainName, *awsItem.ApiMappingId))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-api-mapping",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "apigatewayv2-domain-name",
+			Method: sdp.QueryMethod_GET,
+			Query:  domainName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the domain name deletes the mapping
+			In: true,
+			// Changing the mapping changes what the domain name serves
+			Out: true,
+		},
+	})
+
+	if awsItem.ApiId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigatewayv2-api",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ApiId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the API breaks the mapping
+				In: true,
+				// The mapping won't affect the API
+				Out: false,
+			},
+		})
+
+		if awsItem.Stage != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "apigatewayv2-stage",
+					Method: sdp.QueryMethod_GET,
+					Query:  fmt.Sprintf("%s/%s", *awsItem.ApiId, *awsItem.Stage),
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Requests to the domain name are served by the stage
+					In: true,
+					// The mapping won't affect the stage
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2ApiMappingAdapter(client *apigatewayv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ApiMapping, *apigatewayv2.Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ApiMapping, *apigatewayv2.Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-api-mapping",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2ApiMappingAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigatewayv2.Client, scope, query string) (*types.ApiMapping, error) {
+			domainName, apiMappingID, err := apigatewayv2SplitGetQuery(query, "domain-name/api-mapping-id")
+			if err != nil {
+				return nil, err
+			}
+
+			out, err := client.GetApiMapping(ctx, &apigatewayv2.GetApiMappingInput{
+				DomainName:   &domainName,
+				ApiMappingId: &apiMappingID,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetApiMappingOutputToApiMapping(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigatewayv2.Client, scope string, query string) ([]*types.ApiMapping, error) {
+			var apiMappings []*types.ApiMapping
+
+			input := &apigatewayv2.GetApiMappingsInput{
+				DomainName: &query,
+			}
+
+			for {
+				out, err := client.GetApiMappings(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, apiMapping := range out.Items {
+					apiMappings = append(apiMappings, &apiMapping)
+				}
+
+				if out.NextToken == nil || *out.NextToken == "" {
+					break
+				}
+
+				input.NextToken = out.NextToken
+			}
+
+			return apiMappings, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.ApiMapping) (*sdp.Item, error) {
+			return apiMappingOutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayV2ApiMappingAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-api-mapping",
+	DescriptiveName: "API Gateway V2 API Mapping",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an API Mapping by domain-name/api-mapping-id",
+		SearchDescription: "Search API Mappings by domain name",
+	},
+	PotentialLinks: []string{
+		"apigatewayv2-domain-name",
+		"apigatewayv2-api",
+		"apigatewayv2-stage",
+	},
+})
+
+var apiGatewayV2ApiMappingAdapterIAMActions = IAMActions.Register(apiGatewayV2ApiMappingAdapterMetadata,
+	"apigateway:GET",
+)
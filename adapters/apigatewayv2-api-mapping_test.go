@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestApiMappingOutputMapper(t *testing.T) {
+	apiMapping := &types.ApiMapping{
+		ApiId:         adapterhelpers.PtrString("api-id"),
+		ApiMappingId:  adapterhelpers.PtrString("mapping-id"),
+		ApiMappingKey: adapterhelpers.PtrString("orders"),
+		Stage:         adapterhelpers.PtrString("prod"),
+	}
+
+	item, err := apiMappingOutputMapper("api.example.com", "123456789012.eu-west-2", apiMapping)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "api.example.com/mapping-id" {
+		t.Errorf("expected unique attribute api.example.com/mapping-id, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-domain-name",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api.example.com",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-stage",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api-id/prod",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayV2ApiMappingAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2ApiMappingAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
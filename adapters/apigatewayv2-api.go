@@ -0,0 +1,195 @@
+package adapters
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetApiOutputToApi(output *apigatewayv2.GetApiOutput) *types.Api {
+	return &types.Api{
+		ApiEndpoint:               output.ApiEndpoint,
+		ApiGatewayManaged:         output.ApiGatewayManaged,
+		ApiId:                     output.ApiId,
+		ApiKeySelectionExpression: output.ApiKeySelectionExpression,
+		CorsConfiguration:         output.CorsConfiguration,
+		CreatedDate:               output.CreatedDate,
+		Description:               output.Description,
+		DisableExecuteApiEndpoint: output.DisableExecuteApiEndpoint,
+		DisableSchemaValidation:   output.DisableSchemaValidation,
+		ImportInfo:                output.ImportInfo,
+		Name:                      output.Name,
+		ProtocolType:              output.ProtocolType,
+		RouteSelectionExpression:  output.RouteSelectionExpression,
+		Tags:                      output.Tags,
+		Version:                   output.Version,
+		Warnings:                  output.Warnings,
+	}
+}
+
+func apiV2ListFunc(ctx context.Context, client *apigatewayv2.Client, _ string) ([]*types.Api, error) {
+	var apis []*types.Api
+
+	input := &apigatewayv2.GetApisInput{}
+
+	for {
+		out, err := client.GetApis(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, api := range out.Items {
+			apis = append(apis, &api)
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return apis, nil
+}
+
+func apiV2OutputMapper(scope string, awsItem *types.Api) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-api",
+		UniqueAttribute: "ApiId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	// Warnings are raised when the API was imported from a definition that
+	// contained things that couldn't be created
+	if len(awsItem.Warnings) > 0 {
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	} else {
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	// The default endpoint can be disabled so that clients have to use a
+	// custom domain name, in which case it won't resolve
+	if awsItem.ApiEndpoint != nil && (awsItem.DisableExecuteApiEndpoint == nil || !*awsItem.DisableExecuteApiEndpoint) {
+		if u, err := url.Parse(*awsItem.ApiEndpoint); err == nil && u.Hostname() != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  u.Hostname(),
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The name is managed by API Gateway, so it is tightly
+					// linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.ApiId != nil {
+		for _, childType := range []string{
+			"apigatewayv2-route",
+			"apigatewayv2-integration",
+			"apigatewayv2-authorizer",
+			"apigatewayv2-stage",
+		} {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   childType,
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.ApiId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Updating a part of the API won't affect the API
+					In: false,
+					// Updating the API will affect all of its parts
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2ApiAdapter(client *apigatewayv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Api, *apigatewayv2.Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Api, *apigatewayv2.Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-api",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2ApiAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigatewayv2.Client, scope, query string) (*types.Api, error) {
+			out, err := client.GetApi(ctx, &apigatewayv2.GetApiInput{
+				ApiId: &query,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetApiOutputToApi(out), nil
+		},
+		ListFunc: apiV2ListFunc,
+		SearchFunc: func(ctx context.Context, client *apigatewayv2.Client, scope string, query string) ([]*types.Api, error) {
+			apis, err := apiV2ListFunc(ctx, client, scope)
+			if err != nil {
+				return nil, err
+			}
+
+			var items []*types.Api
+			for _, api := range apis {
+				if api.Name != nil && *api.Name == query {
+					items = append(items, api)
+				}
+			}
+
+			return items, nil
+		},
+		ItemMapper: func(_, scope string, awsItem *types.Api) (*sdp.Item, error) {
+			return apiV2OutputMapper(scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayV2ApiAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-api",
+	DescriptiveName: "API Gateway V2 API",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an HTTP or WebSocket API by ID",
+		ListDescription:   "List all HTTP and WebSocket APIs",
+		SearchDescription: "Search for HTTP and WebSocket APIs by their name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_apigatewayv2_api.id"},
+	},
+	PotentialLinks: []string{
+		"dns",
+		"apigatewayv2-route",
+		"apigatewayv2-integration",
+		"apigatewayv2-authorizer",
+		"apigatewayv2-stage",
+	},
+})
+
+var apiGatewayV2ApiAdapterIAMActions = IAMActions.Register(apiGatewayV2ApiAdapterMetadata,
+	"apigateway:GET",
+)
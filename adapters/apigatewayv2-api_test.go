@@ -0,0 +1,88 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestApiV2OutputMapper(t *testing.T) {
+	api := &types.Api{
+		ApiEndpoint:              adapterhelpers.PtrString("https://a1b2c3d4e5.execute-api.eu-west-2.amazonaws.com"),
+		ApiId:                    adapterhelpers.PtrString("a1b2c3d4e5"),
+		CreatedDate:              adapterhelpers.PtrTime(time.Now()),
+		Name:                     adapterhelpers.PtrString("orders"),
+		ProtocolType:             types.ProtocolTypeHttp,
+		RouteSelectionExpression: adapterhelpers.PtrString("$request.method $request.path"),
+		Tags: map[string]string{
+			"env": "prod",
+		},
+	}
+
+	item, err := apiV2OutputMapper("123456789012.eu-west-2", api)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5.execute-api.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "apigatewayv2-route",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-integration",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-authorizer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-stage",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1b2c3d4e5",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayV2ApiAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2ApiAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
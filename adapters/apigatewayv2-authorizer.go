@@ -0,0 +1,203 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetAuthorizerV2OutputToAuthorizer(output *apigatewayv2.GetAuthorizerOutput) *types.Authorizer {
+	return &types.Authorizer{
+		AuthorizerCredentialsArn:       output.AuthorizerCredentialsArn,
+		AuthorizerId:                   output.AuthorizerId,
+		AuthorizerPayloadFormatVersion: output.AuthorizerPayloadFormatVersion,
+		AuthorizerResultTtlInSeconds:   output.AuthorizerResultTtlInSeconds,
+		AuthorizerType:                 output.AuthorizerType,
+		AuthorizerUri:                  output.AuthorizerUri,
+		EnableSimpleResponses:          output.EnableSimpleResponses,
+		IdentitySource:                 output.IdentitySource,
+		IdentityValidationExpression:   output.IdentityValidationExpression,
+		JwtConfiguration:               output.JwtConfiguration,
+		Name:                           output.Name,
+	}
+}
+
+// apigatewayv2IssuerLinks Links to whatever issues the tokens that a JWT
+// authorizer accepts. Cognito issuers are in the format
+// https://cognito-idp.{region}.amazonaws.com/{user-pool-id}, anything else is
+// an OIDC provider that is linked by URL
+func apigatewayv2IssuerLinks(issuer string, scope string) []*sdp.LinkedItemQuery {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return nil
+	}
+
+	if region, found := strings.CutPrefix(u.Hostname(), "cognito-idp."); found && strings.HasSuffix(region, ".amazonaws.com") {
+		region = strings.TrimSuffix(region, ".amazonaws.com")
+		userPoolID := strings.Trim(u.Path, "/")
+
+		accountID, _, err := adapterhelpers.ParseScope(scope)
+		if err == nil && userPoolID != "" {
+			return []*sdp.LinkedItemQuery{
+				{
+					Query: &sdp.Query{
+						Type:   "cognito-idp-user-pool",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  fmt.Sprintf("arn:aws:cognito-idp:%s:%s:userpool/%s", region, accountID, userPoolID),
+						Scope:  adapterhelpers.FormatScope(accountID, region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the user pool could affect who is
+						// authorized
+						In: true,
+						// The authorizer won't affect the user pool
+						Out: false,
+					},
+				},
+			}
+		}
+	}
+
+	links := apigatewayv2HTTPLinks(issuer)
+	for _, link := range links {
+		// The authorizer fetches the provider's keys, it doesn't send
+		// requests to it
+		link.BlastPropagation.Out = false
+	}
+
+	return links
+}
+
+// query: api-id/authorizer-id for get request
+// query: api-id for search request
+func authorizerV2OutputMapper(query, scope string, awsItem *types.Authorizer) (*sdp.Item, error) {
+	apiID, err := apigatewayv2ParseChildQuery(query, "authorizer-id")
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", apiID, *awsItem.AuthorizerId))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-authorizer",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayv2APILink(apiID, scope))
+
+	// REQUEST authorizers call a Lambda function
+	if awsItem.AuthorizerUri != nil {
+		if link := apigatewayLambdaLink(*awsItem.AuthorizerUri); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.AuthorizerCredentialsArn != nil {
+		if link := apigatewayCredentialsLink(*awsItem.AuthorizerCredentialsArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.JwtConfiguration != nil && awsItem.JwtConfiguration.Issuer != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayv2IssuerLinks(*awsItem.JwtConfiguration.Issuer, scope)...)
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2AuthorizerAdapter(client *apigatewayv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Authorizer, *apigatewayv2.Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Authorizer, *apigatewayv2.Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-authorizer",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2AuthorizerAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigatewayv2.Client, scope, query string) (*types.Authorizer, error) {
+			apiID, authorizerID, err := apigatewayv2SplitGetQuery(query, "api-id/authorizer-id")
+			if err != nil {
+				return nil, err
+			}
+
+			out, err := client.GetAuthorizer(ctx, &apigatewayv2.GetAuthorizerInput{
+				ApiId:        &apiID,
+				AuthorizerId: &authorizerID,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetAuthorizerV2OutputToAuthorizer(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigatewayv2.Client, scope string, query string) ([]*types.Authorizer, error) {
+			var authorizers []*types.Authorizer
+
+			input := &apigatewayv2.GetAuthorizersInput{
+				ApiId: &query,
+			}
+
+			for {
+				out, err := client.GetAuthorizers(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, authorizer := range out.Items {
+					authorizers = append(authorizers, &authorizer)
+				}
+
+				if out.NextToken == nil || *out.NextToken == "" {
+					break
+				}
+
+				input.NextToken = out.NextToken
+			}
+
+			return authorizers, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.Authorizer) (*sdp.Item, error) {
+			return authorizerV2OutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayV2AuthorizerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-authorizer",
+	DescriptiveName: "API Gateway V2 Authorizer",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an Authorizer by api-id/authorizer-id",
+		SearchDescription: "Search Authorizers by API ID",
+	},
+	PotentialLinks: []string{
+		"apigatewayv2-api",
+		"lambda-function",
+		"iam-role",
+		"cognito-idp-user-pool",
+		"http",
+		"dns",
+	},
+})
+
+var apiGatewayV2AuthorizerAdapterIAMActions = IAMActions.Register(apiGatewayV2AuthorizerAdapterMetadata,
+	"apigateway:GET",
+)
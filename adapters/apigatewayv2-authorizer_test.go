@@ -0,0 +1,107 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestAuthorizerV2OutputMapper(t *testing.T) {
+	scope := "123456789012.eu-west-2"
+
+	t.Run("jwt", func(t *testing.T) {
+		authorizer := &types.Authorizer{
+			AuthorizerId:   adapterhelpers.PtrString("authorizer-id"),
+			AuthorizerType: types.AuthorizerTypeJwt,
+			IdentitySource: []string{"$request.header.Authorization"},
+			JwtConfiguration: &types.JWTConfiguration{
+				Audience: []string{"client-id"},
+				Issuer:   adapterhelpers.PtrString("https://cognito-idp.eu-west-2.amazonaws.com/eu-west-2_AbCdEfGhI"),
+			},
+			Name: adapterhelpers.PtrString("cognito"),
+		}
+
+		item, err := authorizerV2OutputMapper("api-id/authorizer-id", scope, authorizer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		if item.UniqueAttributeValue() != "api-id/authorizer-id" {
+			t.Errorf("expected unique attribute api-id/authorizer-id, got %v", item.UniqueAttributeValue())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "apigatewayv2-api",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "api-id",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "cognito-idp-user-pool",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:cognito-idp:eu-west-2:123456789012:userpool/eu-west-2_AbCdEfGhI",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("lambda", func(t *testing.T) {
+		authorizer := &types.Authorizer{
+			AuthorizerCredentialsArn:       adapterhelpers.PtrString("arn:aws:iam::123456789012:role/apigateway-authorizer"),
+			AuthorizerId:                   adapterhelpers.PtrString("authorizer-id"),
+			AuthorizerPayloadFormatVersion: adapterhelpers.PtrString("2.0"),
+			AuthorizerType:                 types.AuthorizerTypeRequest,
+			AuthorizerUri:                  adapterhelpers.PtrString("arn:aws:apigateway:eu-west-2:lambda:path/2015-03-31/functions/arn:aws:lambda:eu-west-2:123456789012:function:authorizer/invocations"),
+			Name:                           adapterhelpers.PtrString("lambda"),
+		}
+
+		item, err := authorizerV2OutputMapper("api-id", scope, authorizer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "lambda-function",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:authorizer",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "iam-role",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:iam::123456789012:role/apigateway-authorizer",
+				ExpectedScope:  "123456789012",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+}
+
+func TestNewAPIGatewayV2AuthorizerAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2AuthorizerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
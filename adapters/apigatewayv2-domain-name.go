@@ -0,0 +1,233 @@
+package adapters
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetDomainNameV2OutputToDomainName(output *apigatewayv2.GetDomainNameOutput) *types.DomainName {
+	return &types.DomainName{
+		ApiMappingSelectionExpression: output.ApiMappingSelectionExpression,
+		DomainName:                    output.DomainName,
+		DomainNameConfigurations:      output.DomainNameConfigurations,
+		MutualTlsAuthentication:       output.MutualTlsAuthentication,
+		Tags:                          output.Tags,
+	}
+}
+
+func domainNameV2OutputMapper(scope string, awsItem *types.DomainName) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-domain-name",
+		UniqueAttribute: "DomainName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, config := range awsItem.DomainNameConfigurations {
+		// A domain name only has one configuration, so its status is the
+		// status of the domain name
+		switch config.DomainNameStatus {
+		case types.DomainNameStatusAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.DomainNameStatusUpdating,
+			types.DomainNameStatusPendingCertificateReimport,
+			types.DomainNameStatusPendingOwnershipVerification:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+
+		for _, certificateARN := range []*string{config.CertificateArn, config.OwnershipVerificationCertificateArn} {
+			if certificateARN == nil {
+				continue
+			}
+
+			if a, err := adapterhelpers.ParseARN(*certificateARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "acm-certificate",
+						Method: sdp.QueryMethod_GET,
+						Query:  *certificateARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// They are tightly linked
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		// This is the name that the custom domain should be aliased to
+		if config.ApiGatewayDomainName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *config.ApiGatewayDomainName,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The name is managed by API Gateway, so it is tightly
+					// linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if config.HostedZoneId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "route53-hosted-zone",
+					Method: sdp.QueryMethod_GET,
+					Query:  *config.HostedZoneId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the hosted zone can affect the domain name
+					In: true,
+					// The domain name won't affect the hosted zone
+					Out: false,
+				},
+			})
+		}
+	}
+
+	// The truststore for mutual TLS is in the format s3://{bucket}/{key}
+	if awsItem.MutualTlsAuthentication != nil && awsItem.MutualTlsAuthentication.TruststoreUri != nil {
+		if u, err := url.Parse(*awsItem.MutualTlsAuthentication.TruststoreUri); err == nil && u.Scheme == "s3" && u.Host != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  u.Host,
+					Scope:  adapterhelpers.FormatScope(accountID, ""),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the truststore is removed, clients can't connect
+					In: true,
+					// The domain name won't affect the bucket
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.DomainName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigatewayv2-api-mapping",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.DomainName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing a mapping won't affect the domain name
+				In: false,
+				// Deleting the domain name deletes its mappings
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2DomainNameAdapter(client *apigatewayv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DomainName, *apigatewayv2.Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DomainName, *apigatewayv2.Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-domain-name",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2DomainNameAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigatewayv2.Client, scope, query string) (*types.DomainName, error) {
+			if query == "" {
+				return nil, &sdp.QueryError{
+					ErrorType:   sdp.QueryError_NOTFOUND,
+					ErrorString: "query must be the domain-name, but found empty query",
+				}
+			}
+
+			out, err := client.GetDomainName(ctx, &apigatewayv2.GetDomainNameInput{
+				DomainName: &query,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetDomainNameV2OutputToDomainName(out), nil
+		},
+		ListFunc: func(ctx context.Context, client *apigatewayv2.Client, scope string) ([]*types.DomainName, error) {
+			var domainNames []*types.DomainName
+
+			input := &apigatewayv2.GetDomainNamesInput{}
+
+			for {
+				out, err := client.GetDomainNames(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, domainName := range out.Items {
+					domainNames = append(domainNames, &domainName)
+				}
+
+				if out.NextToken == nil || *out.NextToken == "" {
+					break
+				}
+
+				input.NextToken = out.NextToken
+			}
+
+			return domainNames, nil
+		},
+		ItemMapper: func(_, scope string, awsItem *types.DomainName) (*sdp.Item, error) {
+			return domainNameV2OutputMapper(scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayV2DomainNameAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-domain-name",
+	DescriptiveName: "API Gateway V2 Domain Name",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Domain Name by domain-name",
+		ListDescription:   "List Domain Names",
+		SearchDescription: "Search Domain Names by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_apigatewayv2_domain_name.domain_name"},
+	},
+	PotentialLinks: []string{
+		"acm-certificate",
+		"dns",
+		"route53-hosted-zone",
+		"s3-bucket",
+		"apigatewayv2-api-mapping",
+	},
+})
+
+var apiGatewayV2DomainNameAdapterIAMActions = IAMActions.Register(apiGatewayV2DomainNameAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,97 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestDomainNameV2OutputMapper(t *testing.T) {
+	domainName := &types.DomainName{
+		ApiMappingSelectionExpression: adapterhelpers.PtrString("$request.basepath"),
+		DomainName:                    adapterhelpers.PtrString("api.example.com"),
+		DomainNameConfigurations: []types.DomainNameConfiguration{
+			{
+				ApiGatewayDomainName: adapterhelpers.PtrString("d-abcdef1234.execute-api.eu-west-2.amazonaws.com"),
+				CertificateArn:       adapterhelpers.PtrString("arn:aws:acm:eu-west-2:123456789012:certificate/certificate-id"),
+				DomainNameStatus:     types.DomainNameStatusAvailable,
+				EndpointType:         types.EndpointTypeRegional,
+				HostedZoneId:         adapterhelpers.PtrString("ZJ5UAJN8Y3Z2Q"),
+				SecurityPolicy:       types.SecurityPolicyTls12,
+			},
+		},
+		MutualTlsAuthentication: &types.MutualTlsAuthentication{
+			TruststoreUri: adapterhelpers.PtrString("s3://truststore-bucket/truststore.pem"),
+		},
+		Tags: map[string]string{
+			"env": "prod",
+		},
+	}
+
+	item, err := domainNameV2OutputMapper("123456789012.eu-west-2", domainName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:123456789012:certificate/certificate-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "d-abcdef1234.execute-api.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "route53-hosted-zone",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ZJ5UAJN8Y3Z2Q",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "truststore-bucket",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "apigatewayv2-api-mapping",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "api.example.com",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayV2DomainNameAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2DomainNameAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
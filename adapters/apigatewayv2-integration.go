@@ -0,0 +1,244 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetIntegrationOutputToIntegration(output *apigatewayv2.GetIntegrationOutput) *types.Integration {
+	return &types.Integration{
+		ApiGatewayManaged:                      output.ApiGatewayManaged,
+		ConnectionId:                           output.ConnectionId,
+		ConnectionType:                         output.ConnectionType,
+		ContentHandlingStrategy:                output.ContentHandlingStrategy,
+		CredentialsArn:                         output.CredentialsArn,
+		Description:                            output.Description,
+		IntegrationId:                          output.IntegrationId,
+		IntegrationMethod:                      output.IntegrationMethod,
+		IntegrationResponseSelectionExpression: output.IntegrationResponseSelectionExpression,
+		IntegrationSubtype:                     output.IntegrationSubtype,
+		IntegrationType:                        output.IntegrationType,
+		IntegrationUri:                         output.IntegrationUri,
+		PassthroughBehavior:                    output.PassthroughBehavior,
+		PayloadFormatVersion:                   output.PayloadFormatVersion,
+		RequestParameters:                      output.RequestParameters,
+		RequestTemplates:                       output.RequestTemplates,
+		ResponseParameters:                     output.ResponseParameters,
+		TemplateSelectionExpression:            output.TemplateSelectionExpression,
+		TimeoutInMillis:                        output.TimeoutInMillis,
+		TlsConfig:                              output.TlsConfig,
+	}
+}
+
+// query: api-id/integration-id for get request
+// query: api-id for search request
+func integrationV2OutputMapper(query, scope string, awsItem *types.Integration) (*sdp.Item, error) {
+	apiID, err := apigatewayv2ParseChildQuery(query, "integration-id")
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", apiID, *awsItem.IntegrationId))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-integration",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayv2APILink(apiID, scope))
+
+	// The URI is an ARN for Lambda functions and, for private integrations
+	// of HTTP APIs, the ALB/NLB listener or Cloud Map service that the VPC
+	// link sends requests to
+	var uriARN *adapterhelpers.ARN
+	if awsItem.IntegrationUri != nil {
+		uriARN, _ = adapterhelpers.ParseARN(*awsItem.IntegrationUri)
+	}
+
+	if awsItem.IntegrationUri != nil {
+		switch awsItem.IntegrationType {
+		case types.IntegrationTypeAwsProxy, types.IntegrationTypeAws:
+			if uriARN != nil && uriARN.Service == "lambda" {
+				// HTTP APIs take the function ARN directly
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-function",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *awsItem.IntegrationUri,
+						Scope:  adapterhelpers.FormatScope(uriARN.AccountID, uriARN.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the function changes how requests are
+						// handled
+						In: true,
+						// Requests are sent to the function
+						Out: true,
+					},
+				})
+			} else if link := apigatewayLambdaLink(*awsItem.IntegrationUri); link != nil {
+				// WebSocket APIs use the same invocation URI as REST APIs
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		case types.IntegrationTypeHttpProxy, types.IntegrationTypeHttp:
+			if uriARN != nil {
+				if uriARN.Service == "elasticloadbalancing" && uriARN.Type() == "listener" {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "elbv2-listener",
+							Method: sdp.QueryMethod_GET,
+							Query:  *awsItem.IntegrationUri,
+							Scope:  adapterhelpers.FormatScope(uriARN.AccountID, uriARN.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// If the listener or its load balancer is
+							// unhealthy, requests will fail
+							In: true,
+							// Requests are sent to the listener
+							Out: true,
+						},
+					})
+				}
+			} else {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayv2HTTPLinks(*awsItem.IntegrationUri)...)
+			}
+		}
+	}
+
+	if awsItem.IntegrationSubtype != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayv2SubtypeLinks(*awsItem.IntegrationSubtype, awsItem.RequestParameters, scope)...)
+	}
+
+	if awsItem.ConnectionType == types.ConnectionTypeVpcLink && awsItem.ConnectionId != nil {
+		// HTTP APIs use their own VPC links, which are targeted using an ARN.
+		// WebSocket APIs use REST API VPC links and a URL
+		linkType := "apigateway-vpc-link"
+		if uriARN != nil {
+			linkType = "apigatewayv2-vpc-link"
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   linkType,
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ConnectionId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Requests to the backend go through the VPC link
+				In: true,
+				// The integration won't affect the VPC link
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.CredentialsArn != nil {
+		if link := apigatewayCredentialsLink(*awsItem.CredentialsArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2IntegrationAdapter(client *apigatewayv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Integration, *apigatewayv2.Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Integration, *apigatewayv2.Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-integration",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2IntegrationAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigatewayv2.Client, scope, query string) (*types.Integration, error) {
+			apiID, integrationID, err := apigatewayv2SplitGetQuery(query, "api-id/integration-id")
+			if err != nil {
+				return nil, err
+			}
+
+			out, err := client.GetIntegration(ctx, &apigatewayv2.GetIntegrationInput{
+				ApiId:         &apiID,
+				IntegrationId: &integrationID,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetIntegrationOutputToIntegration(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigatewayv2.Client, scope string, query string) ([]*types.Integration, error) {
+			var integrations []*types.Integration
+
+			input := &apigatewayv2.GetIntegrationsInput{
+				ApiId: &query,
+			}
+
+			for {
+				out, err := client.GetIntegrations(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, integration := range out.Items {
+					integrations = append(integrations, &integration)
+				}
+
+				if out.NextToken == nil || *out.NextToken == "" {
+					break
+				}
+
+				input.NextToken = out.NextToken
+			}
+
+			return integrations, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.Integration) (*sdp.Item, error) {
+			return integrationV2OutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayV2IntegrationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-integration",
+	DescriptiveName: "API Gateway V2 Integration",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an Integration by api-id/integration-id",
+		SearchDescription: "Search Integrations by API ID",
+	},
+	PotentialLinks: []string{
+		"apigatewayv2-api",
+		"apigatewayv2-vpc-link",
+		"apigateway-vpc-link",
+		"lambda-function",
+		"elbv2-listener",
+		"sfn-state-machine",
+		"sqs-queue",
+		"events-event-bus",
+		"kinesis-stream",
+		"http",
+		"dns",
+		"iam-role",
+	},
+})
+
+var apiGatewayV2IntegrationAdapterIAMActions = IAMActions.Register(apiGatewayV2IntegrationAdapterMetadata,
+	"apigateway:GET",
+)
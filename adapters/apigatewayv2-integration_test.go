@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestIntegrationV2OutputMapper(t *testing.T) {
+	scope := "123456789012.eu-west-2"
+
+	t.Run("lambda", func(t *testing.T) {
+		integration := &types.Integration{
+			IntegrationId:        adapterhelpers.PtrString("integration-id"),
+			IntegrationType:      types.IntegrationTypeAwsProxy,
+			IntegrationUri:       adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:orders"),
+			PayloadFormatVersion: adapterhelpers.PtrString("2.0"),
+		}
+
+		item, err := integrationV2OutputMapper("api-id/integration-id", scope, integration)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		if item.UniqueAttributeValue() != "api-id/integration-id" {
+			t.Errorf("expected unique attribute api-id/integration-id, got %v", item.UniqueAttributeValue())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "apigatewayv2-api",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "api-id",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "lambda-function",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:orders",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		integration := &types.Integration{
+			ConnectionId:      adapterhelpers.PtrString("vpc-link-id"),
+			ConnectionType:    types.ConnectionTypeVpcLink,
+			IntegrationId:     adapterhelpers.PtrString("integration-id"),
+			IntegrationMethod: adapterhelpers.PtrString("ANY"),
+			IntegrationType:   types.IntegrationTypeHttpProxy,
+			IntegrationUri:    adapterhelpers.PtrString("arn:aws:elasticloadbalancing:eu-west-2:123456789012:listener/app/internal/1234567890abcdef/abcdef1234567890"),
+		}
+
+		item, err := integrationV2OutputMapper("api-id", scope, integration)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "elbv2-listener",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:listener/app/internal/1234567890abcdef/abcdef1234567890",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "apigatewayv2-vpc-link",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "vpc-link-id",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("step functions", func(t *testing.T) {
+		integration := &types.Integration{
+			CredentialsArn:     adapterhelpers.PtrString("arn:aws:iam::123456789012:role/apigateway-sfn"),
+			IntegrationId:      adapterhelpers.PtrString("integration-id"),
+			IntegrationSubtype: adapterhelpers.PtrString("StepFunctions-StartExecution"),
+			IntegrationType:    types.IntegrationTypeAwsProxy,
+			RequestParameters: map[string]string{
+				"StateMachineArn": "arn:aws:states:eu-west-2:123456789012:stateMachine:orders",
+				"Input":           "$request.body",
+			},
+		}
+
+		item, err := integrationV2OutputMapper("api-id", scope, integration)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "sfn-state-machine",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:states:eu-west-2:123456789012:stateMachine:orders",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "iam-role",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:iam::123456789012:role/apigateway-sfn",
+				ExpectedScope:  "123456789012",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+}
+
+func TestNewAPIGatewayV2IntegrationAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2IntegrationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
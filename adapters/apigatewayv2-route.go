@@ -0,0 +1,175 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetRouteOutputToRoute(output *apigatewayv2.GetRouteOutput) *types.Route {
+	return &types.Route{
+		ApiGatewayManaged:                output.ApiGatewayManaged,
+		ApiKeyRequired:                   output.ApiKeyRequired,
+		AuthorizationScopes:              output.AuthorizationScopes,
+		AuthorizationType:                output.AuthorizationType,
+		AuthorizerId:                     output.AuthorizerId,
+		ModelSelectionExpression:         output.ModelSelectionExpression,
+		OperationName:                    output.OperationName,
+		RequestModels:                    output.RequestModels,
+		RequestParameters:                output.RequestParameters,
+		RouteId:                          output.RouteId,
+		RouteKey:                         output.RouteKey,
+		RouteResponseSelectionExpression: output.RouteResponseSelectionExpression,
+		Target:                           output.Target,
+	}
+}
+
+// query: api-id/route-id for get request
+// query: api-id for search request
+func routeOutputMapper(query, scope string, awsItem *types.Route) (*sdp.Item, error) {
+	apiID, err := apigatewayv2ParseChildQuery(query, "route-id")
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", apiID, *awsItem.RouteId))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-route",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayv2APILink(apiID, scope))
+
+	// The target is in the format integrations/{integration-id}
+	if awsItem.Target != nil {
+		if integrationID, found := strings.CutPrefix(*awsItem.Target, "integrations/"); found {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "apigatewayv2-integration",
+					Method: sdp.QueryMethod_GET,
+					Query:  fmt.Sprintf("%s/%s", apiID, integrationID),
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Requests matching the route are sent to the integration,
+					// so they are tightly coupled
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.AuthorizerId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigatewayv2-authorizer",
+				Method: sdp.QueryMethod_GET,
+				Query:  fmt.Sprintf("%s/%s", apiID, *awsItem.AuthorizerId),
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the authorizer changes who can call the route
+				In: true,
+				// The route won't affect the authorizer
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2RouteAdapter(client *apigatewayv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Route, *apigatewayv2.Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Route, *apigatewayv2.Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-route",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2RouteAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigatewayv2.Client, scope, query string) (*types.Route, error) {
+			apiID, routeID, err := apigatewayv2SplitGetQuery(query, "api-id/route-id")
+			if err != nil {
+				return nil, err
+			}
+
+			out, err := client.GetRoute(ctx, &apigatewayv2.GetRouteInput{
+				ApiId:   &apiID,
+				RouteId: &routeID,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetRouteOutputToRoute(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigatewayv2.Client, scope string, query string) ([]*types.Route, error) {
+			var routes []*types.Route
+
+			input := &apigatewayv2.GetRoutesInput{
+				ApiId: &query,
+			}
+
+			for {
+				out, err := client.GetRoutes(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, route := range out.Items {
+					routes = append(routes, &route)
+				}
+
+				if out.NextToken == nil || *out.NextToken == "" {
+					break
+				}
+
+				input.NextToken = out.NextToken
+			}
+
+			return routes, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.Route) (*sdp.Item, error) {
+			return routeOutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayV2RouteAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-route",
+	DescriptiveName: "API Gateway V2 Route",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a Route by api-id/route-id",
+		SearchDescription: "Search Routes by API ID",
+	},
+	PotentialLinks: []string{
+		"apigatewayv2-api",
+		"apigatewayv2-integration",
+		"apigatewayv2-authorizer",
+	},
+})
+
+var apiGatewayV2RouteAdapterIAMActions = IAMActions.Register(apiGatewayV2RouteAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestRouteOutputMapper(t *testing.T) {
+	route := &types.Route{
+		ApiKeyRequired:    adapterhelpers.PtrBool(false),
+		AuthorizationType: types.AuthorizationTypeJwt,
+		AuthorizerId:      adapterhelpers.PtrString("authorizer-id"),
+		RouteId:           adapterhelpers.PtrString("route-id"),
+		RouteKey:          adapterhelpers.PtrString("GET /orders"),
+		Target:            adapterhelpers.PtrString("integrations/integration-id"),
+	}
+
+	item, err := routeOutputMapper("api-id/route-id", "123456789012.eu-west-2", route)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "api-id/route-id" {
+		t.Errorf("expected unique attribute api-id/route-id, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-integration",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api-id/integration-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigatewayv2-authorizer",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api-id/authorizer-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayV2RouteAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2RouteAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,166 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetStageV2OutputToStage(output *apigatewayv2.GetStageOutput) *types.Stage {
+	return &types.Stage{
+		AccessLogSettings:           output.AccessLogSettings,
+		ApiGatewayManaged:           output.ApiGatewayManaged,
+		AutoDeploy:                  output.AutoDeploy,
+		ClientCertificateId:         output.ClientCertificateId,
+		CreatedDate:                 output.CreatedDate,
+		DefaultRouteSettings:        output.DefaultRouteSettings,
+		DeploymentId:                output.DeploymentId,
+		Description:                 output.Description,
+		LastDeploymentStatusMessage: output.LastDeploymentStatusMessage,
+		LastUpdatedDate:             output.LastUpdatedDate,
+		RouteSettings:               output.RouteSettings,
+		StageName:                   output.StageName,
+		StageVariables:              output.StageVariables,
+		Tags:                        output.Tags,
+	}
+}
+
+// query: api-id/stage-name for get request
+// query: api-id for search request
+func stageV2OutputMapper(query, scope string, awsItem *types.Stage) (*sdp.Item, error) {
+	apiID, err := apigatewayv2ParseChildQuery(query, "stage-name")
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s/%s", apiID, *awsItem.StageName))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-stage",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, apigatewayv2APILink(apiID, scope))
+
+	if awsItem.AccessLogSettings != nil && awsItem.AccessLogSettings.DestinationArn != nil {
+		// The destination is either a log group or a Firehose delivery stream
+		if link := logDestinationLink(*awsItem.AccessLogSettings.DestinationArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// WebSocket APIs use the same client certificates as REST APIs
+	if awsItem.ClientCertificateId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-client-certificate",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ClientCertificateId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Backends use the certificate to authenticate API Gateway,
+				// so changing it can break requests
+				In: true,
+				// The stage won't affect the certificate
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2StageAdapter(client *apigatewayv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Stage, *apigatewayv2.Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Stage, *apigatewayv2.Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-stage",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2StageAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigatewayv2.Client, scope, query string) (*types.Stage, error) {
+			apiID, stageName, err := apigatewayv2SplitGetQuery(query, "api-id/stage-name")
+			if err != nil {
+				return nil, err
+			}
+
+			out, err := client.GetStage(ctx, &apigatewayv2.GetStageInput{
+				ApiId:     &apiID,
+				StageName: &stageName,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetStageV2OutputToStage(out), nil
+		},
+		DisableList: true,
+		SearchFunc: func(ctx context.Context, client *apigatewayv2.Client, scope string, query string) ([]*types.Stage, error) {
+			var stages []*types.Stage
+
+			input := &apigatewayv2.GetStagesInput{
+				ApiId: &query,
+			}
+
+			for {
+				out, err := client.GetStages(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, stage := range out.Items {
+					stages = append(stages, &stage)
+				}
+
+				if out.NextToken == nil || *out.NextToken == "" {
+					break
+				}
+
+				input.NextToken = out.NextToken
+			}
+
+			return stages, nil
+		},
+		ItemMapper: func(query, scope string, awsItem *types.Stage) (*sdp.Item, error) {
+			return stageV2OutputMapper(query, scope, awsItem)
+		},
+	}
+}
+
+var apiGatewayV2StageAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-stage",
+	DescriptiveName: "API Gateway V2 Stage",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a Stage by api-id/stage-name",
+		SearchDescription: "Search Stages by API ID",
+	},
+	PotentialLinks: []string{
+		"apigatewayv2-api",
+		"apigateway-client-certificate",
+		"logs-log-group",
+		"firehose-delivery-stream",
+	},
+})
+
+var apiGatewayV2StageAdapterIAMActions = IAMActions.Register(apiGatewayV2StageAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestStageV2OutputMapper(t *testing.T) {
+	stage := &types.Stage{
+		AccessLogSettings: &types.AccessLogSettings{
+			DestinationArn: adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:api-access-logs"),
+			Format:         adapterhelpers.PtrString("$context.requestId"),
+		},
+		AutoDeploy:   adapterhelpers.PtrBool(true),
+		CreatedDate:  adapterhelpers.PtrTime(time.Now()),
+		DeploymentId: adapterhelpers.PtrString("deployment-id"),
+		StageName:    adapterhelpers.PtrString("$default"),
+		Tags: map[string]string{
+			"env": "prod",
+		},
+	}
+
+	item, err := stageV2OutputMapper("api-id/$default", "123456789012.eu-west-2", stage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "api-id/$default" {
+		t.Errorf("expected unique attribute api-id/$default, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "apigatewayv2-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "api-id",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:123456789012:log-group:api-access-logs",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayV2StageAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2StageAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,157 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func convertGetVpcLinkV2OutputToVpcLink(output *apigatewayv2.GetVpcLinkOutput) *types.VpcLink {
+	return &types.VpcLink{
+		CreatedDate:          output.CreatedDate,
+		Name:                 output.Name,
+		SecurityGroupIds:     output.SecurityGroupIds,
+		SubnetIds:            output.SubnetIds,
+		Tags:                 output.Tags,
+		VpcLinkId:            output.VpcLinkId,
+		VpcLinkStatus:        output.VpcLinkStatus,
+		VpcLinkStatusMessage: output.VpcLinkStatusMessage,
+		VpcLinkVersion:       output.VpcLinkVersion,
+	}
+}
+
+func vpcLinkV2OutputMapper(_, scope string, awsItem *types.VpcLink) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "apigatewayv2-vpc-link",
+		UniqueAttribute: "VpcLinkId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	switch awsItem.VpcLinkStatus {
+	case types.VpcLinkStatusAvailable:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.VpcLinkStatusPending, types.VpcLinkStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.VpcLinkStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	case types.VpcLinkStatusInactive:
+		// Links become inactive when they haven't been used for 60 days
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	// HTTP API VPC links create network interfaces in these subnets, the
+	// load balancer or service is set on each integration instead
+	for _, subnetID := range awsItem.SubnetIds {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnetID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the subnet is deleted, the link won't work
+				In: true,
+				// The link won't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	for _, securityGroupID := range awsItem.SecurityGroupIds {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  securityGroupID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the rules can block requests through the link
+				In: true,
+				// The link won't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAPIGatewayV2VpcLinkAdapter(client *apigatewayv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.VpcLink, *apigatewayv2.Client, *apigatewayv2.Options] {
+	return &adapterhelpers.GetListAdapter[*types.VpcLink, *apigatewayv2.Client, *apigatewayv2.Options]{
+		ItemType:        "apigatewayv2-vpc-link",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apiGatewayV2VpcLinkAdapterMetadata,
+		GetFunc: func(ctx context.Context, client *apigatewayv2.Client, scope, query string) (*types.VpcLink, error) {
+			out, err := client.GetVpcLink(ctx, &apigatewayv2.GetVpcLinkInput{
+				VpcLinkId: &query,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return convertGetVpcLinkV2OutputToVpcLink(out), nil
+		},
+		ListFunc: func(ctx context.Context, client *apigatewayv2.Client, scope string) ([]*types.VpcLink, error) {
+			var vpcLinks []*types.VpcLink
+
+			input := &apigatewayv2.GetVpcLinksInput{}
+
+			for {
+				out, err := client.GetVpcLinks(ctx, input)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, vpcLink := range out.Items {
+					vpcLinks = append(vpcLinks, &vpcLink)
+				}
+
+				if out.NextToken == nil || *out.NextToken == "" {
+					break
+				}
+
+				input.NextToken = out.NextToken
+			}
+
+			return vpcLinks, nil
+		},
+		ItemMapper: vpcLinkV2OutputMapper,
+	}
+}
+
+var apiGatewayV2VpcLinkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apigatewayv2-vpc-link",
+	DescriptiveName: "API Gateway V2 VPC Link",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a VPC Link by ID",
+		ListDescription:   "List VPC Links",
+		SearchDescription: "Search VPC Links by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_apigatewayv2_vpc_link.id"},
+	},
+	PotentialLinks: []string{"ec2-subnet", "ec2-security-group"},
+})
+
+var apiGatewayV2VpcLinkAdapterIAMActions = IAMActions.Register(apiGatewayV2VpcLinkAdapterMetadata,
+	"apigateway:GET",
+)
@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
+	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVpcLinkV2OutputMapper(t *testing.T) {
+	vpcLink := &types.VpcLink{
+		Name:             adapterhelpers.PtrString("internal-services"),
+		SecurityGroupIds: []string{"sg-0123456789abcdef0"},
+		SubnetIds:        []string{"subnet-0123456789abcdef0"},
+		Tags: map[string]string{
+			"env": "prod",
+		},
+		VpcLinkId:      adapterhelpers.PtrString("vpc-link-id"),
+		VpcLinkStatus:  types.VpcLinkStatusAvailable,
+		VpcLinkVersion: types.VpcLinkVersionV2,
+	}
+
+	item, err := vpcLinkV2OutputMapper("", "123456789012.eu-west-2", vpcLink)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAPIGatewayV2VpcLinkAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := apigatewayv2.NewFromConfig(config)
+
+	adapter := NewAPIGatewayV2VpcLinkAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,167 @@
+package adapters
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// apigatewayv2APILink Links a child of an HTTP or WebSocket API back to the API
+func apigatewayv2APILink(apiID string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "apigatewayv2-api",
+			Method: sdp.QueryMethod_GET,
+			Query:  apiID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the API deletes everything in it
+			In: true,
+			// Changes to a part of the API don't affect the API itself
+			Out: false,
+		},
+	}
+}
+
+// apigatewayv2ParseChildQuery Parses the {api-id}/{child-id} format that is
+// used as the unique name of routes, integrations, authorizers and stages. The
+// query for a search is just the API ID, so that is allowed too
+func apigatewayv2ParseChildQuery(query string, childName string) (string, error) {
+	f := strings.Split(query, "/")
+
+	switch len(f) {
+	case 1, 2:
+		return f[0], nil
+	default:
+		return "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format of: the api-id/%s or api-id, but found: %s", childName, query),
+		}
+	}
+}
+
+// apigatewayv2SplitGetQuery Splits a {parent}/{child} get query into its parts
+func apigatewayv2SplitGetQuery(query string, format string) (string, string, error) {
+	f := strings.Split(query, "/")
+	if len(f) != 2 || f[0] == "" || f[1] == "" {
+		return "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format of: the %s, but found: %s", format, query),
+		}
+	}
+
+	return f[0], f[1], nil
+}
+
+// apigatewayv2HTTPLinks Links to the URL and hostname of an HTTP backend or
+// JWT issuer. URLs with path parameters such as {proxy} won't resolve, so the
+// full URL is only linked when there aren't any
+func apigatewayv2HTTPLinks(uri string) []*sdp.LinkedItemQuery {
+	var links []*sdp.LinkedItemQuery
+
+	if !strings.Contains(uri, "{") {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_GET,
+				Query:  uri,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the endpoint is unhealthy, requests will fail
+				In: true,
+				// Requests are sent to the endpoint
+				Out: true,
+			},
+		})
+	}
+
+	if u, err := url.Parse(uri); err == nil && u.Hostname() != "" && !strings.Contains(u.Hostname(), "{") {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  u.Hostname(),
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the DNS changes, requests will go somewhere else
+				In: true,
+				// Requests are sent to the endpoint
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
+
+// apigatewayv2ServiceLink Links to the resource named by a request parameter of
+// an AWS service integration. The value can be a name, an ARN or a mapping
+// expression such as $request.body.QueueUrl, which can't be linked
+func apigatewayv2ServiceLink(queryType string, value string, scope string) *sdp.LinkedItemQuery {
+	if value == "" || strings.HasPrefix(value, "$") {
+		return nil
+	}
+
+	query := &sdp.Query{
+		Type:   queryType,
+		Method: sdp.QueryMethod_GET,
+		Query:  value,
+		Scope:  scope,
+	}
+
+	if a, err := adapterhelpers.ParseARN(value); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the resource is deleted or changed, requests will fail
+			In: true,
+			// Requests are sent to the resource
+			Out: true,
+		},
+	}
+}
+
+// apigatewayv2SubtypeLinks Links the resources that an AWS service integration
+// calls. The subtype is in the format {service}-{action} e.g.
+// StepFunctions-StartExecution, and the resource is passed in the request
+// parameters
+//
+// https://docs.aws.amazon.com/apigateway/latest/developerguide/http-api-develop-integrations-aws-services-reference.html
+func apigatewayv2SubtypeLinks(subtype string, requestParameters map[string]string, scope string) []*sdp.LinkedItemQuery {
+	var queryType, parameter string
+
+	service, _, _ := strings.Cut(subtype, "-")
+
+	switch service {
+	case "StepFunctions":
+		queryType = "sfn-state-machine"
+		parameter = "StateMachineArn"
+	case "SQS":
+		queryType = "sqs-queue"
+		parameter = "QueueUrl"
+	case "EventBridge":
+		queryType = "events-event-bus"
+		parameter = "EventBusName"
+	case "Kinesis":
+		queryType = "kinesis-stream"
+		parameter = "StreamName"
+	default:
+		return nil
+	}
+
+	if link := apigatewayv2ServiceLink(queryType, requestParameters[parameter], scope); link != nil {
+		return []*sdp.LinkedItemQuery{link}
+	}
+
+	return nil
+}
@@ -60,6 +60,27 @@ func autoScalingGroupOutputMapper(_ context.Context, _ *autoscaling.Client, scop
 						})
 					}
 				}
+
+				// Each override can use a different launch template, for
+				// example to use a different AMI for Graviton instance types
+				for _, override := range asg.MixedInstancesPolicy.LaunchTemplate.Overrides {
+					if override.LaunchTemplateSpecification != nil && override.LaunchTemplateSpecification.LaunchTemplateId != nil {
+						item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+							Query: &sdp.Query{
+								Type:   "ec2-launch-template",
+								Method: sdp.QueryMethod_GET,
+								Query:  *override.LaunchTemplateSpecification.LaunchTemplateId,
+								Scope:  scope,
+							},
+							BlastPropagation: &sdp.BlastPropagation{
+								// Changes to a launch template will affect the ASG
+								In: true,
+								// Changes to an ASG won't affect the template
+								Out: false,
+							},
+						})
+					}
+				}
 			}
 		}
 
@@ -145,6 +166,70 @@ func autoScalingGroupOutputMapper(_ context.Context, _ *autoscaling.Client, scop
 			}
 		}
 
+		if asg.AutoScalingGroupName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries,
+				&sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "autoscaling-policy",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *asg.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Policies change the size of the group
+						In: true,
+						// Deleting the group deletes its policies
+						Out: true,
+					},
+				},
+				&sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "autoscaling-scheduled-action",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *asg.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Scheduled actions change the size of the group
+						In: true,
+						// Deleting the group deletes its scheduled actions
+						Out: true,
+					},
+				},
+				&sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "autoscaling-lifecycle-hook",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *asg.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Hooks can hold instances in a wait state
+						In: true,
+						// Deleting the group deletes its hooks
+						Out: true,
+					},
+				},
+			)
+
+			if asg.WarmPoolConfiguration != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "autoscaling-warm-pool",
+						Method: sdp.QueryMethod_GET,
+						Query:  *asg.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The group scales out using instances from the pool
+						In: true,
+						// Deleting the group deletes the pool
+						Out: true,
+					},
+				})
+			}
+		}
+
 		if asg.LaunchConfigurationName != nil {
 			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
 				Query: &sdp.Query{
@@ -248,5 +333,9 @@ var autoScalingGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 			TerraformMethod:   sdp.QueryMethod_SEARCH,
 		},
 	},
-	PotentialLinks: []string{"ec2-launch-template", "elbv2-target-group", "ec2-instance", "iam-role", "autoscaling-launch-configuration", "ec2-placement-group"},
+	PotentialLinks: []string{"ec2-launch-template", "elbv2-target-group", "ec2-instance", "iam-role", "autoscaling-launch-configuration", "ec2-placement-group", "autoscaling-policy", "autoscaling-scheduled-action", "autoscaling-lifecycle-hook", "autoscaling-warm-pool"},
 })
+
+var autoScalingGroupAdapterIAMActions = IAMActions.Register(autoScalingGroupAdapterMetadata,
+	"autoscaling:DescribeAutoScalingGroups",
+)
@@ -31,6 +31,12 @@ func TestAutoScalingGroupOutputMapper(t *testing.T) {
 							{
 								InstanceType: adapterhelpers.PtrString("t3.large"),
 							},
+							{
+								InstanceType: adapterhelpers.PtrString("m7g.large"),
+								LaunchTemplateSpecification: &types.LaunchTemplateSpecification{
+									LaunchTemplateId: adapterhelpers.PtrString("lt-0a1b2c3d4e5f6a7b8"), // link
+								},
+							},
 						},
 					},
 					InstancesDistribution: &types.InstancesDistribution{
@@ -178,6 +184,36 @@ func TestAutoScalingGroupOutputMapper(t *testing.T) {
 			ExpectedQuery:  "lt-0174ff2b8909d0c75",
 			ExpectedScope:  "foo",
 		},
+		{
+			ExpectedType:   "ec2-launch-template",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "lt-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "autoscaling-policy",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "eks-default-20230117110031319900000013-96c2dfb1-a11b-b5e4-6efb-0fea7e22855c",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "autoscaling-scheduled-action",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "eks-default-20230117110031319900000013-96c2dfb1-a11b-b5e4-6efb-0fea7e22855c",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "autoscaling-lifecycle-hook",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "eks-default-20230117110031319900000013-96c2dfb1-a11b-b5e4-6efb-0fea7e22855c",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "autoscaling-warm-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eks-default-20230117110031319900000013-96c2dfb1-a11b-b5e4-6efb-0fea7e22855c",
+			ExpectedScope:  "foo",
+		},
 		{
 			ExpectedType:   "elbv2-target-group",
 			ExpectedMethod: sdp.QueryMethod_SEARCH,
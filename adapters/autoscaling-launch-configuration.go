@@ -0,0 +1,204 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func launchConfigurationOutputMapper(_ context.Context, _ *autoscaling.Client, scope string, _ *autoscaling.DescribeLaunchConfigurationsInput, output *autoscaling.DescribeLaunchConfigurationsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, lc := range output.LaunchConfigurations {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(lc)
+
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "autoscaling-launch-configuration",
+			UniqueAttribute: "LaunchConfigurationName",
+			Scope:           scope,
+			Attributes:      attributes,
+		}
+
+		if lc.ImageId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-image",
+					Method: sdp.QueryMethod_GET,
+					Query:  *lc.ImageId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the image is deregistered new instances can't be
+					// launched
+					In: true,
+					// We can't affect the image
+					Out: false,
+				},
+			})
+		}
+
+		if lc.KeyName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-key-pair",
+					Method: sdp.QueryMethod_GET,
+					Query:  *lc.KeyName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the key pair will affect new instances
+					In: true,
+					// We can't affect the key pair
+					Out: false,
+				},
+			})
+		}
+
+		if lc.IamInstanceProfile != nil {
+			// This can be either the name or the ARN of the profile
+			if a, err := adapterhelpers.ParseARN(*lc.IamInstanceProfile); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-instance-profile",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *lc.IamInstanceProfile,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the profile will affect new instances
+						In: true,
+						// We can't affect the profile
+						Out: false,
+					},
+				})
+			} else {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-instance-profile",
+						Method: sdp.QueryMethod_GET,
+						Query:  *lc.IamInstanceProfile,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the profile will affect new instances
+						In: true,
+						// We can't affect the profile
+						Out: false,
+					},
+				})
+			}
+		}
+
+		for _, group := range lc.SecurityGroups {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  group,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the security group will affect new instances
+					In: true,
+					// We can't affect the security group
+					Out: false,
+				},
+			})
+		}
+
+		for _, mapping := range lc.BlockDeviceMappings {
+			if mapping.Ebs != nil && mapping.Ebs.SnapshotId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-snapshot",
+						Method: sdp.QueryMethod_GET,
+						Query:  *mapping.Ebs.SnapshotId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the snapshot is deleted new instances can't be
+						// launched
+						In: true,
+						// We can't affect the snapshot
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewAutoScalingLaunchConfigurationAdapter(client *autoscaling.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribeLaunchConfigurationsInput, *autoscaling.DescribeLaunchConfigurationsOutput, *autoscaling.Client, *autoscaling.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribeLaunchConfigurationsInput, *autoscaling.DescribeLaunchConfigurationsOutput, *autoscaling.Client, *autoscaling.Options]{
+		ItemType:        "autoscaling-launch-configuration",
+		AccountID:       accountID,
+		Region:          region,
+		Client:          client,
+		AdapterMetadata: launchConfigurationAdapterMetadata,
+		InputMapperGet: func(scope, query string) (*autoscaling.DescribeLaunchConfigurationsInput, error) {
+			return &autoscaling.DescribeLaunchConfigurationsInput{
+				LaunchConfigurationNames: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*autoscaling.DescribeLaunchConfigurationsInput, error) {
+			return &autoscaling.DescribeLaunchConfigurationsInput{}, nil
+		},
+		InputMapperSearch: func(ctx context.Context, client *autoscaling.Client, scope, query string) (*autoscaling.DescribeLaunchConfigurationsInput, error) {
+			a, err := adapterhelpers.ParseARN(query)
+			if err != nil {
+				return nil, err
+			}
+
+			name, err := autoScalingARNField(a, "launchConfigurationName")
+			if err != nil {
+				return nil, err
+			}
+
+			return &autoscaling.DescribeLaunchConfigurationsInput{
+				LaunchConfigurationNames: []string{name},
+			}, nil
+		},
+		PaginatorBuilder: func(client *autoscaling.Client, params *autoscaling.DescribeLaunchConfigurationsInput) adapterhelpers.Paginator[*autoscaling.DescribeLaunchConfigurationsOutput, *autoscaling.Options] {
+			return autoscaling.NewDescribeLaunchConfigurationsPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client *autoscaling.Client, input *autoscaling.DescribeLaunchConfigurationsInput) (*autoscaling.DescribeLaunchConfigurationsOutput, error) {
+			return client.DescribeLaunchConfigurations(ctx, input)
+		},
+		OutputMapper: launchConfigurationOutputMapper,
+	}
+}
+
+var launchConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "autoscaling-launch-configuration",
+	DescriptiveName: "Launch Configuration",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a launch configuration by name",
+		ListDescription:   "List launch configurations",
+		SearchDescription: "Search for launch configurations by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_launch_configuration.name",
+		},
+	},
+	PotentialLinks: []string{"ec2-image", "ec2-key-pair", "iam-instance-profile", "ec2-security-group", "ec2-snapshot"},
+})
+
+var launchConfigurationAdapterIAMActions = IAMActions.Register(launchConfigurationAdapterMetadata,
+	"autoscaling:DescribeLaunchConfigurations",
+)
@@ -0,0 +1,107 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestLaunchConfigurationOutputMapper(t *testing.T) {
+	output := &autoscaling.DescribeLaunchConfigurationsOutput{
+		LaunchConfigurations: []types.LaunchConfiguration{
+			{
+				CreatedTime:             adapterhelpers.PtrTime(time.Now()),
+				ImageId:                 adapterhelpers.PtrString("ami-0a1b2c3d4e5f6a7b8"), // link
+				InstanceType:            adapterhelpers.PtrString("t3.micro"),
+				LaunchConfigurationName: adapterhelpers.PtrString("web"),
+				LaunchConfigurationARN:  adapterhelpers.PtrString("arn:aws:autoscaling:eu-west-2:123456789012:launchConfiguration:8e86b655-b2e6-4410-8f29-b4f094d6871c:launchConfigurationName/web"),
+				IamInstanceProfile:      adapterhelpers.PtrString("web-profile"), // link
+				KeyName:                 adapterhelpers.PtrString("web-key"),     // link
+				SecurityGroups: []string{
+					"sg-094e151c9fc5da181", // link
+				},
+				BlockDeviceMappings: []types.BlockDeviceMapping{
+					{
+						DeviceName: adapterhelpers.PtrString("/dev/xvda"),
+						Ebs: &types.Ebs{
+							SnapshotId: adapterhelpers.PtrString("snap-0a1b2c3d4e5f6a7b8"), // link
+							VolumeSize: adapterhelpers.PtrInt32(8),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	items, err := launchConfigurationOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-key-pair",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web-key",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "iam-instance-profile",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web-profile",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-094e151c9fc5da181",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-snapshot",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "snap-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAutoScalingLaunchConfigurationAdapter(t *testing.T) {
+	client, account, region := autoscalingGetAutoConfig(t)
+
+	adapter := NewAutoScalingLaunchConfigurationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,185 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func lifecycleHookOutputMapper(_ context.Context, _ *autoscaling.Client, scope string, _ *autoscaling.DescribeLifecycleHooksInput, output *autoscaling.DescribeLifecycleHooksOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, hook := range output.LifecycleHooks {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(hook)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if hook.AutoScalingGroupName == nil || hook.LifecycleHookName == nil {
+			continue
+		}
+
+		// The uniqueAttributeValue for this is a custom field:
+		// {autoScalingGroupName}/{lifecycleHookName}
+		err = attributes.Set("UniqueName", autoScalingUniqueName(*hook.AutoScalingGroupName, *hook.LifecycleHookName))
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "autoscaling-lifecycle-hook",
+			UniqueAttribute: "UniqueName",
+			Scope:           scope,
+			Attributes:      attributes,
+			LinkedItemQueries: []*sdp.LinkedItemQuery{
+				{
+					Query: &sdp.Query{
+						Type:   "autoscaling-auto-scaling-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  *hook.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Deleting the group deletes the hook
+						In: true,
+						// The hook holds instances in a wait state until it
+						// is completed or times out
+						Out: true,
+					},
+				},
+			},
+		}
+
+		if hook.NotificationTargetARN != nil {
+			if a, err := adapterhelpers.ParseARN(*hook.NotificationTargetARN); err == nil {
+				var targetType string
+				switch a.Service {
+				case "sns":
+					targetType = "sns-topic"
+				case "sqs":
+					targetType = "sqs-queue"
+				}
+
+				if targetType != "" {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   targetType,
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  *hook.NotificationTargetARN,
+							Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// If the target is deleted then whatever is
+							// meant to complete the hook won't be notified
+							// and instances will wait for the timeout
+							In: true,
+							// The hook sends notifications to the target
+							Out: true,
+						},
+					})
+				}
+			}
+		} else {
+			// Without a notification target, lifecycle events are only sent
+			// to the default EventBridge event bus
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "events-event-bus",
+					Method: sdp.QueryMethod_GET,
+					Query:  "default",
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the event bus won't affect the hook
+					In: false,
+					// The hook sends events to the bus
+					Out: true,
+				},
+			})
+		}
+
+		if hook.RoleARN != nil {
+			if a, err := adapterhelpers.ParseARN(*hook.RoleARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-role",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *hook.RoleARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The role is used to publish to the target
+						In: true,
+						// We can't affect the role
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewAutoScalingLifecycleHookAdapter(client *autoscaling.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribeLifecycleHooksInput, *autoscaling.DescribeLifecycleHooksOutput, *autoscaling.Client, *autoscaling.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribeLifecycleHooksInput, *autoscaling.DescribeLifecycleHooksOutput, *autoscaling.Client, *autoscaling.Options]{
+		ItemType:        "autoscaling-lifecycle-hook",
+		AccountID:       accountID,
+		Region:          region,
+		Client:          client,
+		AdapterMetadata: lifecycleHookAdapterMetadata,
+		InputMapperGet: func(scope, query string) (*autoscaling.DescribeLifecycleHooksInput, error) {
+			groupName, hookName, err := parseAutoScalingUniqueName(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return &autoscaling.DescribeLifecycleHooksInput{
+				AutoScalingGroupName: &groupName,
+				LifecycleHookNames:   []string{hookName},
+			}, nil
+		},
+		// Hooks can only be described for a given Auto Scaling group, so we
+		// can't list them
+		InputMapperSearch: func(ctx context.Context, client *autoscaling.Client, scope, query string) (*autoscaling.DescribeLifecycleHooksInput, error) {
+			return &autoscaling.DescribeLifecycleHooksInput{
+				AutoScalingGroupName: &query,
+			}, nil
+		},
+		DescribeFunc: func(ctx context.Context, client *autoscaling.Client, input *autoscaling.DescribeLifecycleHooksInput) (*autoscaling.DescribeLifecycleHooksOutput, error) {
+			return client.DescribeLifecycleHooks(ctx, input)
+		},
+		OutputMapper: lifecycleHookOutputMapper,
+	}
+}
+
+var lifecycleHookAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "autoscaling-lifecycle-hook",
+	DescriptiveName: "Autoscaling Lifecycle Hook",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a lifecycle hook by {autoScalingGroupName}/{lifecycleHookName}",
+		SearchDescription: "Search for lifecycle hooks by Autoscaling Group name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			// The ID of this resource in Terraform is just the hook name
+			// which isn't unique, so we search by group name instead
+			TerraformQueryMap: "aws_autoscaling_lifecycle_hook.autoscaling_group_name",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"autoscaling-auto-scaling-group", "sns-topic", "sqs-queue", "events-event-bus", "iam-role"},
+})
+
+var lifecycleHookAdapterIAMActions = IAMActions.Register(lifecycleHookAdapterMetadata,
+	"autoscaling:DescribeLifecycleHooks",
+)
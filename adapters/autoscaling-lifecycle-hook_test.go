@@ -0,0 +1,95 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestLifecycleHookOutputMapper(t *testing.T) {
+	output := &autoscaling.DescribeLifecycleHooksOutput{
+		LifecycleHooks: []types.LifecycleHook{
+			{
+				AutoScalingGroupName:  adapterhelpers.PtrString("web"), // link
+				DefaultResult:         adapterhelpers.PtrString("CONTINUE"),
+				GlobalTimeout:         adapterhelpers.PtrInt32(172800),
+				HeartbeatTimeout:      adapterhelpers.PtrInt32(3600),
+				LifecycleHookName:     adapterhelpers.PtrString("drain"),
+				LifecycleTransition:   adapterhelpers.PtrString("autoscaling:EC2_INSTANCE_TERMINATING"),
+				NotificationTargetARN: adapterhelpers.PtrString("arn:aws:sqs:eu-west-2:123456789012:drain"),      // link
+				RoleARN:               adapterhelpers.PtrString("arn:aws:iam::123456789012:role/lifecycle-hook"), // link
+			},
+			{
+				AutoScalingGroupName: adapterhelpers.PtrString("web"),
+				DefaultResult:        adapterhelpers.PtrString("ABANDON"),
+				LifecycleHookName:    adapterhelpers.PtrString("bootstrap"),
+				LifecycleTransition:  adapterhelpers.PtrString("autoscaling:EC2_INSTANCE_LAUNCHING"),
+			},
+		},
+	}
+
+	items, err := lifecycleHookOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	t.Run("with target", func(t *testing.T) {
+		item := items[0]
+
+		if item.UniqueAttributeValue() != "web/drain" {
+			t.Errorf("expected unique attribute value to be web/drain, got %v", item.UniqueAttributeValue())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "autoscaling-auto-scaling-group",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "web",
+				ExpectedScope:  "foo",
+			},
+			{
+				ExpectedType:   "sqs-queue",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:drain",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "iam-role",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:iam::123456789012:role/lifecycle-hook",
+				ExpectedScope:  "123456789012",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("without target", func(t *testing.T) {
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "events-event-bus",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "default",
+				ExpectedScope:  "foo",
+			},
+		}
+
+		tests.Execute(t, items[1])
+	})
+}
@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func scalingPolicyOutputMapper(_ context.Context, _ *autoscaling.Client, scope string, _ *autoscaling.DescribePoliciesInput, output *autoscaling.DescribePoliciesOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, policy := range output.ScalingPolicies {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(policy)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if policy.AutoScalingGroupName == nil || policy.PolicyName == nil {
+			continue
+		}
+
+		// The uniqueAttributeValue for this is a custom field:
+		// {autoScalingGroupName}/{policyName}
+		err = attributes.Set("UniqueName", autoScalingUniqueName(*policy.AutoScalingGroupName, *policy.PolicyName))
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "autoscaling-policy",
+			UniqueAttribute: "UniqueName",
+			Scope:           scope,
+			Attributes:      attributes,
+		}
+
+		if policy.Enabled != nil && !*policy.Enabled {
+			// A disabled policy won't scale the group when its alarms fire
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "autoscaling-auto-scaling-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *policy.AutoScalingGroupName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the group deletes the policy
+				In: true,
+				// The policy changes the size of the group
+				Out: true,
+			},
+		})
+
+		for _, alarm := range policy.Alarms {
+			if alarm.AlarmName == nil {
+				continue
+			}
+
+			alarmScope := scope
+			if alarm.AlarmARN != nil {
+				if a, err := adapterhelpers.ParseARN(*alarm.AlarmARN); err == nil {
+					alarmScope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+				}
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "cloudwatch-alarm",
+					Method: sdp.QueryMethod_GET,
+					Query:  *alarm.AlarmName,
+					Scope:  alarmScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The alarm triggers the policy
+					In: true,
+					// For target tracking policies the alarms are managed by
+					// the policy, and will be changed along with it
+					Out: true,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewAutoScalingPolicyAdapter(client *autoscaling.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribePoliciesInput, *autoscaling.DescribePoliciesOutput, *autoscaling.Client, *autoscaling.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribePoliciesInput, *autoscaling.DescribePoliciesOutput, *autoscaling.Client, *autoscaling.Options]{
+		ItemType:        "autoscaling-policy",
+		AccountID:       accountID,
+		Region:          region,
+		Client:          client,
+		AdapterMetadata: scalingPolicyAdapterMetadata,
+		InputMapperGet: func(scope, query string) (*autoscaling.DescribePoliciesInput, error) {
+			groupName, policyName, err := parseAutoScalingUniqueName(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return &autoscaling.DescribePoliciesInput{
+				AutoScalingGroupName: &groupName,
+				PolicyNames:          []string{policyName},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*autoscaling.DescribePoliciesInput, error) {
+			return &autoscaling.DescribePoliciesInput{}, nil
+		},
+		InputMapperSearch: func(ctx context.Context, client *autoscaling.Client, scope, query string) (*autoscaling.DescribePoliciesInput, error) {
+			// Policy names can be specified as ARNs, which is what CloudWatch
+			// alarms use for their actions
+			if _, err := adapterhelpers.ParseARN(query); err == nil {
+				return &autoscaling.DescribePoliciesInput{
+					PolicyNames: []string{query},
+				}, nil
+			}
+
+			// Otherwise assume that this is the name of the Auto Scaling group
+			return &autoscaling.DescribePoliciesInput{
+				AutoScalingGroupName: &query,
+			}, nil
+		},
+		PaginatorBuilder: func(client *autoscaling.Client, params *autoscaling.DescribePoliciesInput) adapterhelpers.Paginator[*autoscaling.DescribePoliciesOutput, *autoscaling.Options] {
+			return autoscaling.NewDescribePoliciesPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client *autoscaling.Client, input *autoscaling.DescribePoliciesInput) (*autoscaling.DescribePoliciesOutput, error) {
+			return client.DescribePolicies(ctx, input)
+		},
+		OutputMapper: scalingPolicyOutputMapper,
+	}
+}
+
+var scalingPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "autoscaling-policy",
+	DescriptiveName: "Autoscaling Policy",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a scaling policy by {autoScalingGroupName}/{policyName}",
+		ListDescription:   "List scaling policies",
+		SearchDescription: "Search for scaling policies by ARN or Autoscaling Group name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_autoscaling_policy.arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"autoscaling-auto-scaling-group", "cloudwatch-alarm"},
+})
+
+var scalingPolicyAdapterIAMActions = IAMActions.Register(scalingPolicyAdapterMetadata,
+	"autoscaling:DescribePolicies",
+)
@@ -0,0 +1,91 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestScalingPolicyOutputMapper(t *testing.T) {
+	output := &autoscaling.DescribePoliciesOutput{
+		ScalingPolicies: []types.ScalingPolicy{
+			{
+				AdjustmentType: adapterhelpers.PtrString("ChangeInCapacity"),
+				Alarms: []types.Alarm{
+					{
+						AlarmARN:  adapterhelpers.PtrString("arn:aws:cloudwatch:eu-west-2:123456789012:alarm:high-cpu"),
+						AlarmName: adapterhelpers.PtrString("high-cpu"), // link
+					},
+				},
+				AutoScalingGroupName: adapterhelpers.PtrString("web"), // link
+				Enabled:              adapterhelpers.PtrBool(false),
+				PolicyARN:            adapterhelpers.PtrString("arn:aws:autoscaling:eu-west-2:123456789012:scalingPolicy:8e86b655-b2e6-4410-8f29-b4f094d6871c:autoScalingGroupName/web:policyName/scale-out"),
+				PolicyName:           adapterhelpers.PtrString("scale-out"),
+				PolicyType:           adapterhelpers.PtrString("SimpleScaling"),
+				ScalingAdjustment:    adapterhelpers.PtrInt32(1),
+			},
+		},
+	}
+
+	items, err := scalingPolicyOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.UniqueAttributeValue() != "web/scale-out" {
+		t.Errorf("expected unique attribute value to be web/scale-out, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "autoscaling-auto-scaling-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cloudwatch-alarm",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "high-cpu",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAutoScalingPolicyAdapter(t *testing.T) {
+	client, account, region := autoscalingGetAutoConfig(t)
+
+	adapter := NewAutoScalingPolicyAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,139 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func scheduledActionOutputMapper(_ context.Context, _ *autoscaling.Client, scope string, _ *autoscaling.DescribeScheduledActionsInput, output *autoscaling.DescribeScheduledActionsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, action := range output.ScheduledUpdateGroupActions {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(action)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if action.AutoScalingGroupName == nil || action.ScheduledActionName == nil {
+			continue
+		}
+
+		// The uniqueAttributeValue for this is a custom field:
+		// {autoScalingGroupName}/{scheduledActionName}
+		err = attributes.Set("UniqueName", autoScalingUniqueName(*action.AutoScalingGroupName, *action.ScheduledActionName))
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "autoscaling-scheduled-action",
+			UniqueAttribute: "UniqueName",
+			Scope:           scope,
+			Attributes:      attributes,
+			LinkedItemQueries: []*sdp.LinkedItemQuery{
+				{
+					Query: &sdp.Query{
+						Type:   "autoscaling-auto-scaling-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  *action.AutoScalingGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Deleting the group deletes the action
+						In: true,
+						// The action changes the size of the group
+						Out: true,
+					},
+				},
+			},
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewAutoScalingScheduledActionAdapter(client *autoscaling.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribeScheduledActionsInput, *autoscaling.DescribeScheduledActionsOutput, *autoscaling.Client, *autoscaling.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribeScheduledActionsInput, *autoscaling.DescribeScheduledActionsOutput, *autoscaling.Client, *autoscaling.Options]{
+		ItemType:        "autoscaling-scheduled-action",
+		AccountID:       accountID,
+		Region:          region,
+		Client:          client,
+		AdapterMetadata: scheduledActionAdapterMetadata,
+		InputMapperGet: func(scope, query string) (*autoscaling.DescribeScheduledActionsInput, error) {
+			groupName, actionName, err := parseAutoScalingUniqueName(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return &autoscaling.DescribeScheduledActionsInput{
+				AutoScalingGroupName: &groupName,
+				ScheduledActionNames: []string{actionName},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*autoscaling.DescribeScheduledActionsInput, error) {
+			return &autoscaling.DescribeScheduledActionsInput{}, nil
+		},
+		InputMapperSearch: func(ctx context.Context, client *autoscaling.Client, scope, query string) (*autoscaling.DescribeScheduledActionsInput, error) {
+			if a, err := adapterhelpers.ParseARN(query); err == nil {
+				groupName, err := autoScalingARNField(a, "autoScalingGroupName")
+				if err != nil {
+					return nil, err
+				}
+
+				actionName, err := autoScalingARNField(a, "scheduledActionName")
+				if err != nil {
+					return nil, err
+				}
+
+				return &autoscaling.DescribeScheduledActionsInput{
+					AutoScalingGroupName: &groupName,
+					ScheduledActionNames: []string{actionName},
+				}, nil
+			}
+
+			// Otherwise assume that this is the name of the Auto Scaling group
+			return &autoscaling.DescribeScheduledActionsInput{
+				AutoScalingGroupName: &query,
+			}, nil
+		},
+		PaginatorBuilder: func(client *autoscaling.Client, params *autoscaling.DescribeScheduledActionsInput) adapterhelpers.Paginator[*autoscaling.DescribeScheduledActionsOutput, *autoscaling.Options] {
+			return autoscaling.NewDescribeScheduledActionsPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client *autoscaling.Client, input *autoscaling.DescribeScheduledActionsInput) (*autoscaling.DescribeScheduledActionsOutput, error) {
+			return client.DescribeScheduledActions(ctx, input)
+		},
+		OutputMapper: scheduledActionOutputMapper,
+	}
+}
+
+var scheduledActionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "autoscaling-scheduled-action",
+	DescriptiveName: "Autoscaling Scheduled Action",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a scheduled action by {autoScalingGroupName}/{scheduledActionName}",
+		ListDescription:   "List scheduled actions",
+		SearchDescription: "Search for scheduled actions by ARN or Autoscaling Group name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_autoscaling_schedule.arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"autoscaling-auto-scaling-group"},
+})
+
+var scheduledActionAdapterIAMActions = IAMActions.Register(scheduledActionAdapterMetadata,
+	"autoscaling:DescribeScheduledActions",
+)
@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestScheduledActionOutputMapper(t *testing.T) {
+	output := &autoscaling.DescribeScheduledActionsOutput{
+		ScheduledUpdateGroupActions: []types.ScheduledUpdateGroupAction{
+			{
+				AutoScalingGroupName: adapterhelpers.PtrString("web"), // link
+				DesiredCapacity:      adapterhelpers.PtrInt32(0),
+				MaxSize:              adapterhelpers.PtrInt32(0),
+				MinSize:              adapterhelpers.PtrInt32(0),
+				Recurrence:           adapterhelpers.PtrString("0 20 * * *"),
+				ScheduledActionARN:   adapterhelpers.PtrString("arn:aws:autoscaling:eu-west-2:123456789012:scheduledUpdateGroupAction:8e86b655-b2e6-4410-8f29-b4f094d6871c:autoScalingGroupName/web:scheduledActionName/nightly"),
+				ScheduledActionName:  adapterhelpers.PtrString("nightly"),
+				StartTime:            adapterhelpers.PtrTime(time.Now()),
+				TimeZone:             adapterhelpers.PtrString("Europe/London"),
+			},
+		},
+	}
+
+	items, err := scheduledActionOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.UniqueAttributeValue() != "web/nightly" {
+		t.Errorf("expected unique attribute value to be web/nightly, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "autoscaling-auto-scaling-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAutoScalingScheduledActionAdapter(t *testing.T) {
+	client, account, region := autoscalingGetAutoConfig(t)
+
+	adapter := NewAutoScalingScheduledActionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
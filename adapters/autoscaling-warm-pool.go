@@ -0,0 +1,152 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// warmPool The warm pool configuration doesn't include the name of the group
+// that it belongs to, so we add it
+type warmPool struct {
+	AutoScalingGroupName string
+	types.WarmPoolConfiguration
+	Instances []types.Instance
+}
+
+func warmPoolOutputMapper(_ context.Context, _ *autoscaling.Client, scope string, input *autoscaling.DescribeWarmPoolInput, output *autoscaling.DescribeWarmPoolOutput) ([]*sdp.Item, error) {
+	if input == nil || input.AutoScalingGroupName == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "Auto Scaling group name must be set on the input",
+			Scope:       scope,
+		}
+	}
+
+	// Groups without a warm pool return an empty configuration
+	if output.WarmPoolConfiguration == nil {
+		return []*sdp.Item{}, nil
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(warmPool{
+		AutoScalingGroupName:  *input.AutoScalingGroupName,
+		WarmPoolConfiguration: *output.WarmPoolConfiguration,
+		Instances:             output.Instances,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "autoscaling-warm-pool",
+		UniqueAttribute: "AutoScalingGroupName",
+		Scope:           scope,
+		Attributes:      attributes,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "autoscaling-auto-scaling-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *input.AutoScalingGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the group deletes the warm pool
+					In: true,
+					// The group scales out using instances from the pool
+					Out: true,
+				},
+			},
+		},
+	}
+
+	if output.WarmPoolConfiguration.Status == types.WarmPoolStatusPendingDelete {
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	} else {
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	for _, instance := range output.Instances {
+		if instance.InstanceId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-instance",
+					Method: sdp.QueryMethod_GET,
+					Query:  *instance.InstanceId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Instances that fail to warm up will be replaced
+					In: true,
+					// Changes to the pool will replace the instances
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return []*sdp.Item{&item}, nil
+}
+
+func NewAutoScalingWarmPoolAdapter(client *autoscaling.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribeWarmPoolInput, *autoscaling.DescribeWarmPoolOutput, *autoscaling.Client, *autoscaling.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*autoscaling.DescribeWarmPoolInput, *autoscaling.DescribeWarmPoolOutput, *autoscaling.Client, *autoscaling.Options]{
+		ItemType:        "autoscaling-warm-pool",
+		AccountID:       accountID,
+		Region:          region,
+		Client:          client,
+		AdapterMetadata: warmPoolAdapterMetadata,
+		InputMapperGet: func(scope, query string) (*autoscaling.DescribeWarmPoolInput, error) {
+			if query == "" {
+				return nil, errors.New("query must be the name of an Auto Scaling group")
+			}
+
+			return &autoscaling.DescribeWarmPoolInput{
+				AutoScalingGroupName: &query,
+			}, nil
+		},
+		DescribeFunc: func(ctx context.Context, client *autoscaling.Client, input *autoscaling.DescribeWarmPoolInput) (*autoscaling.DescribeWarmPoolOutput, error) {
+			// The instances in the pool are paginated, so collect them all
+			// into a single output
+			var combined *autoscaling.DescribeWarmPoolOutput
+
+			paginator := autoscaling.NewDescribeWarmPoolPaginator(client, input)
+
+			for paginator.HasMorePages() {
+				out, err := paginator.NextPage(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				if combined == nil {
+					combined = out
+				} else {
+					combined.Instances = append(combined.Instances, out.Instances...)
+				}
+			}
+
+			return combined, nil
+		},
+		OutputMapper: warmPoolOutputMapper,
+	}
+}
+
+var warmPoolAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "autoscaling-warm-pool",
+	DescriptiveName: "Autoscaling Warm Pool",
+	Category:        sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:            true,
+		GetDescription: "Get the warm pool for an Autoscaling Group by group name",
+	},
+	PotentialLinks: []string{"autoscaling-auto-scaling-group", "ec2-instance"},
+})
+
+var warmPoolAdapterIAMActions = IAMActions.Register(warmPoolAdapterMetadata,
+	"autoscaling:DescribeWarmPool",
+)
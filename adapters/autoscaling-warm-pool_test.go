@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestWarmPoolOutputMapper(t *testing.T) {
+	input := &autoscaling.DescribeWarmPoolInput{
+		AutoScalingGroupName: adapterhelpers.PtrString("web"),
+	}
+
+	t.Run("with a warm pool", func(t *testing.T) {
+		output := &autoscaling.DescribeWarmPoolOutput{
+			WarmPoolConfiguration: &types.WarmPoolConfiguration{
+				MinSize:   adapterhelpers.PtrInt32(1),
+				PoolState: types.WarmPoolStateStopped,
+			},
+			Instances: []types.Instance{
+				{
+					AvailabilityZone: adapterhelpers.PtrString("eu-west-2a"),
+					HealthStatus:     adapterhelpers.PtrString("Healthy"),
+					InstanceId:       adapterhelpers.PtrString("i-04c7b2794f7bc3d6a"), // link
+					LifecycleState:   types.LifecycleStateWarmedStopped,
+				},
+			},
+		}
+
+		items, err := warmPoolOutputMapper(context.Background(), nil, "foo", input, output)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, item := range items {
+			if err := item.Validate(); err != nil {
+				t.Error(err)
+			}
+		}
+
+		if len(items) != 1 {
+			t.Fatalf("expected 1 item, got %v", len(items))
+		}
+
+		item := items[0]
+
+		if item.UniqueAttributeValue() != "web" {
+			t.Errorf("expected unique attribute value to be web, got %v", item.UniqueAttributeValue())
+		}
+
+		if item.GetHealth() != sdp.Health_HEALTH_OK {
+			t.Errorf("expected health to be OK, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "autoscaling-auto-scaling-group",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "web",
+				ExpectedScope:  "foo",
+			},
+			{
+				ExpectedType:   "ec2-instance",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "i-04c7b2794f7bc3d6a",
+				ExpectedScope:  "foo",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("without a warm pool", func(t *testing.T) {
+		items, err := warmPoolOutputMapper(context.Background(), nil, "foo", input, &autoscaling.DescribeWarmPoolOutput{})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 0 {
+			t.Errorf("expected 0 items, got %v", len(items))
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+// autoScalingUniqueNameFormat Policies, scheduled actions and lifecycle hooks
+// are only unique within their Auto Scaling group, so we identify them by
+// {autoScalingGroupName}/{name}
+const autoScalingUniqueNameFormat = "{autoScalingGroupName}/{name}"
+
+// autoScalingUniqueName Creates the unique name for a resource that belongs
+// to an Auto Scaling group
+func autoScalingUniqueName(groupName string, name string) string {
+	return fmt.Sprintf("%v/%v", groupName, name)
+}
+
+// parseAutoScalingUniqueName Splits a query in the format
+// {autoScalingGroupName}/{name}. We split on the first "/" so that the
+// resource name itself can contain one
+func parseAutoScalingUniqueName(query string) (string, string, error) {
+	groupName, name, found := strings.Cut(query, "/")
+
+	if !found || groupName == "" || name == "" {
+		return "", "", fmt.Errorf("invalid query, expected in the format of %s, got: %s", autoScalingUniqueNameFormat, query)
+	}
+
+	return groupName, name, nil
+}
+
+// autoScalingARNField Extracts a named field from the resource section of an
+// Auto Scaling ARN. These look like:
+//
+// arn:aws:autoscaling:eu-west-2:123456789012:launchConfiguration:{uuid}:launchConfigurationName/{name}
+//
+// So calling this with a field of "launchConfigurationName" would return the
+// name
+func autoScalingARNField(arn *adapterhelpers.ARN, field string) (string, error) {
+	for _, section := range strings.Split(arn.Resource, ":") {
+		if value, found := strings.CutPrefix(section, field+"/"); found {
+			return value, nil
+		}
+	}
+
+	return "", fmt.Errorf("ARN %v does not contain a %v", arn.String(), field)
+}
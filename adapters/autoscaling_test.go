@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func autoscalingGetAutoConfig(t *testing.T) (*autoscaling.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := autoscaling.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestParseAutoScalingUniqueName(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		groupName, name, err := parseAutoScalingUniqueName("my-asg/scale/out")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if groupName != "my-asg" {
+			t.Errorf("expected group name my-asg, got %v", groupName)
+		}
+
+		if name != "scale/out" {
+			t.Errorf("expected name scale/out, got %v", name)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, query := range []string{"my-asg", "my-asg/", "/scale-out"} {
+			if _, _, err := parseAutoScalingUniqueName(query); err == nil {
+				t.Errorf("expected error for %v", query)
+			}
+		}
+	})
+}
+
+func TestAutoScalingARNField(t *testing.T) {
+	a, err := adapterhelpers.ParseARN("arn:aws:autoscaling:eu-west-2:123456789012:scheduledUpdateGroupAction:8e86b655-b2e6-4410-8f29-b4f094d6871c:autoScalingGroupName/my-asg:scheduledActionName/scale-down")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	groupName, err := autoScalingARNField(a, "autoScalingGroupName")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if groupName != "my-asg" {
+		t.Errorf("expected group name my-asg, got %v", groupName)
+	}
+
+	actionName, err := autoScalingARNField(a, "scheduledActionName")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actionName != "scale-down" {
+		t.Errorf("expected action name scale-down, got %v", actionName)
+	}
+
+	if _, err := autoScalingARNField(a, "policyName"); err == nil {
+		t.Error("expected error for missing field")
+	}
+}
@@ -92,3 +92,8 @@ var cachePolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_cloudfront_cache_policy.id"},
 	},
 })
+
+var cachePolicyAdapterIAMActions = IAMActions.Register(cachePolicyAdapterMetadata,
+	"cloudfront:GetCachePolicy",
+	"cloudfront:ListCachePolicies",
+)
@@ -99,3 +99,8 @@ var continuousDeploymentPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMe
 	PotentialLinks: []string{"dns"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var continuousDeploymentPolicyAdapterIAMActions = IAMActions.Register(continuousDeploymentPolicyAdapterMetadata,
+	"cloudfront:GetContinuousDeploymentPolicy",
+	"cloudfront:ListContinuousDeploymentPolicies",
+)
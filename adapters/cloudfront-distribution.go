@@ -695,3 +695,9 @@ var distributionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		"s3-bucket",
 	},
 })
+
+var distributionAdapterIAMActions = IAMActions.Register(distributionAdapterMetadata,
+	"cloudfront:GetDistribution",
+	"cloudfront:ListDistributions",
+	"cloudfront:ListTagsForResource",
+)
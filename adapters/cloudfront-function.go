@@ -82,3 +82,8 @@ var cloudfrontFunctionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var cloudfrontFunctionAdapterIAMActions = IAMActions.Register(cloudfrontFunctionAdapterMetadata,
+	"cloudfront:DescribeFunction",
+	"cloudfront:ListFunctions",
+)
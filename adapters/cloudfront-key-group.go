@@ -80,3 +80,8 @@ var keyGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_cloudfront_key_group.id"},
 	},
 })
+
+var keyGroupAdapterIAMActions = IAMActions.Register(keyGroupAdapterMetadata,
+	"cloudfront:GetKeyGroup",
+	"cloudfront:ListKeyGroups",
+)
@@ -94,3 +94,8 @@ var originAccessControlAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_cloudfront_origin_access_control.id"},
 	},
 })
+
+var originAccessControlAdapterIAMActions = IAMActions.Register(originAccessControlAdapterMetadata,
+	"cloudfront:GetOriginAccessControl",
+	"cloudfront:ListOriginAccessControls",
+)
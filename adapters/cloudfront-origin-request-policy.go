@@ -80,3 +80,8 @@ var originRequestPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_cloudfront_origin_request_policy.id"},
 	},
 })
+
+var originRequestPolicyAdapterIAMActions = IAMActions.Register(originRequestPolicyAdapterMetadata,
+	"cloudfront:GetOriginRequestPolicy",
+	"cloudfront:ListOriginRequestPolicies",
+)
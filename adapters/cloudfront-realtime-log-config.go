@@ -125,3 +125,8 @@ var realtimeLogConfigsAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		},
 	},
 })
+
+var realtimeLogConfigsAdapterIAMActions = IAMActions.Register(realtimeLogConfigsAdapterMetadata,
+	"cloudfront:GetRealtimeLogConfig",
+	"cloudfront:ListRealtimeLogConfigs",
+)
@@ -80,3 +80,8 @@ var responseHeadersPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var responseHeadersPolicyAdapterIAMActions = IAMActions.Register(responseHeadersPolicyAdapterMetadata,
+	"cloudfront:GetResponseHeadersPolicy",
+	"cloudfront:ListResponseHeadersPolicies",
+)
@@ -209,3 +209,9 @@ var streamingDistributionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	PotentialLinks: []string{"dns"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var streamingDistributionAdapterIAMActions = IAMActions.Register(streamingDistributionAdapterMetadata,
+	"cloudfront:GetStreamingDistribution",
+	"cloudfront:ListStreamingDistributions",
+	"cloudfront:ListTagsForResource",
+)
@@ -258,6 +258,12 @@ var cloudwatchAlarmAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
 
+var cloudwatchAlarmAdapterIAMActions = IAMActions.Register(cloudwatchAlarmAdapterMetadata,
+	"cloudwatch:DescribeAlarms",
+	"cloudwatch:DescribeAlarmsForMetric",
+	"cloudwatch:ListTagsForResource",
+)
+
 // actionToLink converts an action string to a link to the resource that the
 // action refers to. The actions to execute when this alarm transitions to the
 // ALARM state from any other state. Each action is specified as an Amazon
@@ -140,3 +140,7 @@ var directconnectConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetad
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var directconnectConnectionAdapterIAMActions = IAMActions.Register(directconnectConnectionAdapterMetadata,
+	"directconnect:DescribeConnections",
+)
@@ -66,3 +66,7 @@ var customerMetadataAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var customerMetadataAdapterIAMActions = IAMActions.Register(customerMetadataAdapterMetadata,
+	"directconnect:DescribeCustomerMetadata",
+)
@@ -89,3 +89,7 @@ var directConnectGatewayAssociationProposalAdapterMetadata = Metadata.Register(&
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 	PotentialLinks: []string{"directconnect-direct-connect-gateway-association"},
 })
+
+var directConnectGatewayAssociationProposalAdapterIAMActions = IAMActions.Register(directConnectGatewayAssociationProposalAdapterMetadata,
+	"directconnect:DescribeDirectConnectGatewayAssociationProposals",
+)
@@ -143,6 +143,10 @@ var directConnectGatewayAssociationAdapterMetadata = Metadata.Register(&sdp.Adap
 	PotentialLinks: []string{"directconnect-direct-connect-gateway"},
 })
 
+var directConnectGatewayAssociationAdapterIAMActions = IAMActions.Register(directConnectGatewayAssociationAdapterMetadata,
+	"directconnect:DescribeDirectConnectGatewayAssociations",
+)
+
 // parseDirectConnectGatewayAssociationGetInputQuery expects a query:
 //   - in the format of "directConnectGatewayID/virtualGatewayID"
 //   - virtualGatewayID => associatedGatewayID
@@ -134,6 +134,10 @@ var directConnectGatewayAttachmentAdapterMetadata = Metadata.Register(&sdp.Adapt
 	PotentialLinks: []string{"directconnect-direct-connect-gateway", "directconnect-virtual-interface"},
 })
 
+var directConnectGatewayAttachmentAdapterIAMActions = IAMActions.Register(directConnectGatewayAttachmentAdapterMetadata,
+	"directconnect:DescribeDirectConnectGatewayAttachments",
+)
+
 // parseGatewayIDVirtualInterfaceID expects a query in the format of "gatewayID/virtualInterfaceID"
 // First returned item is gatewayID, second is virtualInterfaceID
 func parseGatewayIDVirtualInterfaceID(query string) (string, string, error) {
@@ -114,3 +114,8 @@ var directConnectGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var directConnectGatewayAdapterIAMActions = IAMActions.Register(directConnectGatewayAdapterMetadata,
+	"directconnect:DescribeDirectConnectGateways",
+	"directconnect:DescribeTags",
+)
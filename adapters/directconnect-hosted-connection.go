@@ -140,3 +140,7 @@ var hostedConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"directconnect-lag", "directconnect-location", "directconnect-loa", "directconnect-virtual-interface"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var hostedConnectionAdapterIAMActions = IAMActions.Register(hostedConnectionAdapterMetadata,
+	"directconnect:DescribeHostedConnections",
+)
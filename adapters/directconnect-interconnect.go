@@ -155,3 +155,7 @@ var interconnectAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		SearchDescription: "Search Interconnects by ARN",
 	},
 })
+
+var interconnectAdapterIAMActions = IAMActions.Register(interconnectAdapterMetadata,
+	"directconnect:DescribeInterconnects",
+)
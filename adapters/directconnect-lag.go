@@ -144,3 +144,7 @@ var lagAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var lagAdapterIAMActions = IAMActions.Register(lagAdapterMetadata,
+	"directconnect:DescribeLags",
+)
@@ -69,3 +69,7 @@ var directconnectLocationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var directconnectLocationAdapterIAMActions = IAMActions.Register(directconnectLocationAdapterMetadata,
+	"directconnect:DescribeLocations",
+)
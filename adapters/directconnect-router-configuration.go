@@ -81,3 +81,7 @@ var routerConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_dx_router_configuration.virtual_interface_id"},
 	},
 })
+
+var routerConfigurationAdapterIAMActions = IAMActions.Register(routerConfigurationAdapterMetadata,
+	"directconnect:DescribeRouterConfiguration",
+)
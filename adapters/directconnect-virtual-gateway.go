@@ -66,3 +66,7 @@ var virtualGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var virtualGatewayAdapterIAMActions = IAMActions.Register(virtualGatewayAdapterMetadata,
+	"directconnect:DescribeVirtualGateways",
+)
@@ -187,3 +187,7 @@ var virtualInterfaceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var virtualInterfaceAdapterIAMActions = IAMActions.Register(virtualInterfaceAdapterMetadata,
+	"directconnect:DescribeVirtualInterfaces",
+)
@@ -125,6 +125,11 @@ var dynamodbBackupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
 
+var dynamodbBackupAdapterIAMActions = IAMActions.Register(dynamodbBackupAdapterMetadata,
+	"dynamodb:DescribeBackup",
+	"dynamodb:ListBackups",
+)
+
 // Another AWS API that doesn't provide a paginator *and* does pagination
 // completely differently from everything else? You don't say.
 //
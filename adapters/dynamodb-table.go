@@ -217,3 +217,10 @@ var dynamodbTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformMethod: sdp.QueryMethod_SEARCH, TerraformQueryMap: "aws_dynamodb_table.arn"},
 	},
 })
+
+var dynamodbTableAdapterIAMActions = IAMActions.Register(dynamodbTableAdapterMetadata,
+	"dynamodb:DescribeKinesisStreamingDestination",
+	"dynamodb:DescribeTable",
+	"dynamodb:ListTables",
+	"dynamodb:ListTagsOfResource",
+)
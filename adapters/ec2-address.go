@@ -169,3 +169,7 @@ var addressAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	PotentialLinks: []string{"ec2-instance", "ip", "ec2-network-interface"},
 })
+
+var addressAdapterIAMActions = IAMActions.Register(addressAdapterMetadata,
+	"ec2:DescribeAddresses",
+)
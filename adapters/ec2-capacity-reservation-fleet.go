@@ -113,3 +113,7 @@ var capacityReservationFleetAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 	},
 	PotentialLinks: []string{"ec2-capacity-reservation"},
 })
+
+var capacityReservationFleetAdapterIAMActions = IAMActions.Register(capacityReservationFleetAdapterMetadata,
+	"ec2:DescribeCapacityReservationFleets",
+)
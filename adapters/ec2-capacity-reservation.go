@@ -130,3 +130,7 @@ var capacityReservationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"outposts-outpost", "ec2-placement-group", "ec2-capacity-reservation-fleet"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var capacityReservationAdapterIAMActions = IAMActions.Register(capacityReservationAdapterMetadata,
+	"ec2:DescribeCapacityReservations",
+)
@@ -0,0 +1,136 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func customerGatewayInputMapperGet(scope string, query string) (*ec2.DescribeCustomerGatewaysInput, error) {
+	return &ec2.DescribeCustomerGatewaysInput{
+		CustomerGatewayIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func customerGatewayInputMapperList(scope string) (*ec2.DescribeCustomerGatewaysInput, error) {
+	return &ec2.DescribeCustomerGatewaysInput{}, nil
+}
+
+func customerGatewayOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeCustomerGatewaysInput, output *ec2.DescribeCustomerGatewaysOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("empty output")
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, gateway := range output.CustomerGateways {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(gateway, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-customer-gateway",
+			UniqueAttribute: "CustomerGatewayId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(gateway.Tags),
+		}
+
+		if gateway.State != nil {
+			switch *gateway.State {
+			case "available":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "pending", "deleting":
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			}
+		}
+
+		if gateway.IpAddress != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ip",
+					Method: sdp.QueryMethod_GET,
+					Query:  *gateway.IpAddress,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// IPs always link
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if gateway.CustomerGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpn-connection",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *gateway.CustomerGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The connections don't affect the customer gateway
+					In: false,
+					// Changing the customer gateway will affect all of the
+					// connections that use it
+					Out: true,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2CustomerGatewayAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeCustomerGatewaysInput, *ec2.DescribeCustomerGatewaysOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeCustomerGatewaysInput, *ec2.DescribeCustomerGatewaysOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-customer-gateway",
+		AdapterMetadata: customerGatewayAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeCustomerGatewaysInput) (*ec2.DescribeCustomerGatewaysOutput, error) {
+			return client.DescribeCustomerGateways(ctx, input)
+		},
+		InputMapperGet:  customerGatewayInputMapperGet,
+		InputMapperList: customerGatewayInputMapperList,
+		OutputMapper:    customerGatewayOutputMapper,
+	}
+}
+
+var customerGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-customer-gateway",
+	DescriptiveName: "Customer Gateway",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a customer gateway by ID",
+		ListDescription:   "List all customer gateways",
+		SearchDescription: "Search for customer gateways by ARN",
+	},
+	PotentialLinks: []string{"ip", "ec2-vpn-connection"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_customer_gateway.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var customerGatewayAdapterIAMActions = IAMActions.Register(customerGatewayAdapterMetadata,
+	"ec2:DescribeCustomerGateways",
+)
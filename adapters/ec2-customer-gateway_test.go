@@ -0,0 +1,101 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCustomerGatewayInputMapperGet(t *testing.T) {
+	input, err := customerGatewayInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.CustomerGatewayIds) != 1 {
+		t.Fatalf("expected 1 customer gateway ID, got %v", len(input.CustomerGatewayIds))
+	}
+
+	if input.CustomerGatewayIds[0] != "bar" {
+		t.Errorf("expected customer gateway ID to be bar, got %v", input.CustomerGatewayIds[0])
+	}
+}
+
+func TestCustomerGatewayOutputMapper(t *testing.T) {
+	output := &ec2.DescribeCustomerGatewaysOutput{
+		CustomerGateways: []types.CustomerGateway{
+			{
+				BgpAsn:            adapterhelpers.PtrString("65000"),
+				CustomerGatewayId: adapterhelpers.PtrString("cgw-0a1b2c3d4e5f6a7b8"),
+				IpAddress:         adapterhelpers.PtrString("198.51.100.1"),
+				State:             adapterhelpers.PtrString("available"),
+				Type:              adapterhelpers.PtrString("ipsec.1"),
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("Name"),
+						Value: adapterhelpers.PtrString("office"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := customerGatewayOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "198.51.100.1",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-vpn-connection",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "cgw-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2CustomerGatewayAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2CustomerGatewayAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
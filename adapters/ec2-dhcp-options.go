@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"context"
+	"net"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func dhcpOptionsInputMapperGet(scope string, query string) (*ec2.DescribeDhcpOptionsInput, error) {
+	return &ec2.DescribeDhcpOptionsInput{
+		DhcpOptionsIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func dhcpOptionsInputMapperList(scope string) (*ec2.DescribeDhcpOptionsInput, error) {
+	return &ec2.DescribeDhcpOptionsInput{}, nil
+}
+
+func dhcpOptionsOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeDhcpOptionsInput, output *ec2.DescribeDhcpOptionsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, options := range output.DhcpOptions {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(options, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-dhcp-options",
+			UniqueAttribute: "DhcpOptionsId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(options.Tags),
+		}
+
+		for _, config := range options.DhcpConfigurations {
+			if config.Key == nil {
+				continue
+			}
+
+			for _, value := range config.Values {
+				if value.Value == nil {
+					continue
+				}
+
+				switch *config.Key {
+				case "domain-name-servers", "ntp-servers", "netbios-name-servers":
+					// DNS servers can also be "AmazonProvidedDNS" which isn't
+					// something we can link to
+					if net.ParseIP(*value.Value) == nil {
+						continue
+					}
+
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ip",
+							Method: sdp.QueryMethod_GET,
+							Query:  *value.Value,
+							Scope:  "global",
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// If the server goes away then everything using
+							// these options will be affected
+							In: true,
+							// The options don't affect the server
+							Out: false,
+						},
+					})
+				case "domain-name":
+					// This can be a space separated list but in practice is
+					// usually a single domain
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "dns",
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  *value.Value,
+							Scope:  "global",
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// The domain is only used as a search suffix
+							In:  false,
+							Out: false,
+						},
+					})
+				}
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2DhcpOptionsAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeDhcpOptionsInput, *ec2.DescribeDhcpOptionsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeDhcpOptionsInput, *ec2.DescribeDhcpOptionsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-dhcp-options",
+		AdapterMetadata: dhcpOptionsAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeDhcpOptionsInput) (*ec2.DescribeDhcpOptionsOutput, error) {
+			return client.DescribeDhcpOptions(ctx, input)
+		},
+		InputMapperGet:  dhcpOptionsInputMapperGet,
+		InputMapperList: dhcpOptionsInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeDhcpOptionsInput) adapterhelpers.Paginator[*ec2.DescribeDhcpOptionsOutput, *ec2.Options] {
+			return ec2.NewDescribeDhcpOptionsPaginator(client, params)
+		},
+		OutputMapper: dhcpOptionsOutputMapper,
+	}
+}
+
+var dhcpOptionsAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-dhcp-options",
+	DescriptiveName: "DHCP Options",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a DHCP option set by ID",
+		ListDescription:   "List all DHCP option sets",
+		SearchDescription: "Search for DHCP option sets by ARN",
+	},
+	PotentialLinks: []string{"ip", "dns"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_vpc_dhcp_options.id"},
+		{TerraformQueryMap: "aws_vpc_dhcp_options_association.dhcp_options_id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var dhcpOptionsAdapterIAMActions = IAMActions.Register(dhcpOptionsAdapterMetadata,
+	"ec2:DescribeDhcpOptions",
+)
@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestDhcpOptionsInputMapperGet(t *testing.T) {
+	input, err := dhcpOptionsInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.DhcpOptionsIds) != 1 {
+		t.Fatalf("expected 1 DHCP options ID, got %v", len(input.DhcpOptionsIds))
+	}
+
+	if input.DhcpOptionsIds[0] != "bar" {
+		t.Errorf("expected DHCP options ID to be bar, got %v", input.DhcpOptionsIds[0])
+	}
+}
+
+func TestDhcpOptionsOutputMapper(t *testing.T) {
+	output := &ec2.DescribeDhcpOptionsOutput{
+		DhcpOptions: []types.DhcpOptions{
+			{
+				DhcpConfigurations: []types.DhcpConfiguration{
+					{
+						Key: adapterhelpers.PtrString("domain-name"),
+						Values: []types.AttributeValue{
+							{
+								Value: adapterhelpers.PtrString("corp.example.com"),
+							},
+						},
+					},
+					{
+						Key: adapterhelpers.PtrString("domain-name-servers"),
+						Values: []types.AttributeValue{
+							{
+								Value: adapterhelpers.PtrString("AmazonProvidedDNS"),
+							},
+							{
+								Value: adapterhelpers.PtrString("10.0.0.2"),
+							},
+						},
+					},
+					{
+						Key: adapterhelpers.PtrString("ntp-servers"),
+						Values: []types.AttributeValue{
+							{
+								Value: adapterhelpers.PtrString("169.254.169.123"),
+							},
+						},
+					},
+				},
+				DhcpOptionsId: adapterhelpers.PtrString("dopt-0959b838bf4a4c7b8"),
+				OwnerId:       adapterhelpers.PtrString("052392120703"),
+			},
+		},
+	}
+
+	items, err := dhcpOptionsOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	// AmazonProvidedDNS shouldn't be linked
+	if len(item.GetLinkedItemQueries()) != 3 {
+		t.Errorf("expected 3 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "corp.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.0.2",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "169.254.169.123",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2DhcpOptionsAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2DhcpOptionsAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -106,3 +106,7 @@ var egressOnlyInternetGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMet
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var egressOnlyInternetGatewayAdapterIAMActions = IAMActions.Register(egressOnlyInternetGatewayAdapterMetadata,
+	"ec2:DescribeEgressOnlyInternetGateways",
+)
@@ -0,0 +1,249 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// fleetLaunchTemplateLinks Returns the link to the launch template used by an
+// EC2 fleet or spot fleet. Templates can also be specified by name, but we
+// can only look them up by ID
+func fleetLaunchTemplateLinks(scope string, spec *types.FleetLaunchTemplateSpecification) []*sdp.LinkedItemQuery {
+	if spec == nil || spec.LaunchTemplateId == nil {
+		return nil
+	}
+
+	return []*sdp.LinkedItemQuery{
+		{
+			Query: &sdp.Query{
+				Type:   "ec2-launch-template",
+				Method: sdp.QueryMethod_GET,
+				Query:  *spec.LaunchTemplateId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the launch template will affect the instances
+				// that the fleet launches
+				In: true,
+				// Changes to the fleet won't affect the template
+				Out: false,
+			},
+		},
+	}
+}
+
+// fleetInstanceLinks Returns links to the instances that are running in a
+// fleet
+func fleetInstanceLinks(scope string, instanceIDs []string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, id := range instanceIDs {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-instance",
+				Method: sdp.QueryMethod_GET,
+				Query:  id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The instances don't affect the fleet
+				In: false,
+				// Changing the fleet's capacity or configuration will
+				// launch or terminate instances
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
+
+// ec2FleetInstanceIDs Gets the IDs of the running instances in a fleet of
+// type maintain or request. Instant fleets return their instances in the
+// description instead
+func ec2FleetInstanceIDs(ctx context.Context, client *ec2.Client, fleetID string) ([]string, error) {
+	ids := make([]string, 0)
+
+	input := &ec2.DescribeFleetInstancesInput{
+		FleetId: &fleetID,
+	}
+
+	for {
+		out, err := client.DescribeFleetInstances(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, instance := range out.ActiveInstances {
+			if instance.InstanceId != nil {
+				ids = append(ids, *instance.InstanceId)
+			}
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return ids, nil
+}
+
+func fleetInputMapperGet(scope string, query string) (*ec2.DescribeFleetsInput, error) {
+	return &ec2.DescribeFleetsInput{
+		FleetIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func fleetInputMapperList(scope string) (*ec2.DescribeFleetsInput, error) {
+	return &ec2.DescribeFleetsInput{}, nil
+}
+
+func fleetOutputMapper(ctx context.Context, client *ec2.Client, scope string, _ *ec2.DescribeFleetsInput, output *ec2.DescribeFleetsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, fleet := range output.Fleets {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(fleet, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-fleet",
+			UniqueAttribute: "FleetId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(fleet.Tags),
+		}
+
+		switch fleet.FleetState {
+		case types.FleetStateCodeActive:
+			switch fleet.ActivityStatus {
+			case types.FleetActivityStatusError:
+				// The fleet is unable to launch or terminate instances
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			case types.FleetActivityStatusPendingFulfillment, types.FleetActivityStatusPendingTermination:
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			default:
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			}
+		case types.FleetStateCodeSubmitted, types.FleetStateCodeModifying, types.FleetStateCodeDeletedRunning, types.FleetStateCodeDeletedTerminatingInstances:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.FleetStateCodeFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		for _, config := range fleet.LaunchTemplateConfigs {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, fleetLaunchTemplateLinks(scope, config.LaunchTemplateSpecification)...)
+
+			for _, override := range config.Overrides {
+				if override.SubnetId != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ec2-subnet",
+							Method: sdp.QueryMethod_GET,
+							Query:  *override.SubnetId,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Changing the subnet will affect where instances
+							// are launched
+							In: true,
+							// We can't affect the subnet
+							Out: false,
+						},
+					})
+				}
+
+				if override.ImageId != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ec2-image",
+							Method: sdp.QueryMethod_GET,
+							Query:  *override.ImageId,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// If the image is deregistered new instances can't
+							// be launched
+							In: true,
+							// We can't affect the image
+							Out: false,
+						},
+					})
+				}
+			}
+		}
+
+		if fleet.Type == types.FleetTypeInstant {
+			for _, instances := range fleet.Instances {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, fleetInstanceLinks(scope, instances.InstanceIds)...)
+			}
+		} else if client != nil && fleet.FleetId != nil {
+			// If we can't get the instances we still want to return the
+			// fleet
+			if ids, err := ec2FleetInstanceIDs(ctx, client, *fleet.FleetId); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, fleetInstanceLinks(scope, ids)...)
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2FleetAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeFleetsInput, *ec2.DescribeFleetsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeFleetsInput, *ec2.DescribeFleetsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-fleet",
+		AdapterMetadata: fleetAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeFleetsInput) (*ec2.DescribeFleetsOutput, error) {
+			return client.DescribeFleets(ctx, input)
+		},
+		InputMapperGet:  fleetInputMapperGet,
+		InputMapperList: fleetInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeFleetsInput) adapterhelpers.Paginator[*ec2.DescribeFleetsOutput, *ec2.Options] {
+			return ec2.NewDescribeFleetsPaginator(client, params)
+		},
+		OutputMapper: fleetOutputMapper,
+	}
+}
+
+var fleetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-fleet",
+	DescriptiveName: "EC2 Fleet",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an EC2 fleet by ID",
+		ListDescription:   "List all EC2 fleets",
+		SearchDescription: "Search for EC2 fleets by ARN",
+	},
+	PotentialLinks: []string{"ec2-launch-template", "ec2-subnet", "ec2-image", "ec2-instance"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_fleet.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var fleetAdapterIAMActions = IAMActions.Register(fleetAdapterMetadata,
+	"ec2:DescribeFleets",
+	"ec2:DescribeFleetInstances",
+)
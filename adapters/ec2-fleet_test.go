@@ -0,0 +1,135 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFleetInputMapperGet(t *testing.T) {
+	input, err := fleetInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.FleetIds) != 1 {
+		t.Fatalf("expected 1 fleet ID, got %v", len(input.FleetIds))
+	}
+
+	if input.FleetIds[0] != "bar" {
+		t.Errorf("expected fleet ID to be bar, got %v", input.FleetIds[0])
+	}
+}
+
+func TestFleetOutputMapper(t *testing.T) {
+	output := &ec2.DescribeFleetsOutput{
+		Fleets: []types.FleetData{
+			{
+				ActivityStatus: types.FleetActivityStatusFulfilled,
+				CreateTime:     adapterhelpers.PtrTime(time.Now()),
+				FleetId:        adapterhelpers.PtrString("fleet-0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d"),
+				FleetState:     types.FleetStateCodeActive,
+				Instances: []types.DescribeFleetsInstances{
+					{
+						InstanceIds: []string{
+							"i-04c7b2794f7bc3d6a", // link
+						},
+						InstanceType: types.InstanceTypeM5Large,
+						Lifecycle:    types.InstanceLifecycleSpot,
+					},
+				},
+				LaunchTemplateConfigs: []types.FleetLaunchTemplateConfig{
+					{
+						LaunchTemplateSpecification: &types.FleetLaunchTemplateSpecification{
+							LaunchTemplateId: adapterhelpers.PtrString("lt-0174ff2b8909d0c75"), // link
+							Version:          adapterhelpers.PtrString("1"),
+						},
+						Overrides: []types.FleetLaunchTemplateOverrides{
+							{
+								ImageId:      adapterhelpers.PtrString("ami-0a1b2c3d4e5f6a7b8"), // link
+								InstanceType: types.InstanceTypeM5Large,
+								SubnetId:     adapterhelpers.PtrString("subnet-0450a637af9984235"), // link
+							},
+						},
+					},
+				},
+				TargetCapacitySpecification: &types.TargetCapacitySpecification{
+					DefaultTargetCapacityType: types.DefaultTargetCapacityTypeSpot,
+					TotalTargetCapacity:       adapterhelpers.PtrInt32(1),
+				},
+				Type: types.FleetTypeInstant,
+			},
+		},
+	}
+
+	items, err := fleetOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-launch-template",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "lt-0174ff2b8909d0c75",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-04c7b2794f7bc3d6a",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2FleetAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2FleetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
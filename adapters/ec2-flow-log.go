@@ -0,0 +1,260 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func flowLogInputMapperGet(scope string, query string) (*ec2.DescribeFlowLogsInput, error) {
+	return &ec2.DescribeFlowLogsInput{
+		FlowLogIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func flowLogInputMapperList(scope string) (*ec2.DescribeFlowLogsInput, error) {
+	return &ec2.DescribeFlowLogsInput{}, nil
+}
+
+// flowLogInputMapperSearch Searches for flow logs by the ID of the resource
+// that they are monitoring e.g. a VPC, subnet or network interface. ARNs are
+// also supported
+func flowLogInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.DescribeFlowLogsInput, error) {
+	if arn, err := adapterhelpers.ParseARN(query); err == nil {
+		return &ec2.DescribeFlowLogsInput{
+			FlowLogIds: []string{
+				arn.ResourceID(),
+			},
+		}, nil
+	}
+
+	return &ec2.DescribeFlowLogsInput{
+		Filter: []types.Filter{
+			{
+				Name:   adapterhelpers.PtrString("resource-id"),
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+// flowLogResourceType Returns the item type of the resource that a flow log is
+// monitoring based on its ID
+func flowLogResourceType(resourceID string) string {
+	switch {
+	case strings.HasPrefix(resourceID, "vpc-"):
+		return "ec2-vpc"
+	case strings.HasPrefix(resourceID, "subnet-"):
+		return "ec2-subnet"
+	case strings.HasPrefix(resourceID, "eni-"):
+		return "ec2-network-interface"
+	case strings.HasPrefix(resourceID, "tgw-attach-"):
+		return "ec2-transit-gateway-attachment"
+	case strings.HasPrefix(resourceID, "tgw-"):
+		return "ec2-transit-gateway"
+	default:
+		return ""
+	}
+}
+
+func flowLogOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeFlowLogsInput, output *ec2.DescribeFlowLogsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	for _, flowLog := range output.FlowLogs {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(flowLog, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-flow-log",
+			UniqueAttribute: "FlowLogId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(flowLog.Tags),
+		}
+
+		if flowLog.DeliverLogsStatus != nil {
+			switch *flowLog.DeliverLogsStatus {
+			case "SUCCESS":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "FAILED":
+				// Logs aren't being delivered, the reason is in
+				// DeliverLogsErrorMessage
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			}
+		}
+
+		if flowLog.ResourceId != nil {
+			if resourceType := flowLogResourceType(*flowLog.ResourceId); resourceType != "" {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   resourceType,
+						Method: sdp.QueryMethod_GET,
+						Query:  *flowLog.ResourceId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Deleting the resource will stop the flow log
+						In: true,
+						// The flow log doesn't affect the resource
+						Out: false,
+					},
+				})
+			}
+		}
+
+		switch flowLog.LogDestinationType {
+		case types.LogDestinationTypeCloudWatchLogs:
+			if flowLog.LogGroupName != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "logs-log-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  *flowLog.LogGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Deleting the log group will cause delivery to fail
+						In: true,
+						// The flow log sends logs to the group
+						Out: true,
+					},
+				})
+			}
+		case types.LogDestinationTypeS3:
+			// The destination is the ARN of the bucket, optionally followed by
+			// a folder e.g. arn:aws:s3:::my-bucket/my-prefix
+			if flowLog.LogDestination != nil {
+				if arn, err := adapterhelpers.ParseARN(*flowLog.LogDestination); err == nil {
+					bucketName, _, _ := strings.Cut(arn.Resource, "/")
+
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "s3-bucket",
+							Method: sdp.QueryMethod_GET,
+							Query:  bucketName,
+							Scope:  adapterhelpers.FormatScope(accountID, ""),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Changes to the bucket and its policy can cause
+							// delivery to fail
+							In: true,
+							// The flow log sends logs to the bucket
+							Out: true,
+						},
+					})
+				}
+			}
+		case types.LogDestinationTypeKinesisDataFirehose:
+			if flowLog.LogDestination != nil {
+				if arn, err := adapterhelpers.ParseARN(*flowLog.LogDestination); err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "firehose-delivery-stream",
+							Method: sdp.QueryMethod_GET,
+							Query:  arn.ResourceID(),
+							Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Deleting the stream will cause delivery to fail
+							In: true,
+							// The flow log sends logs to the stream
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+
+		for _, roleARN := range []*string{flowLog.DeliverLogsPermissionArn, flowLog.DeliverCrossAccountRole} {
+			if roleARN == nil {
+				continue
+			}
+
+			if arn, err := adapterhelpers.ParseARN(*roleARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-role",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *roleARN,
+						Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The role is used to deliver the logs
+						In: true,
+						// The flow log can't affect the role
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2FlowLogAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeFlowLogsInput, *ec2.DescribeFlowLogsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeFlowLogsInput, *ec2.DescribeFlowLogsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-flow-log",
+		AdapterMetadata: flowLogAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeFlowLogsInput) (*ec2.DescribeFlowLogsOutput, error) {
+			return client.DescribeFlowLogs(ctx, input)
+		},
+		InputMapperGet:    flowLogInputMapperGet,
+		InputMapperList:   flowLogInputMapperList,
+		InputMapperSearch: flowLogInputMapperSearch,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeFlowLogsInput) adapterhelpers.Paginator[*ec2.DescribeFlowLogsOutput, *ec2.Options] {
+			return ec2.NewDescribeFlowLogsPaginator(client, params)
+		},
+		OutputMapper: flowLogOutputMapper,
+	}
+}
+
+var flowLogAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-flow-log",
+	DescriptiveName: "VPC Flow Log",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a flow log by ID",
+		ListDescription:   "List all flow logs",
+		SearchDescription: "Search for flow logs by ARN, or by the ID of the VPC, subnet, network interface or transit gateway that they monitor",
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-network-interface", "ec2-transit-gateway", "ec2-transit-gateway-attachment", "logs-log-group", "s3-bucket", "firehose-delivery-stream", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_flow_log.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
+
+var flowLogAdapterIAMActions = IAMActions.Register(flowLogAdapterMetadata,
+	"ec2:DescribeFlowLogs",
+)
@@ -0,0 +1,179 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFlowLogInputMapperSearch(t *testing.T) {
+	t.Run("resource ID", func(t *testing.T) {
+		input, err := flowLogInputMapperSearch(context.Background(), nil, "foo", "vpc-0d7892e00e573e701")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.Filter) != 1 || *input.Filter[0].Name != "resource-id" || input.Filter[0].Values[0] != "vpc-0d7892e00e573e701" {
+			t.Errorf("expected resource-id filter, got %v", input.Filter)
+		}
+	})
+
+	t.Run("ARN", func(t *testing.T) {
+		input, err := flowLogInputMapperSearch(context.Background(), nil, "foo", "arn:aws:ec2:eu-west-2:123456789012:vpc-flow-log/fl-0a1b2c3d4e5f6a7b8")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.FlowLogIds) != 1 || input.FlowLogIds[0] != "fl-0a1b2c3d4e5f6a7b8" {
+			t.Errorf("expected flow log ID fl-0a1b2c3d4e5f6a7b8, got %v", input.FlowLogIds)
+		}
+	})
+}
+
+func TestFlowLogOutputMapper(t *testing.T) {
+	output := &ec2.DescribeFlowLogsOutput{
+		FlowLogs: []types.FlowLog{
+			{
+				CreationTime:             adapterhelpers.PtrTime(time.Now()),
+				DeliverLogsPermissionArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/flow-logs"),
+				DeliverLogsStatus:        adapterhelpers.PtrString("SUCCESS"),
+				FlowLogId:                adapterhelpers.PtrString("fl-0a1b2c3d4e5f6a7b8"),
+				FlowLogStatus:            adapterhelpers.PtrString("ACTIVE"),
+				LogDestinationType:       types.LogDestinationTypeCloudWatchLogs,
+				LogGroupName:             adapterhelpers.PtrString("vpc-flow-logs"),
+				ResourceId:               adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+				TrafficType:              types.TrafficTypeAll,
+			},
+			{
+				CreationTime:       adapterhelpers.PtrTime(time.Now()),
+				DeliverLogsStatus:  adapterhelpers.PtrString("FAILED"),
+				FlowLogId:          adapterhelpers.PtrString("fl-0b1c2d3e4f5a6b7c8"),
+				FlowLogStatus:      adapterhelpers.PtrString("ACTIVE"),
+				LogDestination:     adapterhelpers.PtrString("arn:aws:s3:::flow-log-bucket/vpc/"),
+				LogDestinationType: types.LogDestinationTypeS3,
+				ResourceId:         adapterhelpers.PtrString("subnet-0450a637af9984235"),
+				TrafficType:        types.TrafficTypeReject,
+			},
+			{
+				CreationTime:       adapterhelpers.PtrTime(time.Now()),
+				DeliverLogsStatus:  adapterhelpers.PtrString("SUCCESS"),
+				FlowLogId:          adapterhelpers.PtrString("fl-0c1d2e3f4a5b6c7d8"),
+				FlowLogStatus:      adapterhelpers.PtrString("ACTIVE"),
+				LogDestination:     adapterhelpers.PtrString("arn:aws:firehose:eu-west-2:210987654321:deliverystream/flow-logs"),
+				LogDestinationType: types.LogDestinationTypeKinesisDataFirehose,
+				ResourceId:         adapterhelpers.PtrString("eni-0b4652e6f2aa36d78"),
+				TrafficType:        types.TrafficTypeAccept,
+			},
+		},
+	}
+
+	items, err := flowLogOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %v", len(items))
+	}
+
+	t.Run("CloudWatch Logs", func(t *testing.T) {
+		item := items[0]
+
+		if item.GetHealth() != sdp.Health_HEALTH_OK {
+			t.Errorf("expected health to be OK, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-vpc",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "vpc-0d7892e00e573e701",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "logs-log-group",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "vpc-flow-logs",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "iam-role",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:iam::123456789012:role/flow-logs",
+				ExpectedScope:  "123456789012",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("S3", func(t *testing.T) {
+		item := items[1]
+
+		if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+			t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-subnet",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "subnet-0450a637af9984235",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "s3-bucket",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "flow-log-bucket",
+				ExpectedScope:  "123456789012",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("Firehose", func(t *testing.T) {
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-network-interface",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "eni-0b4652e6f2aa36d78",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "firehose-delivery-stream",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "flow-logs",
+				ExpectedScope:  "210987654321.eu-west-2",
+			},
+		}
+
+		tests.Execute(t, items[2])
+	})
+}
+
+func TestNewEC2FlowLogAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2FlowLogAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func hostInputMapperGet(scope string, query string) (*ec2.DescribeHostsInput, error) {
+	return &ec2.DescribeHostsInput{
+		HostIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func hostInputMapperList(scope string) (*ec2.DescribeHostsInput, error) {
+	return &ec2.DescribeHostsInput{}, nil
+}
+
+func hostOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeHostsInput, output *ec2.DescribeHostsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	_, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	for _, host := range output.Hosts {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(host, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-host",
+			UniqueAttribute: "HostId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(host.Tags),
+		}
+
+		switch host.State {
+		case types.AllocationStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.AllocationStatePending:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.AllocationStateUnderAssessment:
+			// AWS is investigating a possible problem with the host
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case types.AllocationStatePermanentFailure, types.AllocationStateReleasedPermanentFailure:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		for _, instance := range host.Instances {
+			if instance.InstanceId == nil {
+				continue
+			}
+
+			// Hosts can be shared, in which case the instances might belong
+			// to another account
+			instanceScope := scope
+			if instance.OwnerId != nil {
+				instanceScope = adapterhelpers.FormatScope(*instance.OwnerId, region)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-instance",
+					Method: sdp.QueryMethod_GET,
+					Query:  *instance.InstanceId,
+					Scope:  instanceScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Instances don't affect the host
+					In: false,
+					// If the host fails or is released, the instances on it
+					// go with it
+					Out: true,
+				},
+			})
+		}
+
+		if host.OutpostArn != nil {
+			if arn, err := adapterhelpers.ParseARN(*host.OutpostArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "outposts-outpost",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *host.OutpostArn,
+						Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the outpost will affect the host
+						In: true,
+						// Changing the host won't affect the outpost
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2HostAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeHostsInput, *ec2.DescribeHostsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeHostsInput, *ec2.DescribeHostsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-host",
+		AdapterMetadata: hostAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeHostsInput) (*ec2.DescribeHostsOutput, error) {
+			return client.DescribeHosts(ctx, input)
+		},
+		InputMapperGet:  hostInputMapperGet,
+		InputMapperList: hostInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeHostsInput) adapterhelpers.Paginator[*ec2.DescribeHostsOutput, *ec2.Options] {
+			return ec2.NewDescribeHostsPaginator(client, params)
+		},
+		OutputMapper: hostOutputMapper,
+	}
+}
+
+var hostAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-host",
+	DescriptiveName: "Dedicated Host",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a dedicated host by ID",
+		ListDescription:   "List all dedicated hosts",
+		SearchDescription: "Search for dedicated hosts by ARN",
+	},
+	PotentialLinks: []string{"ec2-instance", "outposts-outpost"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_host.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var hostAdapterIAMActions = IAMActions.Register(hostAdapterMetadata,
+	"ec2:DescribeHosts",
+)
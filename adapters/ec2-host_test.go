@@ -0,0 +1,125 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestHostInputMapperGet(t *testing.T) {
+	input, err := hostInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.HostIds) != 1 {
+		t.Fatalf("expected 1 host ID, got %v", len(input.HostIds))
+	}
+
+	if input.HostIds[0] != "bar" {
+		t.Errorf("expected host ID to be bar, got %v", input.HostIds[0])
+	}
+}
+
+func TestHostOutputMapper(t *testing.T) {
+	output := &ec2.DescribeHostsOutput{
+		Hosts: []types.Host{
+			{
+				AllocationTime:   adapterhelpers.PtrTime(time.Now()),
+				AutoPlacement:    types.AutoPlacementOn,
+				AvailabilityZone: adapterhelpers.PtrString("eu-west-2a"),
+				AvailableCapacity: &types.AvailableCapacity{
+					AvailableVCpus: adapterhelpers.PtrInt32(60),
+				},
+				HostId: adapterhelpers.PtrString("h-0a1b2c3d4e5f6a7b8"),
+				HostProperties: &types.HostProperties{
+					Cores:        adapterhelpers.PtrInt32(36),
+					InstanceType: adapterhelpers.PtrString("c5.large"),
+					Sockets:      adapterhelpers.PtrInt32(2),
+					TotalVCpus:   adapterhelpers.PtrInt32(72),
+				},
+				HostRecovery: types.HostRecoveryOn,
+				Instances: []types.HostInstance{
+					{
+						InstanceId:   adapterhelpers.PtrString("i-04c7b2794f7bc3d6a"), // link
+						InstanceType: adapterhelpers.PtrString("c5.large"),
+						OwnerId:      adapterhelpers.PtrString("123456789012"),
+					},
+					{
+						InstanceId:   adapterhelpers.PtrString("i-0b1c2d3e4f5a6b7c8"), // link
+						InstanceType: adapterhelpers.PtrString("c5.large"),
+						OwnerId:      adapterhelpers.PtrString("210987654321"),
+					},
+				},
+				OutpostArn: adapterhelpers.PtrString("arn:aws:outposts:eu-west-2:123456789012:outpost/op-0a1b2c3d4e5f6a7b8"), // link
+				OwnerId:    adapterhelpers.PtrString("123456789012"),
+				State:      types.AllocationStateUnderAssessment,
+			},
+		},
+	}
+
+	items, err := hostOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-04c7b2794f7bc3d6a",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0b1c2d3e4f5a6b7c8",
+			ExpectedScope:  "210987654321.eu-west-2",
+		},
+		{
+			ExpectedType:   "outposts-outpost",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:outposts:eu-west-2:123456789012:outpost/op-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2HostAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2HostAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
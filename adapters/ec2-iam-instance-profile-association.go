@@ -105,3 +105,7 @@ var iamInstanceProfileAssociationAdapterMetadata = Metadata.Register(&sdp.Adapte
 	PotentialLinks: []string{"iam-instance-profile", "ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var iamInstanceProfileAssociationAdapterIAMActions = IAMActions.Register(iamInstanceProfileAssociationAdapterMetadata,
+	"ec2:DescribeIamInstanceProfileAssociations",
+)
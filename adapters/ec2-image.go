@@ -94,3 +94,7 @@ var imageAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var imageAdapterIAMActions = IAMActions.Register(imageAdapterMetadata,
+	"ec2:DescribeImages",
+)
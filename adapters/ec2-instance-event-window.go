@@ -118,3 +118,7 @@ var instanceEventWindowAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-host", "ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var instanceEventWindowAdapterIAMActions = IAMActions.Register(instanceEventWindowAdapterMetadata,
+	"ec2:DescribeInstanceEventWindows",
+)
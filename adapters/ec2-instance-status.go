@@ -110,3 +110,7 @@ var instanceStatusAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var instanceStatusAdapterIAMActions = IAMActions.Register(instanceStatusAdapterMetadata,
+	"ec2:DescribeInstanceStatus",
+)
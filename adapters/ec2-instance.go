@@ -238,6 +238,41 @@ func instanceOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2
 				})
 			}
 
+			// Instances launched by a fleet are tagged with the fleet's ID
+			if fleetID, ok := item.GetTags()["aws:ec2:fleet-id"]; ok {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-fleet",
+						Method: sdp.QueryMethod_GET,
+						Query:  fleetID,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the fleet can terminate the instance
+						In: true,
+						// Changing the instance won't affect the fleet
+						Out: false,
+					},
+				})
+			}
+
+			if fleetID, ok := item.GetTags()["aws:ec2spot:fleet-request-id"]; ok {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-spot-fleet-request",
+						Method: sdp.QueryMethod_GET,
+						Query:  fleetID,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the fleet can terminate the instance
+						In: true,
+						// Changing the instance won't affect the fleet
+						Out: false,
+					},
+				})
+			}
+
 			if instance.ImageId != nil {
 				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
 					Query: &sdp.Query{
@@ -274,6 +309,23 @@ func instanceOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2
 			}
 
 			if instance.Placement != nil {
+				if instance.Placement.HostId != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ec2-host",
+							Method: sdp.QueryMethod_GET,
+							Query:  *instance.Placement.HostId,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// If the host fails the instance fails with it
+							In: true,
+							// Changing an instance won't affect the host
+							Out: false,
+						},
+					})
+				}
+
 				if instance.Placement.GroupId != nil {
 					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
 						Query: &sdp.Query{
@@ -484,7 +536,7 @@ func NewEC2InstanceAdapter(client *ec2.Client, accountID string, region string)
 var ec2InstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Type:            "ec2-instance",
 	DescriptiveName: "EC2 Instance",
-	PotentialLinks:  []string{"ec2-instance-status", "iam-instance-profile", "ec2-capacity-reservation", "ec2-elastic-gpu", "elastic-inference-accelerator", "license-manager-license-configuration", "outposts-outpost", "ec2-spot-instance-request", "ec2-image", "ec2-key-pair", "ec2-placement-group", "ip", "ec2-subnet", "ec2-vpc", "dns", "ec2-security-group", "ec2-volume"},
+	PotentialLinks:  []string{"ec2-instance-status", "iam-instance-profile", "ec2-capacity-reservation", "ec2-elastic-gpu", "elastic-inference-accelerator", "license-manager-license-configuration", "outposts-outpost", "ec2-spot-instance-request", "ec2-fleet", "ec2-spot-fleet-request", "ec2-host", "ec2-image", "ec2-key-pair", "ec2-placement-group", "ip", "ec2-subnet", "ec2-vpc", "dns", "ec2-security-group", "ec2-volume"},
 	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
 		Get:               true,
 		List:              true,
@@ -505,3 +557,7 @@ var ec2InstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		},
 	},
 })
+
+var ec2InstanceAdapterIAMActions = IAMActions.Register(ec2InstanceAdapterMetadata,
+	"ec2:DescribeInstances",
+)
@@ -101,6 +101,7 @@ func TestInstanceOutputMapper(t *testing.T) {
 							AvailabilityZone: adapterhelpers.PtrString("eu-west-2c"), // link
 							GroupName:        adapterhelpers.PtrString(""),
 							GroupId:          adapterhelpers.PtrString("groupId"),
+							HostId:           adapterhelpers.PtrString("h-0a1b2c3d4e5f6a7b8"), // link
 							Tenancy:          types.TenancyDefault,
 						},
 						PrivateDnsName:   adapterhelpers.PtrString("ip-172-31-95-79.eu-west-2.compute.internal"),
@@ -181,6 +182,14 @@ func TestInstanceOutputMapper(t *testing.T) {
 								Key:   adapterhelpers.PtrString("Name"),
 								Value: adapterhelpers.PtrString("test"),
 							},
+							{
+								Key:   adapterhelpers.PtrString("aws:ec2:fleet-id"),
+								Value: adapterhelpers.PtrString("fleet-0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d"), // link
+							},
+							{
+								Key:   adapterhelpers.PtrString("aws:ec2spot:fleet-request-id"),
+								Value: adapterhelpers.PtrString("sfr-0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d"), // link
+							},
 						},
 						VirtualizationType: types.VirtualizationTypeHvm,
 						CpuOptions: &types.CpuOptions{
@@ -344,6 +353,24 @@ func TestInstanceOutputMapper(t *testing.T) {
 			ExpectedQuery:  "groupId",
 			ExpectedScope:  "foo",
 		},
+		{
+			ExpectedType:   "ec2-host",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "h-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  item.GetScope(),
+		},
+		{
+			ExpectedType:   "ec2-fleet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fleet-0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+			ExpectedScope:  item.GetScope(),
+		},
+		{
+			ExpectedType:   "ec2-spot-fleet-request",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sfr-0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+			ExpectedScope:  item.GetScope(),
+		},
 	}
 
 	tests.Execute(t, item)
@@ -107,3 +107,7 @@ var internetGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-vpc"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var internetGatewayAdapterIAMActions = IAMActions.Register(internetGatewayAdapterMetadata,
+	"ec2:DescribeInternetGateways",
+)
@@ -83,3 +83,7 @@ var keyPairAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var keyPairAdapterIAMActions = IAMActions.Register(keyPairAdapterMetadata,
+	"ec2:DescribeKeyPairs",
+)
@@ -86,3 +86,7 @@ var launchTemplateAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var launchTemplateAdapterIAMActions = IAMActions.Register(launchTemplateAdapterMetadata,
+	"ec2:DescribeLaunchTemplates",
+)
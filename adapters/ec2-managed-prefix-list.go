@@ -0,0 +1,233 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func managedPrefixListInputMapperGet(scope string, query string) (*ec2.DescribeManagedPrefixListsInput, error) {
+	return &ec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func managedPrefixListInputMapperList(scope string) (*ec2.DescribeManagedPrefixListsInput, error) {
+	return &ec2.DescribeManagedPrefixListsInput{}, nil
+}
+
+// managedPrefixListWithEntries Adds the entries to the prefix list so that
+// they are included in the item's attributes
+type managedPrefixListWithEntries struct {
+	types.ManagedPrefixList
+	Entries []types.PrefixListEntry
+}
+
+// managedPrefixListEntries Gets the CIDRs in a prefix list. These aren't
+// returned when describing the list so have to be fetched separately
+func managedPrefixListEntries(ctx context.Context, client *ec2.Client, prefixListID string) ([]types.PrefixListEntry, error) {
+	entries := make([]types.PrefixListEntry, 0)
+
+	paginator := ec2.NewGetManagedPrefixListEntriesPaginator(client, &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: &prefixListID,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, out.Entries...)
+	}
+
+	return entries, nil
+}
+
+// managedPrefixListAssociations Gets the resources that reference a prefix
+// list. This only works for lists that we own
+func managedPrefixListAssociations(ctx context.Context, client *ec2.Client, prefixListID string) ([]types.PrefixListAssociation, error) {
+	associations := make([]types.PrefixListAssociation, 0)
+
+	paginator := ec2.NewGetManagedPrefixListAssociationsPaginator(client, &ec2.GetManagedPrefixListAssociationsInput{
+		PrefixListId: &prefixListID,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		associations = append(associations, out.PrefixListAssociations...)
+	}
+
+	return associations, nil
+}
+
+func managedPrefixListOutputMapper(ctx context.Context, client *ec2.Client, scope string, _ *ec2.DescribeManagedPrefixListsInput, output *ec2.DescribeManagedPrefixListsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	for _, prefixList := range output.PrefixLists {
+		// The entries are what actually matter when evaluating a change, so
+		// include them in the item. If they can't be fetched we still return
+		// the list, but without the Entries attribute so that it can't be
+		// mistaken for a list with no entries
+		var attributeSource interface{} = prefixList
+		var enrichmentFailed bool
+
+		if client != nil && prefixList.PrefixListId != nil {
+			entries, err := managedPrefixListEntries(ctx, client, *prefixList.PrefixListId)
+			if err == nil {
+				attributeSource = managedPrefixListWithEntries{
+					ManagedPrefixList: prefixList,
+					Entries:           entries,
+				}
+			} else {
+				enrichmentFailed = true
+			}
+		}
+
+		attrs, err := adapterhelpers.ToAttributesWithExclude(attributeSource, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-managed-prefix-list",
+			UniqueAttribute: "PrefixListId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(prefixList.Tags),
+		}
+
+		switch prefixList.State {
+		case types.PrefixListStateCreateComplete, types.PrefixListStateModifyComplete, types.PrefixListStateRestoreComplete:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.PrefixListStateCreateInProgress, types.PrefixListStateModifyInProgress, types.PrefixListStateRestoreInProgress, types.PrefixListStateDeleteInProgress:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.PrefixListStateCreateFailed, types.PrefixListStateModifyFailed, types.PrefixListStateRestoreFailed, types.PrefixListStateDeleteFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		// Only the owner can see which resources use the list
+		if client != nil && prefixList.PrefixListId != nil && prefixList.OwnerId != nil && *prefixList.OwnerId == accountID {
+			associations, err := managedPrefixListAssociations(ctx, client, *prefixList.PrefixListId)
+			if err != nil {
+				enrichmentFailed = true
+			}
+
+			for _, association := range associations {
+				if association.ResourceId == nil {
+					continue
+				}
+
+				var resourceType string
+				switch {
+				case strings.HasPrefix(*association.ResourceId, "sg-"):
+					resourceType = "ec2-security-group"
+				case strings.HasPrefix(*association.ResourceId, "rtb-"):
+					resourceType = "ec2-route-table"
+				default:
+					continue
+				}
+
+				resourceScope := scope
+				if association.ResourceOwner != nil {
+					resourceScope = adapterhelpers.FormatScope(*association.ResourceOwner, region)
+				}
+
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   resourceType,
+						Method: sdp.QueryMethod_GET,
+						Query:  *association.ResourceId,
+						Scope:  resourceScope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The resource doesn't affect the list
+						In: false,
+						// Changing the entries changes the rules and
+						// routes that reference the list
+						Out: true,
+					},
+				})
+			}
+		}
+
+		// Without the entries or associations we can't say what the list
+		// affects, so don't report it as healthy. Failed and pending states
+		// are still worth reporting
+		if enrichmentFailed && item.GetHealth() == sdp.Health_HEALTH_OK {
+			item.Health = sdp.Health_HEALTH_UNKNOWN.Enum()
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2ManagedPrefixListAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeManagedPrefixListsInput, *ec2.DescribeManagedPrefixListsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeManagedPrefixListsInput, *ec2.DescribeManagedPrefixListsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-managed-prefix-list",
+		AdapterMetadata: managedPrefixListAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error) {
+			return client.DescribeManagedPrefixLists(ctx, input)
+		},
+		InputMapperGet:  managedPrefixListInputMapperGet,
+		InputMapperList: managedPrefixListInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeManagedPrefixListsInput) adapterhelpers.Paginator[*ec2.DescribeManagedPrefixListsOutput, *ec2.Options] {
+			return ec2.NewDescribeManagedPrefixListsPaginator(client, params)
+		},
+		OutputMapper: managedPrefixListOutputMapper,
+	}
+}
+
+var managedPrefixListAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-managed-prefix-list",
+	DescriptiveName: "Managed Prefix List",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a managed prefix list by ID",
+		ListDescription:   "List all managed prefix lists, including AWS-managed lists",
+		SearchDescription: "Search for managed prefix lists by ARN",
+	},
+	PotentialLinks: []string{"ec2-security-group", "ec2-route-table"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_managed_prefix_list.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var managedPrefixListAdapterIAMActions = IAMActions.Register(managedPrefixListAdapterMetadata,
+	"ec2:DescribeManagedPrefixLists",
+	"ec2:GetManagedPrefixListAssociations",
+	"ec2:GetManagedPrefixListEntries",
+)
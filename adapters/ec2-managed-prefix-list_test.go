@@ -0,0 +1,139 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/middleware"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestManagedPrefixListInputMapperGet(t *testing.T) {
+	input, err := managedPrefixListInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.PrefixListIds) != 1 {
+		t.Fatalf("expected 1 prefix list ID, got %v", len(input.PrefixListIds))
+	}
+
+	if input.PrefixListIds[0] != "bar" {
+		t.Errorf("expected prefix list ID to be bar, got %v", input.PrefixListIds[0])
+	}
+}
+
+func TestManagedPrefixListOutputMapper(t *testing.T) {
+	output := &ec2.DescribeManagedPrefixListsOutput{
+		PrefixLists: []types.ManagedPrefixList{
+			{
+				AddressFamily:  adapterhelpers.PtrString("IPv4"),
+				MaxEntries:     adapterhelpers.PtrInt32(10),
+				OwnerId:        adapterhelpers.PtrString("052392120703"),
+				PrefixListArn:  adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:052392120703:prefix-list/pl-0a1b2c3d4e5f6a7b8"),
+				PrefixListId:   adapterhelpers.PtrString("pl-0a1b2c3d4e5f6a7b8"),
+				PrefixListName: adapterhelpers.PtrString("office"),
+				State:          types.PrefixListStateModifyComplete,
+				Version:        adapterhelpers.PtrInt64(3),
+			},
+			{
+				AddressFamily:  adapterhelpers.PtrString("IPv4"),
+				OwnerId:        adapterhelpers.PtrString("AWS"),
+				PrefixListArn:  adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:aws:prefix-list/pl-7ca54015"),
+				PrefixListId:   adapterhelpers.PtrString("pl-7ca54015"),
+				PrefixListName: adapterhelpers.PtrString("com.amazonaws.eu-west-2.s3"),
+				State:          types.PrefixListStateModifyFailed,
+			},
+		},
+	}
+
+	items, err := managedPrefixListOutputMapper(context.Background(), nil, "052392120703.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	if items[0].GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", items[0].GetHealth())
+	}
+
+	if items[1].GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", items[1].GetHealth())
+	}
+
+	if items[0].UniqueAttributeValue() != "pl-0a1b2c3d4e5f6a7b8" {
+		t.Errorf("expected unique attribute value to be pl-0a1b2c3d4e5f6a7b8, got %v", items[0].UniqueAttributeValue())
+	}
+}
+
+func TestManagedPrefixListOutputMapperEntriesError(t *testing.T) {
+	// A client whose calls are all denied
+	client := ec2.New(ec2.Options{
+		Region: "eu-west-2",
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("Deny", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+					return middleware.InitializeOutput{}, middleware.Metadata{}, errors.New("access denied")
+				}), middleware.After)
+			},
+		},
+	})
+
+	output := &ec2.DescribeManagedPrefixListsOutput{
+		PrefixLists: []types.ManagedPrefixList{
+			{
+				OwnerId:        adapterhelpers.PtrString("052392120703"),
+				PrefixListId:   adapterhelpers.PtrString("pl-0a1b2c3d4e5f6a7b8"),
+				PrefixListName: adapterhelpers.PtrString("office"),
+				State:          types.PrefixListStateModifyComplete,
+			},
+		},
+	}
+
+	items, err := managedPrefixListOutputMapper(context.Background(), client, "052392120703.eu-west-2", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	if items[0].GetHealth() != sdp.Health_HEALTH_UNKNOWN {
+		t.Errorf("expected health to be UNKNOWN, got %v", items[0].GetHealth())
+	}
+
+	if _, err := items[0].GetAttributes().Get("Entries"); err == nil {
+		t.Error("expected no Entries attribute when the entries couldn't be fetched")
+	}
+}
+
+func TestNewEC2ManagedPrefixListAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2ManagedPrefixListAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
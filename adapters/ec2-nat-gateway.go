@@ -173,3 +173,7 @@ var natGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var natGatewayAdapterIAMActions = IAMActions.Register(natGatewayAdapterMetadata,
+	"ec2:DescribeNatGateways",
+)
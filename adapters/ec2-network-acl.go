@@ -123,3 +123,7 @@ var networkAclAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var networkAclAdapterIAMActions = IAMActions.Register(networkAclAdapterMetadata,
+	"ec2:DescribeNetworkAcls",
+)
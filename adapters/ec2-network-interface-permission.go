@@ -99,3 +99,7 @@ var networkInterfacePermissionAdapterMetadata = Metadata.Register(&sdp.AdapterMe
 	PotentialLinks: []string{"ec2-network-interface"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var networkInterfacePermissionAdapterIAMActions = IAMActions.Register(networkInterfacePermissionAdapterMetadata,
+	"ec2:DescribeNetworkInterfacePermissions",
+)
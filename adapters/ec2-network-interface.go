@@ -278,3 +278,7 @@ var networkInterfaceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var networkInterfaceAdapterIAMActions = IAMActions.Register(networkInterfaceAdapterMetadata,
+	"ec2:DescribeNetworkInterfaces",
+)
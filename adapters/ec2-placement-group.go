@@ -83,3 +83,7 @@ var placementGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var placementGroupAdapterIAMActions = IAMActions.Register(placementGroupAdapterMetadata,
+	"ec2:DescribePlacementGroups",
+)
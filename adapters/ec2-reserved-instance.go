@@ -78,3 +78,7 @@ var reservedInstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var reservedInstanceAdapterIAMActions = IAMActions.Register(reservedInstanceAdapterMetadata,
+	"ec2:DescribeReservedInstances",
+)
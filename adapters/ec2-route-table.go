@@ -112,6 +112,41 @@ func routeTableOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *e
 						},
 					})
 				}
+				if strings.HasPrefix(*route.GatewayId, "vgw") {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ec2-vpn-gateway",
+							Method: sdp.QueryMethod_GET,
+							Query:  *route.GatewayId,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Detaching or deleting the gateway blackholes
+							// traffic sent by the route
+							In: true,
+							// Changing the route changes which traffic is
+							// sent over the VPN
+							Out: true,
+						},
+					})
+				}
+			}
+			if route.DestinationPrefixListId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-managed-prefix-list",
+						Method: sdp.QueryMethod_GET,
+						Query:  *route.DestinationPrefixListId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the entries in the list changes which
+						// traffic the route matches
+						In: true,
+						// The route table doesn't affect the list
+						Out: false,
+					},
+				})
 			}
 			if route.CarrierGatewayId != nil {
 				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
@@ -278,7 +313,7 @@ var routeTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		ListDescription:   "List all route tables",
 		SearchDescription: "Search route tables by ARN",
 	},
-	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-internet-gateway", "ec2-vpc-endpoint", "ec2-carrier-gateway", "ec2-egress-only-internet-gateway", "ec2-instance", "ec2-local-gateway", "ec2-nat-gateway", "ec2-network-interface", "ec2-transit-gateway", "ec2-vpc-peering-connection"},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-internet-gateway", "ec2-vpc-endpoint", "ec2-carrier-gateway", "ec2-egress-only-internet-gateway", "ec2-instance", "ec2-local-gateway", "ec2-nat-gateway", "ec2-network-interface", "ec2-transit-gateway", "ec2-vpc-peering-connection", "ec2-vpn-gateway", "ec2-managed-prefix-list"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_route_table.id"},
 		{TerraformQueryMap: "aws_route_table_association.route_table_id"},
@@ -287,3 +322,7 @@ var routeTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var routeTableAdapterIAMActions = IAMActions.Register(routeTableAdapterMetadata,
+	"ec2:DescribeRouteTables",
+)
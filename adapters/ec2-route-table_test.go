@@ -84,6 +84,12 @@ func TestRouteTableOutputMapper(t *testing.T) {
 						TransitGatewayId:            adapterhelpers.PtrString("id"),
 						VpcPeeringConnectionId:      adapterhelpers.PtrString("id"),
 					},
+					{
+						DestinationCidrBlock: adapterhelpers.PtrString("192.168.0.0/16"),
+						GatewayId:            adapterhelpers.PtrString("vgw-0a1b2c3d4e5f6a7b8"),
+						Origin:               types.RouteOriginEnableVgwRoutePropagation,
+						State:                types.RouteStateActive,
+					},
 				},
 				VpcId:   adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
 				OwnerId: adapterhelpers.PtrString("052392120703"),
@@ -190,6 +196,18 @@ func TestRouteTableOutputMapper(t *testing.T) {
 			ExpectedQuery:  "igw-12345",
 			ExpectedScope:  "foo",
 		},
+		{
+			ExpectedType:   "ec2-vpn-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vgw-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-managed-prefix-list",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "pl-7ca54015",
+			ExpectedScope:  "foo",
+		},
 	}
 
 	tests.Execute(t, item)
@@ -61,6 +61,24 @@ func securityGroupRuleOutputMapper(_ context.Context, _ *ec2.Client, scope strin
 			})
 		}
 
+		if securityGroupRule.PrefixListId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-managed-prefix-list",
+					Method: sdp.QueryMethod_GET,
+					Query:  *securityGroupRule.PrefixListId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the entries in the list changes what the rule
+					// allows
+					In: true,
+					// The rule doesn't affect the list
+					Out: false,
+				},
+			})
+		}
+
 		if rg := securityGroupRule.ReferencedGroupInfo; rg != nil {
 			if rg.GroupId != nil {
 				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
@@ -115,7 +133,7 @@ var securityGroupRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		ListDescription:   "List all security group rules",
 		SearchDescription: "Search security group rules by ARN",
 	},
-	PotentialLinks: []string{"ec2-security-group"},
+	PotentialLinks: []string{"ec2-security-group", "ec2-managed-prefix-list"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_security_group_rule.security_group_rule_id"},
 		{TerraformQueryMap: "aws_vpc_security_group_ingress_rule.security_group_rule_id"},
@@ -123,3 +141,7 @@ var securityGroupRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var securityGroupRuleAdapterIAMActions = IAMActions.Register(securityGroupRuleAdapterMetadata,
+	"ec2:DescribeSecurityGroupRules",
+)
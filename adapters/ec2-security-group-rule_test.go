@@ -107,6 +107,39 @@ func TestSecurityGroupRuleOutputMapper(t *testing.T) {
 
 	tests.Execute(t, item)
 
+	t.Run("prefix list", func(t *testing.T) {
+		output := &ec2.DescribeSecurityGroupRulesOutput{
+			SecurityGroupRules: []types.SecurityGroupRule{
+				{
+					SecurityGroupRuleId: adapterhelpers.PtrString("sgr-0c1d2e3f4a5b6c7d8"),
+					GroupId:             adapterhelpers.PtrString("sg-0814766e46f201c22"),
+					GroupOwnerId:        adapterhelpers.PtrString("052392120703"),
+					IsEgress:            adapterhelpers.PtrBool(true),
+					IpProtocol:          adapterhelpers.PtrString("tcp"),
+					FromPort:            adapterhelpers.PtrInt32(443),
+					ToPort:              adapterhelpers.PtrInt32(443),
+					PrefixListId:        adapterhelpers.PtrString("pl-7ca54015"),
+				},
+			},
+		}
+
+		items, err := securityGroupRuleOutputMapper(context.Background(), nil, "foo", nil, output)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-managed-prefix-list",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "pl-7ca54015",
+				ExpectedScope:  "foo",
+			},
+		}
+
+		tests.Execute(t, items[0])
+	})
+
 }
 
 func TestNewEC2SecurityGroupRuleAdapter(t *testing.T) {
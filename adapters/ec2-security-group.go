@@ -66,6 +66,8 @@ func securityGroupOutputMapper(_ context.Context, _ *ec2.Client, scope string, _
 
 		item.LinkedItemQueries = append(item.LinkedItemQueries, extractLinkedSecurityGroups(securityGroup.IpPermissions, scope)...)
 		item.LinkedItemQueries = append(item.LinkedItemQueries, extractLinkedSecurityGroups(securityGroup.IpPermissionsEgress, scope)...)
+		item.LinkedItemQueries = append(item.LinkedItemQueries, extractLinkedPrefixLists(securityGroup.IpPermissions, scope)...)
+		item.LinkedItemQueries = append(item.LinkedItemQueries, extractLinkedPrefixLists(securityGroup.IpPermissionsEgress, scope)...)
 
 		items = append(items, &item)
 	}
@@ -108,7 +110,7 @@ var securityGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		ListDescription:   "List all security groups",
 		SearchDescription: "Search for security groups by ARN",
 	},
-	PotentialLinks: []string{"ec2-vpc"},
+	PotentialLinks: []string{"ec2-vpc", "ec2-managed-prefix-list"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_security_group.id"},
 		{TerraformQueryMap: "aws_security_group_rule.security_group_id"},
@@ -116,6 +118,10 @@ var securityGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
 
+var securityGroupAdapterIAMActions = IAMActions.Register(securityGroupAdapterMetadata,
+	"ec2:DescribeSecurityGroups",
+)
+
 // extractLinkedSecurityGroups Extracts related security groups from IP
 // permissions
 func extractLinkedSecurityGroups(permissions []types.IpPermission, scope string) []*sdp.LinkedItemQuery {
@@ -155,3 +161,33 @@ func extractLinkedSecurityGroups(permissions []types.IpPermission, scope string)
 
 	return requests
 }
+
+// extractLinkedPrefixLists Extracts the managed prefix lists referenced by IP
+// permissions
+func extractLinkedPrefixLists(permissions []types.IpPermission, scope string) []*sdp.LinkedItemQuery {
+	requests := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, permission := range permissions {
+		for _, prefixList := range permission.PrefixListIds {
+			if prefixList.PrefixListId != nil {
+				requests = append(requests, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-managed-prefix-list",
+						Method: sdp.QueryMethod_GET,
+						Query:  *prefixList.PrefixListId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the entries in the list changes what the
+						// rules allow
+						In: true,
+						// The security group doesn't affect the list
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	return requests
+}
@@ -112,3 +112,7 @@ var snapshotAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-volume"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var snapshotAdapterIAMActions = IAMActions.Register(snapshotAdapterMetadata,
+	"ec2:DescribeSnapshots",
+)
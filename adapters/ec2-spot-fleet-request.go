@@ -0,0 +1,236 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// spotFleetInstanceIDs Gets the IDs of the running instances in a spot fleet
+func spotFleetInstanceIDs(ctx context.Context, client *ec2.Client, requestID string) ([]string, error) {
+	ids := make([]string, 0)
+
+	input := &ec2.DescribeSpotFleetInstancesInput{
+		SpotFleetRequestId: &requestID,
+	}
+
+	for {
+		out, err := client.DescribeSpotFleetInstances(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, instance := range out.ActiveInstances {
+			if instance.InstanceId != nil {
+				ids = append(ids, *instance.InstanceId)
+			}
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return ids, nil
+}
+
+func spotFleetRequestInputMapperGet(scope string, query string) (*ec2.DescribeSpotFleetRequestsInput, error) {
+	return &ec2.DescribeSpotFleetRequestsInput{
+		SpotFleetRequestIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func spotFleetRequestInputMapperList(scope string) (*ec2.DescribeSpotFleetRequestsInput, error) {
+	return &ec2.DescribeSpotFleetRequestsInput{}, nil
+}
+
+func spotFleetRequestOutputMapper(ctx context.Context, client *ec2.Client, scope string, _ *ec2.DescribeSpotFleetRequestsInput, output *ec2.DescribeSpotFleetRequestsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, request := range output.SpotFleetRequestConfigs {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(request, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-spot-fleet-request",
+			UniqueAttribute: "SpotFleetRequestId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(request.Tags),
+		}
+
+		switch request.SpotFleetRequestState {
+		case types.BatchStateActive:
+			switch request.ActivityStatus {
+			case types.ActivityStatusError:
+				// The fleet is unable to launch or terminate instances
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			case types.ActivityStatusPendingFulfillment, types.ActivityStatusPendingTermination:
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			default:
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			}
+		case types.BatchStateSubmitted, types.BatchStateModifying, types.BatchStateCancelledRunning, types.BatchStateCancelledTerminatingInstances:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.BatchStateFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		if config := request.SpotFleetRequestConfig; config != nil {
+			if config.IamFleetRole != nil {
+				if arn, err := adapterhelpers.ParseARN(*config.IamFleetRole); err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "iam-role",
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  *config.IamFleetRole,
+							Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// The fleet uses the role to launch and terminate
+							// instances
+							In: true,
+							// We can't affect the role
+							Out: false,
+						},
+					})
+				}
+			}
+
+			for _, spec := range config.LaunchSpecifications {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, spotLaunchSpecificationLinks(scope, spec.IamInstanceProfile, spec.ImageId, spec.KeyName, spec.SubnetId, spec.SecurityGroups)...)
+			}
+
+			for _, ltConfig := range config.LaunchTemplateConfigs {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, fleetLaunchTemplateLinks(scope, ltConfig.LaunchTemplateSpecification)...)
+
+				for _, override := range ltConfig.Overrides {
+					if override.SubnetId != nil {
+						item.LinkedItemQueries = append(item.LinkedItemQueries, spotLaunchSpecificationLinks(scope, nil, nil, nil, override.SubnetId, nil)...)
+					}
+				}
+			}
+
+			if lbConfig := config.LoadBalancersConfig; lbConfig != nil {
+				if lbConfig.ClassicLoadBalancersConfig != nil {
+					for _, lb := range lbConfig.ClassicLoadBalancersConfig.ClassicLoadBalancers {
+						if lb.Name != nil {
+							item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+								Query: &sdp.Query{
+									Type:   "elb-load-balancer",
+									Method: sdp.QueryMethod_GET,
+									Query:  *lb.Name,
+									Scope:  scope,
+								},
+								BlastPropagation: &sdp.BlastPropagation{
+									// Changes to the load balancer won't
+									// affect the fleet
+									In: false,
+									// The fleet registers its instances with
+									// the load balancer
+									Out: true,
+								},
+							})
+						}
+					}
+				}
+
+				if lbConfig.TargetGroupsConfig != nil {
+					for _, tg := range lbConfig.TargetGroupsConfig.TargetGroups {
+						if tg.Arn == nil {
+							continue
+						}
+
+						if arn, err := adapterhelpers.ParseARN(*tg.Arn); err == nil {
+							item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+								Query: &sdp.Query{
+									Type:   "elbv2-target-group",
+									Method: sdp.QueryMethod_SEARCH,
+									Query:  *tg.Arn,
+									Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+								},
+								BlastPropagation: &sdp.BlastPropagation{
+									// Changes to the target group won't
+									// affect the fleet
+									In: false,
+									// The fleet registers its instances with
+									// the target group
+									Out: true,
+								},
+							})
+						}
+					}
+				}
+			}
+		}
+
+		if client != nil && request.SpotFleetRequestId != nil {
+			// If we can't get the instances we still want to return the
+			// request
+			if ids, err := spotFleetInstanceIDs(ctx, client, *request.SpotFleetRequestId); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, fleetInstanceLinks(scope, ids)...)
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2SpotFleetRequestAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeSpotFleetRequestsInput, *ec2.DescribeSpotFleetRequestsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeSpotFleetRequestsInput, *ec2.DescribeSpotFleetRequestsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-spot-fleet-request",
+		AdapterMetadata: spotFleetRequestAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeSpotFleetRequestsInput) (*ec2.DescribeSpotFleetRequestsOutput, error) {
+			return client.DescribeSpotFleetRequests(ctx, input)
+		},
+		InputMapperGet:  spotFleetRequestInputMapperGet,
+		InputMapperList: spotFleetRequestInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeSpotFleetRequestsInput) adapterhelpers.Paginator[*ec2.DescribeSpotFleetRequestsOutput, *ec2.Options] {
+			return ec2.NewDescribeSpotFleetRequestsPaginator(client, params)
+		},
+		OutputMapper: spotFleetRequestOutputMapper,
+	}
+}
+
+var spotFleetRequestAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-spot-fleet-request",
+	DescriptiveName: "Spot Fleet Request",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a spot fleet request by ID",
+		ListDescription:   "List all spot fleet requests",
+		SearchDescription: "Search for spot fleet requests by ARN",
+	},
+	PotentialLinks: []string{"iam-role", "iam-instance-profile", "ec2-image", "ec2-key-pair", "ec2-subnet", "ec2-security-group", "ec2-launch-template", "elb-load-balancer", "elbv2-target-group", "ec2-instance"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_spot_fleet_request.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var spotFleetRequestAdapterIAMActions = IAMActions.Register(spotFleetRequestAdapterMetadata,
+	"ec2:DescribeSpotFleetRequests",
+	"ec2:DescribeSpotFleetInstances",
+)
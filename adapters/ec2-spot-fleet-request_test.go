@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSpotFleetRequestInputMapperGet(t *testing.T) {
+	input, err := spotFleetRequestInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.SpotFleetRequestIds) != 1 {
+		t.Fatalf("expected 1 spot fleet request ID, got %v", len(input.SpotFleetRequestIds))
+	}
+
+	if input.SpotFleetRequestIds[0] != "bar" {
+		t.Errorf("expected spot fleet request ID to be bar, got %v", input.SpotFleetRequestIds[0])
+	}
+}
+
+func TestSpotFleetRequestOutputMapper(t *testing.T) {
+	output := &ec2.DescribeSpotFleetRequestsOutput{
+		SpotFleetRequestConfigs: []types.SpotFleetRequestConfig{
+			{
+				ActivityStatus: types.ActivityStatusError,
+				CreateTime:     adapterhelpers.PtrTime(time.Now()),
+				SpotFleetRequestConfig: &types.SpotFleetRequestConfigData{
+					AllocationStrategy: types.AllocationStrategyPriceCapacityOptimized,
+					IamFleetRole:       adapterhelpers.PtrString("arn:aws:iam::123456789012:role/aws-ec2-spot-fleet-tagging-role"), // link
+					LaunchSpecifications: []types.SpotFleetLaunchSpecification{
+						{
+							ImageId:      adapterhelpers.PtrString("ami-0a1b2c3d4e5f6a7b8"), // link
+							InstanceType: types.InstanceTypeT3Micro,
+							SubnetId:     adapterhelpers.PtrString("subnet-0450a637af9984235, subnet-0b1c2d3e4f5a6b7c8"), // link
+						},
+					},
+					LaunchTemplateConfigs: []types.LaunchTemplateConfig{
+						{
+							LaunchTemplateSpecification: &types.FleetLaunchTemplateSpecification{
+								LaunchTemplateId: adapterhelpers.PtrString("lt-0174ff2b8909d0c75"), // link
+								Version:          adapterhelpers.PtrString("$Latest"),
+							},
+							Overrides: []types.LaunchTemplateOverrides{
+								{
+									InstanceType: types.InstanceTypeM5Large,
+									SubnetId:     adapterhelpers.PtrString("subnet-0c1d2e3f4a5b6c7d8"), // link
+								},
+							},
+						},
+					},
+					LoadBalancersConfig: &types.LoadBalancersConfig{
+						ClassicLoadBalancersConfig: &types.ClassicLoadBalancersConfig{
+							ClassicLoadBalancers: []types.ClassicLoadBalancer{
+								{
+									Name: adapterhelpers.PtrString("classic"), // link
+								},
+							},
+						},
+						TargetGroupsConfig: &types.TargetGroupsConfig{
+							TargetGroups: []types.TargetGroup{
+								{
+									Arn: adapterhelpers.PtrString("arn:aws:elasticloadbalancing:eu-west-2:123456789012:targetgroup/spot/0a1b2c3d4e5f6a7b"), // link
+								},
+							},
+						},
+					},
+					TargetCapacity: adapterhelpers.PtrInt32(2),
+					Type:           types.FleetTypeMaintain,
+				},
+				SpotFleetRequestId:    adapterhelpers.PtrString("sfr-0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d"),
+				SpotFleetRequestState: types.BatchStateActive,
+			},
+		},
+	}
+
+	items, err := spotFleetRequestOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/aws-ec2-spot-fleet-tagging-role",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0b1c2d3e4f5a6b7c8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-launch-template",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "lt-0174ff2b8909d0c75",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0c1d2e3f4a5b6c7d8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elb-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "classic",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elbv2-target-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:targetgroup/spot/0a1b2c3d4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2SpotFleetRequestAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2SpotFleetRequestAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,256 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// spotLaunchSpecificationLinks Returns the linked item queries for the parts
+// of a spot launch specification that reference other resources. Spot
+// instance requests and spot fleet requests use different types for their
+// launch specifications, but the fields that we care about are the same
+func spotLaunchSpecificationLinks(scope string, profile *types.IamInstanceProfileSpecification, imageID *string, keyName *string, subnetID *string, groups []types.GroupIdentifier) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if profile != nil {
+		// Prefer the ARN
+		if profile.Arn != nil {
+			if arn, err := adapterhelpers.ParseARN(*profile.Arn); err == nil {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-instance-profile",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *profile.Arn,
+						Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the profile will affect the instances
+						// that are launched
+						In: true,
+						// We can't affect the profile
+						Out: false,
+					},
+				})
+			}
+		} else if profile.Name != nil {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "iam-instance-profile",
+					Method: sdp.QueryMethod_GET,
+					Query:  *profile.Name,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the profile will affect the instances that
+					// are launched
+					In: true,
+					// We can't affect the profile
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if imageID != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-image",
+				Method: sdp.QueryMethod_GET,
+				Query:  *imageID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the image is deregistered new instances can't be
+				// launched
+				In: true,
+				// We can't affect the image
+				Out: false,
+			},
+		})
+	}
+
+	if keyName != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-key-pair",
+				Method: sdp.QueryMethod_GET,
+				Query:  *keyName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the key pair will affect new instances
+				In: true,
+				// We can't affect the key pair
+				Out: false,
+			},
+		})
+	}
+
+	if subnetID != nil {
+		// Multiple subnets can be specified as a comma separated list in spot
+		// fleets
+		for _, subnet := range strings.Split(*subnetID, ",") {
+			subnet = strings.TrimSpace(subnet)
+			if subnet == "" {
+				continue
+			}
+
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnet,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the subnet will affect where instances are
+					// launched
+					In: true,
+					// We can't affect the subnet
+					Out: false,
+				},
+			})
+		}
+	}
+
+	for _, group := range groups {
+		if group.GroupId != nil {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *group.GroupId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the security group will affect the instances
+					In: true,
+					// We can't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return links
+}
+
+func spotInstanceRequestInputMapperGet(scope string, query string) (*ec2.DescribeSpotInstanceRequestsInput, error) {
+	return &ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func spotInstanceRequestInputMapperList(scope string) (*ec2.DescribeSpotInstanceRequestsInput, error) {
+	return &ec2.DescribeSpotInstanceRequestsInput{}, nil
+}
+
+func spotInstanceRequestOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeSpotInstanceRequestsInput, output *ec2.DescribeSpotInstanceRequestsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, request := range output.SpotInstanceRequests {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(request, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-spot-instance-request",
+			UniqueAttribute: "SpotInstanceRequestId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(request.Tags),
+		}
+
+		switch request.State {
+		case types.SpotInstanceStateActive:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.SpotInstanceStateOpen:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.SpotInstanceStateDisabled:
+			// The instance was stopped or hibernated due to an interruption
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case types.SpotInstanceStateFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		if request.InstanceId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-instance",
+					Method: sdp.QueryMethod_GET,
+					Query:  *request.InstanceId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The instance doesn't affect the request
+					In: false,
+					// Cancelling the request can terminate the instance
+					Out: true,
+				},
+			})
+		}
+
+		if spec := request.LaunchSpecification; spec != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, spotLaunchSpecificationLinks(scope, spec.IamInstanceProfile, spec.ImageId, spec.KeyName, spec.SubnetId, spec.SecurityGroups)...)
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2SpotInstanceRequestAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeSpotInstanceRequestsInput, *ec2.DescribeSpotInstanceRequestsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeSpotInstanceRequestsInput, *ec2.DescribeSpotInstanceRequestsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-spot-instance-request",
+		AdapterMetadata: spotInstanceRequestAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeSpotInstanceRequestsInput) (*ec2.DescribeSpotInstanceRequestsOutput, error) {
+			return client.DescribeSpotInstanceRequests(ctx, input)
+		},
+		InputMapperGet:  spotInstanceRequestInputMapperGet,
+		InputMapperList: spotInstanceRequestInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeSpotInstanceRequestsInput) adapterhelpers.Paginator[*ec2.DescribeSpotInstanceRequestsOutput, *ec2.Options] {
+			return ec2.NewDescribeSpotInstanceRequestsPaginator(client, params)
+		},
+		OutputMapper: spotInstanceRequestOutputMapper,
+	}
+}
+
+var spotInstanceRequestAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-spot-instance-request",
+	DescriptiveName: "Spot Instance Request",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a spot instance request by ID",
+		ListDescription:   "List all spot instance requests",
+		SearchDescription: "Search for spot instance requests by ARN",
+	},
+	PotentialLinks: []string{"ec2-instance", "iam-instance-profile", "ec2-image", "ec2-key-pair", "ec2-subnet", "ec2-security-group"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_spot_instance_request.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var spotInstanceRequestAdapterIAMActions = IAMActions.Register(spotInstanceRequestAdapterMetadata,
+	"ec2:DescribeSpotInstanceRequests",
+)
@@ -0,0 +1,143 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSpotInstanceRequestInputMapperGet(t *testing.T) {
+	input, err := spotInstanceRequestInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.SpotInstanceRequestIds) != 1 {
+		t.Fatalf("expected 1 spot instance request ID, got %v", len(input.SpotInstanceRequestIds))
+	}
+
+	if input.SpotInstanceRequestIds[0] != "bar" {
+		t.Errorf("expected spot instance request ID to be bar, got %v", input.SpotInstanceRequestIds[0])
+	}
+}
+
+func TestSpotInstanceRequestOutputMapper(t *testing.T) {
+	output := &ec2.DescribeSpotInstanceRequestsOutput{
+		SpotInstanceRequests: []types.SpotInstanceRequest{
+			{
+				CreateTime:                   adapterhelpers.PtrTime(time.Now()),
+				InstanceId:                   adapterhelpers.PtrString("i-04c7b2794f7bc3d6a"), // link
+				InstanceInterruptionBehavior: types.InstanceInterruptionBehaviorTerminate,
+				LaunchSpecification: &types.LaunchSpecification{
+					IamInstanceProfile: &types.IamInstanceProfileSpecification{
+						Arn: adapterhelpers.PtrString("arn:aws:iam::123456789012:instance-profile/spot"), // link
+					},
+					ImageId:      adapterhelpers.PtrString("ami-0a1b2c3d4e5f6a7b8"), // link
+					InstanceType: types.InstanceTypeT3Micro,
+					KeyName:      adapterhelpers.PtrString("spot-key"), // link
+					SecurityGroups: []types.GroupIdentifier{
+						{
+							GroupId:   adapterhelpers.PtrString("sg-094e151c9fc5da181"), // link
+							GroupName: adapterhelpers.PtrString("default"),
+						},
+					},
+					SubnetId: adapterhelpers.PtrString("subnet-0450a637af9984235"), // link
+				},
+				LaunchedAvailabilityZone: adapterhelpers.PtrString("eu-west-2a"),
+				ProductDescription:       types.RIProductDescriptionLinuxUnix,
+				SpotInstanceRequestId:    adapterhelpers.PtrString("sir-0a1b2c3d"),
+				SpotPrice:                adapterhelpers.PtrString("0.011600"),
+				State:                    types.SpotInstanceStateActive,
+				Status: &types.SpotInstanceStatus{
+					Code:       adapterhelpers.PtrString("fulfilled"),
+					Message:    adapterhelpers.PtrString("Your spot request is fulfilled."),
+					UpdateTime: adapterhelpers.PtrTime(time.Now()),
+				},
+				Type: types.SpotInstanceTypeOneTime,
+			},
+		},
+	}
+
+	items, err := spotInstanceRequestOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-04c7b2794f7bc3d6a",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "iam-instance-profile",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:instance-profile/spot",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-key-pair",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "spot-key",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-094e151c9fc5da181",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2SpotInstanceRequestAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2SpotInstanceRequestAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
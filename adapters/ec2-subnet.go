@@ -105,3 +105,7 @@ var subnetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var subnetAdapterIAMActions = IAMActions.Register(subnetAdapterMetadata,
+	"ec2:DescribeSubnets",
+)
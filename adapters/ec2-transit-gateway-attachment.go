@@ -0,0 +1,301 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func transitGatewayAttachmentInputMapperGet(scope string, query string) (*ec2.DescribeTransitGatewayAttachmentsInput, error) {
+	return &ec2.DescribeTransitGatewayAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func transitGatewayAttachmentInputMapperList(scope string) (*ec2.DescribeTransitGatewayAttachmentsInput, error) {
+	return &ec2.DescribeTransitGatewayAttachmentsInput{}, nil
+}
+
+// transitGatewayAttachmentInputMapperSearch Searches for attachments by the ID
+// of their transit gateway, or the ID of the route table they are associated
+// with. ARNs are also supported
+func transitGatewayAttachmentInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.DescribeTransitGatewayAttachmentsInput, error) {
+	if arn, err := adapterhelpers.ParseARN(query); err == nil {
+		return &ec2.DescribeTransitGatewayAttachmentsInput{
+			TransitGatewayAttachmentIds: []string{
+				arn.ResourceID(),
+			},
+		}, nil
+	}
+
+	filterName := "transit-gateway-id"
+	if strings.HasPrefix(query, "tgw-rtb-") {
+		filterName = "association.transit-gateway-route-table-id"
+	}
+
+	return &ec2.DescribeTransitGatewayAttachmentsInput{
+		Filters: []types.Filter{
+			{
+				Name:   &filterName,
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+// transitGatewayVpcAttachmentSubnets Returns the subnets used by each VPC
+// attachment, keyed by attachment ID. These aren't included in the generic
+// attachment details so need to be looked up separately. Since this only adds
+// extra links, errors are ignored
+func transitGatewayVpcAttachmentSubnets(ctx context.Context, client *ec2.Client, attachments []types.TransitGatewayAttachment) map[string][]string {
+	subnets := make(map[string][]string)
+
+	if client == nil {
+		return subnets
+	}
+
+	ids := make([]string, 0)
+	for _, attachment := range attachments {
+		if attachment.ResourceType == types.TransitGatewayAttachmentResourceTypeVpc && attachment.TransitGatewayAttachmentId != nil {
+			ids = append(ids, *attachment.TransitGatewayAttachmentId)
+		}
+	}
+
+	if len(ids) == 0 {
+		return subnets
+	}
+
+	paginator := ec2.NewDescribeTransitGatewayVpcAttachmentsPaginator(client, &ec2.DescribeTransitGatewayVpcAttachmentsInput{
+		TransitGatewayAttachmentIds: ids,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return subnets
+		}
+
+		for _, vpcAttachment := range out.TransitGatewayVpcAttachments {
+			if vpcAttachment.TransitGatewayAttachmentId != nil {
+				subnets[*vpcAttachment.TransitGatewayAttachmentId] = vpcAttachment.SubnetIds
+			}
+		}
+	}
+
+	return subnets
+}
+
+func transitGatewayAttachmentOutputMapper(ctx context.Context, client *ec2.Client, scope string, _ *ec2.DescribeTransitGatewayAttachmentsInput, output *ec2.DescribeTransitGatewayAttachmentsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	_, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	subnets := transitGatewayVpcAttachmentSubnets(ctx, client, output.TransitGatewayAttachments)
+
+	for _, attachment := range output.TransitGatewayAttachments {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(attachment, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway-attachment",
+			UniqueAttribute: "TransitGatewayAttachmentId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(attachment.Tags),
+		}
+
+		switch attachment.State {
+		case types.TransitGatewayAttachmentStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayAttachmentStateInitiating,
+			types.TransitGatewayAttachmentStateInitiatingRequest,
+			types.TransitGatewayAttachmentStatePendingAcceptance,
+			types.TransitGatewayAttachmentStatePending,
+			types.TransitGatewayAttachmentStateModifying,
+			types.TransitGatewayAttachmentStateDeleting,
+			types.TransitGatewayAttachmentStateRollingBack,
+			types.TransitGatewayAttachmentStateRejecting,
+			types.TransitGatewayAttachmentStateFailing:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.TransitGatewayAttachmentStateFailed, types.TransitGatewayAttachmentStateRejected:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		if attachment.TransitGatewayId != nil {
+			// The gateway might be shared from another account
+			tgwScope := scope
+			if attachment.TransitGatewayOwnerId != nil {
+				tgwScope = adapterhelpers.FormatScope(*attachment.TransitGatewayOwnerId, region)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attachment.TransitGatewayId,
+					Scope:  tgwScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the gateway will affect the attachment
+					In: true,
+					// The attachment doesn't affect the gateway itself
+					Out: false,
+				},
+			})
+		}
+
+		if attachment.Association != nil && attachment.Association.TransitGatewayRouteTableId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-route-table",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attachment.Association.TransitGatewayRouteTableId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The associated route table decides where traffic from
+					// this attachment goes
+					In: true,
+					// Changing the attachment can change the routes in the
+					// table
+					Out: true,
+				},
+			})
+		}
+
+		// The attached resource might be in another account
+		resourceScope := scope
+		if attachment.ResourceOwnerId != nil {
+			resourceScope = adapterhelpers.FormatScope(*attachment.ResourceOwnerId, region)
+		}
+
+		if attachment.ResourceId != nil {
+			var resourceType string
+
+			switch attachment.ResourceType {
+			case types.TransitGatewayAttachmentResourceTypeVpc:
+				resourceType = "ec2-vpc"
+			case types.TransitGatewayAttachmentResourceTypeVpn:
+				resourceType = "ec2-vpn-connection"
+			case types.TransitGatewayAttachmentResourceTypeDirectConnectGateway:
+				resourceType = "directconnect-direct-connect-gateway"
+			case types.TransitGatewayAttachmentResourceTypeConnect:
+				// For Connect attachments the resource is the attachment
+				// that is used as the transport
+				resourceType = "ec2-transit-gateway-attachment"
+			case types.TransitGatewayAttachmentResourceTypePeering,
+				types.TransitGatewayAttachmentResourceTypeTgwPeering:
+				// The peer gateway can be in another region, which we don't
+				// know from here, so we don't link to it
+			}
+
+			if resourceType != "" {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   resourceType,
+						Method: sdp.QueryMethod_GET,
+						Query:  *attachment.ResourceId,
+						Scope:  resourceScope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The attachment and the resource it attaches affect
+						// each other's connectivity
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if attachment.TransitGatewayAttachmentId != nil {
+			for _, subnetID := range subnets[*attachment.TransitGatewayAttachmentId] {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-subnet",
+						Method: sdp.QueryMethod_GET,
+						Query:  subnetID,
+						Scope:  resourceScope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Deleting the subnet will break the attachment
+						In: true,
+						// Changing the attachment affects the routing of the
+						// subnet
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayAttachmentAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayAttachmentsInput, *ec2.DescribeTransitGatewayAttachmentsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayAttachmentsInput, *ec2.DescribeTransitGatewayAttachmentsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway-attachment",
+		AdapterMetadata: transitGatewayAttachmentAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeTransitGatewayAttachmentsInput) (*ec2.DescribeTransitGatewayAttachmentsOutput, error) {
+			return client.DescribeTransitGatewayAttachments(ctx, input)
+		},
+		InputMapperGet:    transitGatewayAttachmentInputMapperGet,
+		InputMapperList:   transitGatewayAttachmentInputMapperList,
+		InputMapperSearch: transitGatewayAttachmentInputMapperSearch,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeTransitGatewayAttachmentsInput) adapterhelpers.Paginator[*ec2.DescribeTransitGatewayAttachmentsOutput, *ec2.Options] {
+			return ec2.NewDescribeTransitGatewayAttachmentsPaginator(client, params)
+		},
+		OutputMapper: transitGatewayAttachmentOutputMapper,
+	}
+}
+
+var transitGatewayAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway-attachment",
+	DescriptiveName: "Transit Gateway Attachment",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway attachment by ID",
+		ListDescription:   "List all transit gateway attachments",
+		SearchDescription: "Search for transit gateway attachments by ARN, transit gateway ID or associated route table ID",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway", "ec2-transit-gateway-route-table", "ec2-transit-gateway-attachment", "ec2-vpc", "ec2-subnet", "ec2-vpn-connection", "directconnect-direct-connect-gateway"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway_vpc_attachment.id"},
+		{TerraformQueryMap: "aws_ec2_transit_gateway_connect.id"},
+		{TerraformQueryMap: "aws_ec2_transit_gateway_peering_attachment.id"},
+		{TerraformQueryMap: "aws_vpn_connection.transit_gateway_attachment_id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var transitGatewayAttachmentAdapterIAMActions = IAMActions.Register(transitGatewayAttachmentAdapterMetadata,
+	"ec2:DescribeTransitGatewayAttachments",
+	"ec2:DescribeTransitGatewayVpcAttachments",
+)
@@ -0,0 +1,159 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestTransitGatewayAttachmentInputMapperSearch(t *testing.T) {
+	t.Run("transit gateway ID", func(t *testing.T) {
+		input, err := transitGatewayAttachmentInputMapperSearch(context.Background(), nil, "foo", "tgw-0e1d2c3b4a5f6e7d8")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.Filters) != 1 || *input.Filters[0].Name != "transit-gateway-id" {
+			t.Errorf("expected transit-gateway-id filter, got %v", input.Filters)
+		}
+	})
+
+	t.Run("route table ID", func(t *testing.T) {
+		input, err := transitGatewayAttachmentInputMapperSearch(context.Background(), nil, "foo", "tgw-rtb-0b4e2b4a8b0a2c6d1")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.Filters) != 1 || *input.Filters[0].Name != "association.transit-gateway-route-table-id" {
+			t.Errorf("expected association.transit-gateway-route-table-id filter, got %v", input.Filters)
+		}
+	})
+
+	t.Run("ARN", func(t *testing.T) {
+		input, err := transitGatewayAttachmentInputMapperSearch(context.Background(), nil, "foo", "arn:aws:ec2:eu-west-2:123456789012:transit-gateway-attachment/tgw-attach-0a1b2c3d4e5f6a7b8")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.TransitGatewayAttachmentIds) != 1 || input.TransitGatewayAttachmentIds[0] != "tgw-attach-0a1b2c3d4e5f6a7b8" {
+			t.Errorf("expected attachment ID tgw-attach-0a1b2c3d4e5f6a7b8, got %v", input.TransitGatewayAttachmentIds)
+		}
+	})
+}
+
+func TestTransitGatewayAttachmentOutputMapper(t *testing.T) {
+	output := &ec2.DescribeTransitGatewayAttachmentsOutput{
+		TransitGatewayAttachments: []types.TransitGatewayAttachment{
+			{
+				Association: &types.TransitGatewayAttachmentAssociation{
+					State:                      types.TransitGatewayAssociationStateAssociated,
+					TransitGatewayRouteTableId: adapterhelpers.PtrString("tgw-rtb-0b4e2b4a8b0a2c6d1"),
+				},
+				CreationTime:               adapterhelpers.PtrTime(time.Now()),
+				ResourceId:                 adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+				ResourceOwnerId:            adapterhelpers.PtrString("210987654321"),
+				ResourceType:               types.TransitGatewayAttachmentResourceTypeVpc,
+				State:                      types.TransitGatewayAttachmentStateAvailable,
+				TransitGatewayAttachmentId: adapterhelpers.PtrString("tgw-attach-0a1b2c3d4e5f6a7b8"),
+				TransitGatewayId:           adapterhelpers.PtrString("tgw-0e1d2c3b4a5f6e7d8"),
+				TransitGatewayOwnerId:      adapterhelpers.PtrString("123456789012"),
+			},
+			{
+				CreationTime:               adapterhelpers.PtrTime(time.Now()),
+				ResourceId:                 adapterhelpers.PtrString("vpn-0f1e2d3c4b5a69788"),
+				ResourceOwnerId:            adapterhelpers.PtrString("123456789012"),
+				ResourceType:               types.TransitGatewayAttachmentResourceTypeVpn,
+				State:                      types.TransitGatewayAttachmentStateFailed,
+				TransitGatewayAttachmentId: adapterhelpers.PtrString("tgw-attach-0b1c2d3e4f5a6b7c8"),
+				TransitGatewayId:           adapterhelpers.PtrString("tgw-0e1d2c3b4a5f6e7d8"),
+				TransitGatewayOwnerId:      adapterhelpers.PtrString("123456789012"),
+			},
+		},
+	}
+
+	items, err := transitGatewayAttachmentOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	t.Run("VPC attachment", func(t *testing.T) {
+		item := items[0]
+
+		if item.GetHealth() != sdp.Health_HEALTH_OK {
+			t.Errorf("expected health to be OK, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-transit-gateway",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "tgw-0e1d2c3b4a5f6e7d8",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "ec2-transit-gateway-route-table",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "tgw-rtb-0b4e2b4a8b0a2c6d1",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				// The VPC is in another account
+				ExpectedType:   "ec2-vpc",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "vpc-0d7892e00e573e701",
+				ExpectedScope:  "210987654321.eu-west-2",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("VPN attachment", func(t *testing.T) {
+		item := items[1]
+
+		if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+			t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-vpn-connection",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "vpn-0f1e2d3c4b5a69788",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+}
+
+func TestNewEC2TransitGatewayAttachmentAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayAttachmentAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
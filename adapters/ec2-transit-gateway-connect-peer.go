@@ -0,0 +1,152 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func transitGatewayConnectPeerInputMapperGet(scope string, query string) (*ec2.DescribeTransitGatewayConnectPeersInput, error) {
+	return &ec2.DescribeTransitGatewayConnectPeersInput{
+		TransitGatewayConnectPeerIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func transitGatewayConnectPeerInputMapperList(scope string) (*ec2.DescribeTransitGatewayConnectPeersInput, error) {
+	return &ec2.DescribeTransitGatewayConnectPeersInput{}, nil
+}
+
+func transitGatewayConnectPeerOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeTransitGatewayConnectPeersInput, output *ec2.DescribeTransitGatewayConnectPeersOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, peer := range output.TransitGatewayConnectPeers {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(peer, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway-connect-peer",
+			UniqueAttribute: "TransitGatewayConnectPeerId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(peer.Tags),
+		}
+
+		switch peer.State {
+		case types.TransitGatewayConnectPeerStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayConnectPeerStatePending, types.TransitGatewayConnectPeerStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+
+		if peer.TransitGatewayAttachmentId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-attachment",
+					Method: sdp.QueryMethod_GET,
+					Query:  *peer.TransitGatewayAttachmentId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The peer is part of the Connect attachment
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if config := peer.ConnectPeerConfiguration; config != nil {
+			ips := []*string{config.PeerAddress, config.TransitGatewayAddress}
+
+			for _, bgp := range config.BgpConfigurations {
+				// If BGP is down then routes aren't being exchanged with the
+				// peer, even though the peer itself is available
+				if bgp.BgpStatus == types.BgpStatusDown && item.GetHealth() == sdp.Health_HEALTH_OK {
+					item.Health = sdp.Health_HEALTH_WARNING.Enum()
+				}
+
+				ips = append(ips, bgp.PeerAddress, bgp.TransitGatewayAddress)
+			}
+
+			seen := make(map[string]bool)
+			for _, ip := range ips {
+				if ip == nil || seen[*ip] {
+					continue
+				}
+
+				seen[*ip] = true
+
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ip",
+						Method: sdp.QueryMethod_GET,
+						Query:  *ip,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// IPs always link
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayConnectPeerAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayConnectPeersInput, *ec2.DescribeTransitGatewayConnectPeersOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayConnectPeersInput, *ec2.DescribeTransitGatewayConnectPeersOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway-connect-peer",
+		AdapterMetadata: transitGatewayConnectPeerAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeTransitGatewayConnectPeersInput) (*ec2.DescribeTransitGatewayConnectPeersOutput, error) {
+			return client.DescribeTransitGatewayConnectPeers(ctx, input)
+		},
+		InputMapperGet:  transitGatewayConnectPeerInputMapperGet,
+		InputMapperList: transitGatewayConnectPeerInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeTransitGatewayConnectPeersInput) adapterhelpers.Paginator[*ec2.DescribeTransitGatewayConnectPeersOutput, *ec2.Options] {
+			return ec2.NewDescribeTransitGatewayConnectPeersPaginator(client, params)
+		},
+		OutputMapper: transitGatewayConnectPeerOutputMapper,
+	}
+}
+
+var transitGatewayConnectPeerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway-connect-peer",
+	DescriptiveName: "Transit Gateway Connect Peer",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway Connect peer by ID",
+		ListDescription:   "List all transit gateway Connect peers",
+		SearchDescription: "Search for transit gateway Connect peers by ARN",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway-attachment", "ip"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway_connect_peer.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var transitGatewayConnectPeerAdapterIAMActions = IAMActions.Register(transitGatewayConnectPeerAdapterMetadata,
+	"ec2:DescribeTransitGatewayConnectPeers",
+)
@@ -0,0 +1,134 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestTransitGatewayConnectPeerInputMapperGet(t *testing.T) {
+	input, err := transitGatewayConnectPeerInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.TransitGatewayConnectPeerIds) != 1 {
+		t.Fatalf("expected 1 transit gateway connect peer ID, got %v", len(input.TransitGatewayConnectPeerIds))
+	}
+
+	if input.TransitGatewayConnectPeerIds[0] != "bar" {
+		t.Errorf("expected transit gateway connect peer ID to be bar, got %v", input.TransitGatewayConnectPeerIds[0])
+	}
+}
+
+func TestTransitGatewayConnectPeerOutputMapper(t *testing.T) {
+	output := &ec2.DescribeTransitGatewayConnectPeersOutput{
+		TransitGatewayConnectPeers: []types.TransitGatewayConnectPeer{
+			{
+				ConnectPeerConfiguration: &types.TransitGatewayConnectPeerConfiguration{
+					InsideCidrBlocks:      []string{"169.254.6.0/29"},
+					PeerAddress:           adapterhelpers.PtrString("10.0.0.10"),
+					Protocol:              types.ProtocolValueGre,
+					TransitGatewayAddress: adapterhelpers.PtrString("192.0.2.10"),
+					BgpConfigurations: []types.TransitGatewayAttachmentBgpConfiguration{
+						{
+							BgpStatus:             types.BgpStatusDown,
+							PeerAddress:           adapterhelpers.PtrString("169.254.6.1"),
+							PeerAsn:               adapterhelpers.PtrInt64(65000),
+							TransitGatewayAddress: adapterhelpers.PtrString("169.254.6.2"),
+							TransitGatewayAsn:     adapterhelpers.PtrInt64(64512),
+						},
+						{
+							BgpStatus:             types.BgpStatusUp,
+							PeerAddress:           adapterhelpers.PtrString("169.254.6.1"),
+							PeerAsn:               adapterhelpers.PtrInt64(65000),
+							TransitGatewayAddress: adapterhelpers.PtrString("169.254.6.3"),
+							TransitGatewayAsn:     adapterhelpers.PtrInt64(64512),
+						},
+					},
+				},
+				CreationTime:                adapterhelpers.PtrTime(time.Now()),
+				State:                       types.TransitGatewayConnectPeerStateAvailable,
+				TransitGatewayAttachmentId:  adapterhelpers.PtrString("tgw-attach-0c1d2e3f4a5b6c7d8"),
+				TransitGatewayConnectPeerId: adapterhelpers.PtrString("tgw-connect-peer-0a1b2c3d4e5f6a7b8"),
+			},
+		},
+	}
+
+	items, err := transitGatewayConnectPeerOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	// One of the BGP sessions is down
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	// The attachment plus 5 unique IPs
+	if len(item.GetLinkedItemQueries()) != 6 {
+		t.Errorf("expected 6 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-transit-gateway-attachment",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-attach-0c1d2e3f4a5b6c7d8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.0.10",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "192.0.2.10",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "169.254.6.1",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2TransitGatewayConnectPeerAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayConnectPeerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
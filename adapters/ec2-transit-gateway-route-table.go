@@ -0,0 +1,149 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func transitGatewayRouteTableInputMapperGet(scope string, query string) (*ec2.DescribeTransitGatewayRouteTablesInput, error) {
+	return &ec2.DescribeTransitGatewayRouteTablesInput{
+		TransitGatewayRouteTableIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func transitGatewayRouteTableInputMapperList(scope string) (*ec2.DescribeTransitGatewayRouteTablesInput, error) {
+	return &ec2.DescribeTransitGatewayRouteTablesInput{}, nil
+}
+
+func transitGatewayRouteTableOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeTransitGatewayRouteTablesInput, output *ec2.DescribeTransitGatewayRouteTablesOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, rt := range output.TransitGatewayRouteTables {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(rt, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway-route-table",
+			UniqueAttribute: "TransitGatewayRouteTableId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(rt.Tags),
+		}
+
+		switch rt.State {
+		case types.TransitGatewayRouteTableStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayRouteTableStatePending, types.TransitGatewayRouteTableStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+
+		if rt.TransitGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *rt.TransitGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the gateway will affect the route table
+					In: true,
+					// Changing the route table affects routing through the
+					// gateway, but not the gateway itself
+					Out: false,
+				},
+			})
+		}
+
+		if rt.TransitGatewayRouteTableId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-attachment",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *rt.TransitGatewayRouteTableId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Attachments can propagate routes into the table
+					In: true,
+					// The table decides where traffic from the associated
+					// attachments goes
+					Out: true,
+				},
+			})
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-route",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *rt.TransitGatewayRouteTableId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The routes are part of the table
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayRouteTableAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayRouteTablesInput, *ec2.DescribeTransitGatewayRouteTablesOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewayRouteTablesInput, *ec2.DescribeTransitGatewayRouteTablesOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway-route-table",
+		AdapterMetadata: transitGatewayRouteTableAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeTransitGatewayRouteTablesInput) (*ec2.DescribeTransitGatewayRouteTablesOutput, error) {
+			return client.DescribeTransitGatewayRouteTables(ctx, input)
+		},
+		InputMapperGet:  transitGatewayRouteTableInputMapperGet,
+		InputMapperList: transitGatewayRouteTableInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeTransitGatewayRouteTablesInput) adapterhelpers.Paginator[*ec2.DescribeTransitGatewayRouteTablesOutput, *ec2.Options] {
+			return ec2.NewDescribeTransitGatewayRouteTablesPaginator(client, params)
+		},
+		OutputMapper: transitGatewayRouteTableOutputMapper,
+	}
+}
+
+var transitGatewayRouteTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway-route-table",
+	DescriptiveName: "Transit Gateway Route Table",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway route table by ID",
+		ListDescription:   "List all transit gateway route tables",
+		SearchDescription: "Search for transit gateway route tables by ARN",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway", "ec2-transit-gateway-attachment", "ec2-transit-gateway-route"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway_route_table.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var transitGatewayRouteTableAdapterIAMActions = IAMActions.Register(transitGatewayRouteTableAdapterMetadata,
+	"ec2:DescribeTransitGatewayRouteTables",
+)
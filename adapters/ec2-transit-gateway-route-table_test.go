@@ -0,0 +1,110 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestTransitGatewayRouteTableInputMapperGet(t *testing.T) {
+	input, err := transitGatewayRouteTableInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.TransitGatewayRouteTableIds) != 1 {
+		t.Fatalf("expected 1 transit gateway route table ID, got %v", len(input.TransitGatewayRouteTableIds))
+	}
+
+	if input.TransitGatewayRouteTableIds[0] != "bar" {
+		t.Errorf("expected transit gateway route table ID to be bar, got %v", input.TransitGatewayRouteTableIds[0])
+	}
+}
+
+func TestTransitGatewayRouteTableOutputMapper(t *testing.T) {
+	output := &ec2.DescribeTransitGatewayRouteTablesOutput{
+		TransitGatewayRouteTables: []types.TransitGatewayRouteTable{
+			{
+				CreationTime:                 adapterhelpers.PtrTime(time.Now()),
+				DefaultAssociationRouteTable: adapterhelpers.PtrBool(true),
+				DefaultPropagationRouteTable: adapterhelpers.PtrBool(true),
+				State:                        types.TransitGatewayRouteTableStateAvailable,
+				TransitGatewayId:             adapterhelpers.PtrString("tgw-0e1d2c3b4a5f6e7d8"),
+				TransitGatewayRouteTableId:   adapterhelpers.PtrString("tgw-rtb-0b4e2b4a8b0a2c6d1"),
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("Name"),
+						Value: adapterhelpers.PtrString("default"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := transitGatewayRouteTableOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetTags()["Name"] != "default" {
+		t.Errorf("expected Name tag to be default, got %v", item.GetTags()["Name"])
+	}
+
+	// It doesn't really make sense to test anything other than the linked items
+	// since the attributes are converted automatically
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-transit-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-0e1d2c3b4a5f6e7d8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-attachment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tgw-rtb-0b4e2b4a8b0a2c6d1",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-route",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tgw-rtb-0b4e2b4a8b0a2c6d1",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2TransitGatewayRouteTableAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayRouteTableAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
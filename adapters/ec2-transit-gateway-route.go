@@ -0,0 +1,312 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// transitGatewayRouteID Creates a unique ID for a transit gateway route in the
+// format {routeTableId}|{destination} where the destination is either a CIDR
+// or a prefix list ID
+func transitGatewayRouteID(routeTableID string, destination string) string {
+	return fmt.Sprintf("%v|%v", routeTableID, destination)
+}
+
+func transitGatewayRouteInputMapperGet(scope string, query string) (*ec2.SearchTransitGatewayRoutesInput, error) {
+	// We are expecting the query to be {routeTableId}|{destination}. Terraform
+	// uses {routeTableId}_{destination} for its IDs so we accept that too
+	routeTableID, destination, found := strings.Cut(query, "|")
+	if !found {
+		routeTableID, destination, found = strings.Cut(query, "_")
+	}
+
+	if !found {
+		return nil, errors.New("query must be in the format {routeTableId}|{destination}")
+	}
+
+	filterName := "route-search.exact-match"
+	if strings.HasPrefix(destination, "pl-") {
+		filterName = "prefix-list-id"
+	}
+
+	return &ec2.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: &routeTableID,
+		Filters: []types.Filter{
+			{
+				Name:   &filterName,
+				Values: []string{destination},
+			},
+		},
+	}, nil
+}
+
+// transitGatewayRouteInputMapperSearch Searches for all static and propagated
+// routes in a transit gateway route table
+func transitGatewayRouteInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.SearchTransitGatewayRoutesInput, error) {
+	return &ec2.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: &query,
+		Filters: []types.Filter{
+			{
+				Name: adapterhelpers.PtrString("type"),
+				Values: []string{
+					string(types.TransitGatewayRouteTypeStatic),
+					string(types.TransitGatewayRouteTypePropagated),
+				},
+			},
+		},
+	}, nil
+}
+
+type transitGatewayRouteClient interface {
+	SearchTransitGatewayRoutes(ctx context.Context, params *ec2.SearchTransitGatewayRoutesInput, optFns ...func(*ec2.Options)) (*ec2.SearchTransitGatewayRoutesOutput, error)
+}
+
+// transitGatewayRouteSearch SearchTransitGatewayRoutes isn't paginated, it
+// returns at most 1,000 routes and sets AdditionalRoutesAvailable if there are
+// more. When that happens the search is repeated once for each route type and
+// state, which narrows the results enough for all but the largest route
+// tables. If a narrowed search is still truncated a warning is logged since
+// some routes will be missing
+func transitGatewayRouteSearch(ctx context.Context, client transitGatewayRouteClient, input *ec2.SearchTransitGatewayRoutesInput) (*ec2.SearchTransitGatewayRoutesOutput, error) {
+	output, err := client.SearchTransitGatewayRoutes(ctx, input)
+	if err != nil || !aws.ToBool(output.AdditionalRoutesAvailable) {
+		return output, err
+	}
+
+	routeTypes := []string{
+		string(types.TransitGatewayRouteTypeStatic),
+		string(types.TransitGatewayRouteTypePropagated),
+	}
+	routeStates := []string{
+		string(types.TransitGatewayRouteStateActive),
+		string(types.TransitGatewayRouteStateBlackhole),
+		string(types.TransitGatewayRouteStatePending),
+		string(types.TransitGatewayRouteStateDeleting),
+		string(types.TransitGatewayRouteStateDeleted),
+	}
+
+	// Keep any other filters, such as an exact match, and only narrow the
+	// types and states that were requested
+	filters := make([]types.Filter, 0)
+	for _, filter := range input.Filters {
+		switch aws.ToString(filter.Name) {
+		case "type":
+			routeTypes = filter.Values
+		case "state":
+			routeStates = filter.Values
+		default:
+			filters = append(filters, filter)
+		}
+	}
+
+	narrowed := &ec2.SearchTransitGatewayRoutesOutput{
+		AdditionalRoutesAvailable: aws.Bool(false),
+	}
+
+	for _, routeType := range routeTypes {
+		for _, routeState := range routeStates {
+			out, err := client.SearchTransitGatewayRoutes(ctx, &ec2.SearchTransitGatewayRoutesInput{
+				TransitGatewayRouteTableId: input.TransitGatewayRouteTableId,
+				Filters: append(slices.Clone(filters),
+					types.Filter{
+						Name:   aws.String("type"),
+						Values: []string{routeType},
+					},
+					types.Filter{
+						Name:   aws.String("state"),
+						Values: []string{routeState},
+					},
+				),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			narrowed.Routes = append(narrowed.Routes, out.Routes...)
+
+			if aws.ToBool(out.AdditionalRoutesAvailable) {
+				narrowed.AdditionalRoutesAvailable = aws.Bool(true)
+
+				log.WithFields(log.Fields{
+					"routeTableId": aws.ToString(input.TransitGatewayRouteTableId),
+					"type":         routeType,
+					"state":        routeState,
+				}).Warn("Transit gateway route table has more routes than can be returned, some routes will be missing")
+			}
+		}
+	}
+
+	return narrowed, nil
+}
+
+func transitGatewayRouteOutputMapper(_ context.Context, _ *ec2.Client, scope string, input *ec2.SearchTransitGatewayRoutesInput, output *ec2.SearchTransitGatewayRoutesOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	if input == nil || input.TransitGatewayRouteTableId == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "transit gateway route table ID must be set on the input",
+			Scope:       scope,
+		}
+	}
+
+	routeTableID := *input.TransitGatewayRouteTableId
+
+	for _, route := range output.Routes {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(route)
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		var destination string
+		switch {
+		case route.DestinationCidrBlock != nil:
+			destination = *route.DestinationCidrBlock
+		case route.PrefixListId != nil:
+			destination = *route.PrefixListId
+		default:
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: "ec2-transit-gateway-route must have a DestinationCidrBlock or PrefixListId",
+				Scope:       scope,
+			}
+		}
+
+		// Create a custom UAV here since routes don't have an ID
+		err = attrs.Set("TransitGatewayRouteTableId", routeTableID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = attrs.Set("RouteTableIdDestination", transitGatewayRouteID(routeTableID, destination))
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway-route",
+			UniqueAttribute: "RouteTableIdDestination",
+			Scope:           scope,
+			Attributes:      attrs,
+		}
+
+		switch route.State {
+		case types.TransitGatewayRouteStateActive:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayRouteStatePending, types.TransitGatewayRouteStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.TransitGatewayRouteStateBlackhole:
+			// Traffic matching a blackhole route is dropped. This is often
+			// deliberate, but it also happens when an attachment is deleted
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-transit-gateway-route-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  routeTableID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The route is part of the table
+				In:  true,
+				Out: true,
+			},
+		})
+
+		if route.PrefixListId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-managed-prefix-list",
+					Method: sdp.QueryMethod_GET,
+					Query:  *route.PrefixListId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the prefix list changes which traffic the
+					// route matches
+					In: true,
+					// The route doesn't affect the prefix list
+					Out: false,
+				},
+			})
+		}
+
+		for _, attachment := range route.TransitGatewayAttachments {
+			if attachment.TransitGatewayAttachmentId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-transit-gateway-attachment",
+						Method: sdp.QueryMethod_GET,
+						Query:  *attachment.TransitGatewayAttachmentId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Deleting the attachment will blackhole the route
+						In: true,
+						// Changing the route changes the traffic sent to the
+						// attachment
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayRouteAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.SearchTransitGatewayRoutesInput, *ec2.SearchTransitGatewayRoutesOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.SearchTransitGatewayRoutesInput, *ec2.SearchTransitGatewayRoutesOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway-route",
+		AdapterMetadata: transitGatewayRouteAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.SearchTransitGatewayRoutesInput) (*ec2.SearchTransitGatewayRoutesOutput, error) {
+			return transitGatewayRouteSearch(ctx, client, input)
+		},
+		InputMapperGet:    transitGatewayRouteInputMapperGet,
+		InputMapperSearch: transitGatewayRouteInputMapperSearch,
+		OutputMapper:      transitGatewayRouteOutputMapper,
+	}
+}
+
+var transitGatewayRouteAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway-route",
+	DescriptiveName: "Transit Gateway Route",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway route by {routeTableId}|{destination} where the destination is a CIDR or prefix list ID",
+		SearchDescription: "Search for the static and propagated routes in a transit gateway route table by route table ID",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway-route-table", "ec2-transit-gateway-attachment", "ec2-managed-prefix-list"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway_route.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var transitGatewayRouteAdapterIAMActions = IAMActions.Register(transitGatewayRouteAdapterMetadata,
+	"ec2:SearchTransitGatewayRoutes",
+)
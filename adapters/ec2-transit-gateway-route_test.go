@@ -0,0 +1,247 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestTransitGatewayRouteInputMapperGet(t *testing.T) {
+	tests := []struct {
+		Query          string
+		ExpectedTable  string
+		ExpectedFilter string
+		ExpectedValue  string
+	}{
+		{
+			Query:          "tgw-rtb-0b4e2b4a8b0a2c6d1|10.0.0.0/16",
+			ExpectedTable:  "tgw-rtb-0b4e2b4a8b0a2c6d1",
+			ExpectedFilter: "route-search.exact-match",
+			ExpectedValue:  "10.0.0.0/16",
+		},
+		{
+			Query:          "tgw-rtb-0b4e2b4a8b0a2c6d1_10.0.0.0/16",
+			ExpectedTable:  "tgw-rtb-0b4e2b4a8b0a2c6d1",
+			ExpectedFilter: "route-search.exact-match",
+			ExpectedValue:  "10.0.0.0/16",
+		},
+		{
+			Query:          "tgw-rtb-0b4e2b4a8b0a2c6d1|pl-0a1b2c3d4e5f6a7b8",
+			ExpectedTable:  "tgw-rtb-0b4e2b4a8b0a2c6d1",
+			ExpectedFilter: "prefix-list-id",
+			ExpectedValue:  "pl-0a1b2c3d4e5f6a7b8",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Query, func(t *testing.T) {
+			input, err := transitGatewayRouteInputMapperGet("foo", test.Query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if *input.TransitGatewayRouteTableId != test.ExpectedTable {
+				t.Errorf("expected route table %v, got %v", test.ExpectedTable, *input.TransitGatewayRouteTableId)
+			}
+
+			if len(input.Filters) != 1 {
+				t.Fatalf("expected 1 filter, got %v", len(input.Filters))
+			}
+
+			if *input.Filters[0].Name != test.ExpectedFilter {
+				t.Errorf("expected filter %v, got %v", test.ExpectedFilter, *input.Filters[0].Name)
+			}
+
+			if input.Filters[0].Values[0] != test.ExpectedValue {
+				t.Errorf("expected filter value %v, got %v", test.ExpectedValue, input.Filters[0].Values[0])
+			}
+		})
+	}
+
+	t.Run("bad query", func(t *testing.T) {
+		_, err := transitGatewayRouteInputMapperGet("foo", "tgw-rtb-0b4e2b4a8b0a2c6d1")
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestTransitGatewayRouteOutputMapper(t *testing.T) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		TransitGatewayRouteTableId: adapterhelpers.PtrString("tgw-rtb-0b4e2b4a8b0a2c6d1"),
+	}
+
+	output := &ec2.SearchTransitGatewayRoutesOutput{
+		Routes: []types.TransitGatewayRoute{
+			{
+				DestinationCidrBlock: adapterhelpers.PtrString("10.0.0.0/16"),
+				State:                types.TransitGatewayRouteStateActive,
+				Type:                 types.TransitGatewayRouteTypePropagated,
+				TransitGatewayAttachments: []types.TransitGatewayRouteAttachment{
+					{
+						ResourceId:                 adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+						ResourceType:               types.TransitGatewayAttachmentResourceTypeVpc,
+						TransitGatewayAttachmentId: adapterhelpers.PtrString("tgw-attach-0a1b2c3d4e5f6a7b8"),
+					},
+				},
+			},
+			{
+				PrefixListId: adapterhelpers.PtrString("pl-0a1b2c3d4e5f6a7b8"),
+				State:        types.TransitGatewayRouteStateBlackhole,
+				Type:         types.TransitGatewayRouteTypeStatic,
+			},
+		},
+	}
+
+	items, err := transitGatewayRouteOutputMapper(context.Background(), nil, "foo", input, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	t.Run("CIDR route", func(t *testing.T) {
+		item := items[0]
+
+		if item.UniqueAttributeValue() != "tgw-rtb-0b4e2b4a8b0a2c6d1|10.0.0.0/16" {
+			t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+		}
+
+		if item.GetHealth() != sdp.Health_HEALTH_OK {
+			t.Errorf("expected health to be OK, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-transit-gateway-route-table",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "tgw-rtb-0b4e2b4a8b0a2c6d1",
+				ExpectedScope:  "foo",
+			},
+			{
+				ExpectedType:   "ec2-transit-gateway-attachment",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "tgw-attach-0a1b2c3d4e5f6a7b8",
+				ExpectedScope:  "foo",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("prefix list route", func(t *testing.T) {
+		item := items[1]
+
+		if item.UniqueAttributeValue() != "tgw-rtb-0b4e2b4a8b0a2c6d1|pl-0a1b2c3d4e5f6a7b8" {
+			t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+		}
+
+		if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+			t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ec2-managed-prefix-list",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "pl-0a1b2c3d4e5f6a7b8",
+				ExpectedScope:  "foo",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+}
+
+// testTransitGatewayRouteClient Returns a truncated result unless the search
+// has been narrowed by type and state, in which case it returns one route
+type testTransitGatewayRouteClient struct {
+	inputs []*ec2.SearchTransitGatewayRoutesInput
+}
+
+func (c *testTransitGatewayRouteClient) SearchTransitGatewayRoutes(ctx context.Context, params *ec2.SearchTransitGatewayRoutesInput, optFns ...func(*ec2.Options)) (*ec2.SearchTransitGatewayRoutesOutput, error) {
+	c.inputs = append(c.inputs, params)
+
+	var routeType, routeState string
+	for _, filter := range params.Filters {
+		switch *filter.Name {
+		case "type":
+			routeType = filter.Values[0]
+		case "state":
+			routeState = filter.Values[0]
+		}
+	}
+
+	if routeState == "" {
+		return &ec2.SearchTransitGatewayRoutesOutput{
+			AdditionalRoutesAvailable: adapterhelpers.PtrBool(true),
+		}, nil
+	}
+
+	return &ec2.SearchTransitGatewayRoutesOutput{
+		Routes: []types.TransitGatewayRoute{
+			{
+				DestinationCidrBlock: adapterhelpers.PtrString("10.0.0.0/16"),
+				State:                types.TransitGatewayRouteState(routeState),
+				Type:                 types.TransitGatewayRouteType(routeType),
+			},
+		},
+		AdditionalRoutesAvailable: adapterhelpers.PtrBool(false),
+	}, nil
+}
+
+func TestTransitGatewayRouteSearchTruncated(t *testing.T) {
+	client := &testTransitGatewayRouteClient{}
+
+	input, err := transitGatewayRouteInputMapperSearch(context.Background(), nil, "foo", "tgw-rtb-0b4e2b4a8b0a2c6d1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := transitGatewayRouteSearch(context.Background(), client, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The original search, then one for each of the 2 types and 5 states
+	if len(client.inputs) != 11 {
+		t.Errorf("expected 11 searches, got %v", len(client.inputs))
+	}
+
+	if len(output.Routes) != 10 {
+		t.Errorf("expected 10 routes, got %v", len(output.Routes))
+	}
+
+	if *output.AdditionalRoutesAvailable {
+		t.Error("expected the narrowed searches not to be truncated")
+	}
+}
+
+func TestNewEC2TransitGatewayRouteAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayRouteAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,147 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func transitGatewayInputMapperGet(scope string, query string) (*ec2.DescribeTransitGatewaysInput, error) {
+	return &ec2.DescribeTransitGatewaysInput{
+		TransitGatewayIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func transitGatewayInputMapperList(scope string) (*ec2.DescribeTransitGatewaysInput, error) {
+	return &ec2.DescribeTransitGatewaysInput{}, nil
+}
+
+func transitGatewayOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeTransitGatewaysInput, output *ec2.DescribeTransitGatewaysOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, tgw := range output.TransitGateways {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(tgw, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-transit-gateway",
+			UniqueAttribute: "TransitGatewayId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(tgw.Tags),
+		}
+
+		switch tgw.State {
+		case types.TransitGatewayStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.TransitGatewayStatePending, types.TransitGatewayStateModifying, types.TransitGatewayStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+
+		if tgw.TransitGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-attachment",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *tgw.TransitGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing an attachment doesn't affect the gateway itself
+					In: false,
+					// Changing the gateway will affect everything attached to
+					// it
+					Out: true,
+				},
+			})
+		}
+
+		if tgw.Options != nil {
+			// The default route tables will often be the same table, so we
+			// only need to link it once
+			routeTables := make(map[string]bool)
+
+			for _, id := range []*string{tgw.Options.AssociationDefaultRouteTableId, tgw.Options.PropagationDefaultRouteTableId} {
+				if id == nil || routeTables[*id] {
+					continue
+				}
+
+				routeTables[*id] = true
+
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-transit-gateway-route-table",
+						Method: sdp.QueryMethod_GET,
+						Query:  *id,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The default route tables control how traffic is
+						// routed through the gateway, and changing the gateway
+						// can affect the routes in them
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2TransitGatewayAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewaysInput, *ec2.DescribeTransitGatewaysOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeTransitGatewaysInput, *ec2.DescribeTransitGatewaysOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-transit-gateway",
+		AdapterMetadata: transitGatewayAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeTransitGatewaysInput) (*ec2.DescribeTransitGatewaysOutput, error) {
+			return client.DescribeTransitGateways(ctx, input)
+		},
+		InputMapperGet:  transitGatewayInputMapperGet,
+		InputMapperList: transitGatewayInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeTransitGatewaysInput) adapterhelpers.Paginator[*ec2.DescribeTransitGatewaysOutput, *ec2.Options] {
+			return ec2.NewDescribeTransitGatewaysPaginator(client, params)
+		},
+		OutputMapper: transitGatewayOutputMapper,
+	}
+}
+
+var transitGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-transit-gateway",
+	DescriptiveName: "Transit Gateway",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a transit gateway by ID",
+		ListDescription:   "List all transit gateways",
+		SearchDescription: "Search for transit gateways by ARN",
+	},
+	PotentialLinks: []string{"ec2-transit-gateway-attachment", "ec2-transit-gateway-route-table"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_transit_gateway.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var transitGatewayAdapterIAMActions = IAMActions.Register(transitGatewayAdapterMetadata,
+	"ec2:DescribeTransitGateways",
+)
@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestTransitGatewayInputMapperGet(t *testing.T) {
+	input, err := transitGatewayInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.TransitGatewayIds) != 1 {
+		t.Fatalf("expected 1 TransitGateway ID, got %v", len(input.TransitGatewayIds))
+	}
+
+	if input.TransitGatewayIds[0] != "bar" {
+		t.Errorf("expected TransitGateway ID to be bar, got %v", input.TransitGatewayIds[0])
+	}
+}
+
+func TestTransitGatewayOutputMapper(t *testing.T) {
+	output := &ec2.DescribeTransitGatewaysOutput{
+		TransitGateways: []types.TransitGateway{
+			{
+				CreationTime: adapterhelpers.PtrTime(time.Now()),
+				Description:  adapterhelpers.PtrString("hub"),
+				Options: &types.TransitGatewayOptions{
+					AmazonSideAsn:                  adapterhelpers.PtrInt64(64512),
+					AssociationDefaultRouteTableId: adapterhelpers.PtrString("tgw-rtb-0b4e2b4a8b0a2c6d1"),
+					PropagationDefaultRouteTableId: adapterhelpers.PtrString("tgw-rtb-0b4e2b4a8b0a2c6d1"),
+					DefaultRouteTableAssociation:   types.DefaultRouteTableAssociationValueEnable,
+					DefaultRouteTablePropagation:   types.DefaultRouteTablePropagationValueEnable,
+				},
+				OwnerId:           adapterhelpers.PtrString("123456789012"),
+				State:             types.TransitGatewayStateAvailable,
+				TransitGatewayArn: adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:123456789012:transit-gateway/tgw-0e1d2c3b4a5f6e7d8"),
+				TransitGatewayId:  adapterhelpers.PtrString("tgw-0e1d2c3b4a5f6e7d8"),
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("Name"),
+						Value: adapterhelpers.PtrString("hub"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := transitGatewayOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	// The association and propagation route tables are the same so should
+	// only be linked once
+	if len(item.GetLinkedItemQueries()) != 2 {
+		t.Errorf("expected 2 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-transit-gateway-attachment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tgw-0e1d2c3b4a5f6e7d8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway-route-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-rtb-0b4e2b4a8b0a2c6d1",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2TransitGatewayAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2TransitGatewayAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -129,3 +129,7 @@ var volumeStatusAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var volumeStatusAdapterIAMActions = IAMActions.Register(volumeStatusAdapterMetadata,
+	"ec2:DescribeVolumeStatus",
+)
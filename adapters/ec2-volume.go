@@ -103,3 +103,7 @@ var volumeAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var volumeAdapterIAMActions = IAMActions.Register(volumeAdapterMetadata,
+	"ec2:DescribeVolumes",
+)
@@ -0,0 +1,213 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// vpcEndpointConnectionInputMapperGet Gets the connection for a given consumer
+// endpoint. An endpoint can only connect to a single service so this is unique
+func vpcEndpointConnectionInputMapperGet(scope string, query string) (*ec2.DescribeVpcEndpointConnectionsInput, error) {
+	return &ec2.DescribeVpcEndpointConnectionsInput{
+		Filters: []types.Filter{
+			{
+				Name:   adapterhelpers.PtrString("vpc-endpoint-id"),
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+func vpcEndpointConnectionInputMapperList(scope string) (*ec2.DescribeVpcEndpointConnectionsInput, error) {
+	return &ec2.DescribeVpcEndpointConnectionsInput{}, nil
+}
+
+// vpcEndpointConnectionInputMapperSearch Searches for all of the connections to
+// an endpoint service by service ID
+func vpcEndpointConnectionInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.DescribeVpcEndpointConnectionsInput, error) {
+	return &ec2.DescribeVpcEndpointConnectionsInput{
+		Filters: []types.Filter{
+			{
+				Name:   adapterhelpers.PtrString("service-id"),
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+func vpcEndpointConnectionOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeVpcEndpointConnectionsInput, output *ec2.DescribeVpcEndpointConnectionsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, connection := range output.VpcEndpointConnections {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(connection, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-vpc-endpoint-connection",
+			UniqueAttribute: "VpcEndpointId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(connection.Tags),
+		}
+
+		// Like VPC endpoints, the API returns the state in lowercase
+		switch strings.ToLower(string(connection.VpcEndpointState)) {
+		case strings.ToLower(string(types.StateAvailable)):
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case strings.ToLower(string(types.StatePending)),
+			strings.ToLower(string(types.StatePendingAcceptance)),
+			strings.ToLower(string(types.StateDeleting)):
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case strings.ToLower(string(types.StatePartial)):
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case strings.ToLower(string(types.StateRejected)),
+			strings.ToLower(string(types.StateFailed)),
+			strings.ToLower(string(types.StateExpired)):
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		if connection.ServiceId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc-endpoint-service",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.ServiceId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the service will affect the connection
+					In: true,
+					// The connection doesn't affect the service itself
+					Out: false,
+				},
+			})
+		}
+
+		if connection.VpcEndpointId != nil {
+			// The consumer's endpoint is usually in another account, and can
+			// be in another region
+			endpointScope := scope
+			if connection.VpcEndpointOwner != nil {
+				if connection.VpcEndpointRegion != nil {
+					endpointScope = adapterhelpers.FormatScope(*connection.VpcEndpointOwner, *connection.VpcEndpointRegion)
+				} else if _, region, err := adapterhelpers.ParseScope(scope); err == nil {
+					endpointScope = adapterhelpers.FormatScope(*connection.VpcEndpointOwner, region)
+				}
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc-endpoint",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.VpcEndpointId,
+					Scope:  endpointScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The connection only exists because of the endpoint
+					In: true,
+					// Rejecting or deleting the connection breaks the
+					// consumer's endpoint
+					Out: true,
+				},
+			})
+		}
+
+		for _, lbARN := range slices.Concat(connection.NetworkLoadBalancerArns, connection.GatewayLoadBalancerArns) {
+			lbScope := scope
+			if arn, err := adapterhelpers.ParseARN(lbARN); err == nil {
+				lbScope = adapterhelpers.FormatScope(arn.AccountID, arn.Region)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elbv2-load-balancer",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  lbARN,
+					Scope:  lbScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Traffic from the consumer is sent to this load balancer
+					// so changing it will affect the connection
+					In: true,
+					// The connection doesn't affect the load balancer
+					Out: false,
+				},
+			})
+		}
+
+		for _, dnsEntry := range connection.DnsEntries {
+			if dnsEntry.DnsName != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "dns",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *dnsEntry.DnsName,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// These are tightly linked
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2VpcEndpointConnectionAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeVpcEndpointConnectionsInput, *ec2.DescribeVpcEndpointConnectionsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeVpcEndpointConnectionsInput, *ec2.DescribeVpcEndpointConnectionsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-vpc-endpoint-connection",
+		AdapterMetadata: vpcEndpointConnectionAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeVpcEndpointConnectionsInput) (*ec2.DescribeVpcEndpointConnectionsOutput, error) {
+			return client.DescribeVpcEndpointConnections(ctx, input)
+		},
+		InputMapperGet:    vpcEndpointConnectionInputMapperGet,
+		InputMapperList:   vpcEndpointConnectionInputMapperList,
+		InputMapperSearch: vpcEndpointConnectionInputMapperSearch,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeVpcEndpointConnectionsInput) adapterhelpers.Paginator[*ec2.DescribeVpcEndpointConnectionsOutput, *ec2.Options] {
+			return ec2.NewDescribeVpcEndpointConnectionsPaginator(client, params)
+		},
+		OutputMapper: vpcEndpointConnectionOutputMapper,
+	}
+}
+
+var vpcEndpointConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-vpc-endpoint-connection",
+	DescriptiveName: "VPC Endpoint Connection",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get the connection to one of our endpoint services by the consumer's VPC endpoint ID",
+		ListDescription:   "List all connections to endpoint services owned by this account",
+		SearchDescription: "Search for connections to an endpoint service by service ID",
+	},
+	PotentialLinks: []string{"ec2-vpc-endpoint-service", "ec2-vpc-endpoint", "elbv2-load-balancer", "dns"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var vpcEndpointConnectionAdapterIAMActions = IAMActions.Register(vpcEndpointConnectionAdapterMetadata,
+	"ec2:DescribeVpcEndpointConnections",
+)
@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVpcEndpointConnectionInputMapperGet(t *testing.T) {
+	input, err := vpcEndpointConnectionInputMapperGet("foo", "vpce-0a1b2c3d4e5f6a7b8")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.Filters) != 1 {
+		t.Fatalf("expected 1 filter, got %v", len(input.Filters))
+	}
+
+	if *input.Filters[0].Name != "vpc-endpoint-id" || input.Filters[0].Values[0] != "vpce-0a1b2c3d4e5f6a7b8" {
+		t.Errorf("unexpected filter %v=%v", *input.Filters[0].Name, input.Filters[0].Values)
+	}
+}
+
+func TestVpcEndpointConnectionOutputMapper(t *testing.T) {
+	output := &ec2.DescribeVpcEndpointConnectionsOutput{
+		VpcEndpointConnections: []types.VpcEndpointConnection{
+			{
+				CreationTimestamp: adapterhelpers.PtrTime(time.Now()),
+				DnsEntries: []types.DnsEntry{
+					{
+						DnsName:      adapterhelpers.PtrString("vpce-0a1b2c3d4e5f6a7b8-abcdefgh.vpce-svc-0a1b2c3d4e5f6a7b8.eu-west-2.vpce.amazonaws.com"),
+						HostedZoneId: adapterhelpers.PtrString("Z1234567890ABC"),
+					},
+				},
+				IpAddressType:           types.IpAddressTypeIpv4,
+				NetworkLoadBalancerArns: []string{"arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/net/privatelink/0a1b2c3d4e5f6a7b"},
+				ServiceId:               adapterhelpers.PtrString("vpce-svc-0a1b2c3d4e5f6a7b8"),
+				VpcEndpointConnectionId: adapterhelpers.PtrString("vpce-con-0a1b2c3d4e5f6a7b8"),
+				VpcEndpointId:           adapterhelpers.PtrString("vpce-0a1b2c3d4e5f6a7b8"),
+				VpcEndpointOwner:        adapterhelpers.PtrString("210987654321"),
+				// The API returns this in lowercase
+				VpcEndpointState: types.State("available"),
+			},
+		},
+	}
+
+	items, err := vpcEndpointConnectionOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc-endpoint-service",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpce-svc-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			// The consumer is in another account
+			ExpectedType:   "ec2-vpc-endpoint",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpce-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "210987654321.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/net/privatelink/0a1b2c3d4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "vpce-0a1b2c3d4e5f6a7b8-abcdefgh.vpce-svc-0a1b2c3d4e5f6a7b8.eu-west-2.vpce.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2VpcEndpointConnectionAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2VpcEndpointConnectionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
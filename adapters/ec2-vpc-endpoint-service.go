@@ -0,0 +1,402 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// vpcEndpointService Combines the two views of an endpoint service. The
+// configuration is only available to the owner of the service and contains the
+// load balancers behind it, the detail is available to anyone that can consume
+// the service, including AWS services
+type vpcEndpointService struct {
+	Configuration *types.ServiceConfiguration
+	Detail        *types.ServiceDetail
+}
+
+// vpcEndpointServiceDetails Gets the consumer view of the given services keyed
+// by service name
+func vpcEndpointServiceDetails(ctx context.Context, client *ec2.Client, serviceNames []string) (map[string]types.ServiceDetail, error) {
+	details := make(map[string]types.ServiceDetail)
+
+	input := &ec2.DescribeVpcEndpointServicesInput{
+		ServiceNames: serviceNames,
+	}
+
+	// There is no paginator for this API
+	for {
+		out, err := client.DescribeVpcEndpointServices(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, detail := range out.ServiceDetails {
+			if detail.ServiceName != nil {
+				details[*detail.ServiceName] = detail
+			}
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return details, nil
+}
+
+// vpcEndpointServicesFromConfigurations Adds the consumer view to services
+// that we own. Since the configuration contains almost everything, errors
+// getting the details are ignored. This can happen if the service is still
+// pending for example
+func vpcEndpointServicesFromConfigurations(ctx context.Context, client *ec2.Client, configurations []types.ServiceConfiguration) []*vpcEndpointService {
+	services := make([]*vpcEndpointService, 0, len(configurations))
+	names := make([]string, 0, len(configurations))
+
+	for _, configuration := range configurations {
+		services = append(services, &vpcEndpointService{
+			Configuration: &configuration,
+		})
+
+		if configuration.ServiceName != nil {
+			names = append(names, *configuration.ServiceName)
+		}
+	}
+
+	if len(names) == 0 {
+		return services
+	}
+
+	details, err := vpcEndpointServiceDetails(ctx, client, names)
+	if err != nil {
+		return services
+	}
+
+	for _, service := range services {
+		if service.Configuration.ServiceName == nil {
+			continue
+		}
+
+		if detail, ok := details[*service.Configuration.ServiceName]; ok {
+			service.Detail = &detail
+		}
+	}
+
+	return services
+}
+
+func vpcEndpointServiceGetFunc(ctx context.Context, client *ec2.Client, scope string, query string) (*vpcEndpointService, error) {
+	notFound := &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: "endpoint service " + query + " not found",
+		Scope:       scope,
+	}
+
+	if strings.HasPrefix(query, "vpce-svc-") {
+		// Services can only be looked up by ID if we own them
+		out, err := client.DescribeVpcEndpointServiceConfigurations(ctx, &ec2.DescribeVpcEndpointServiceConfigurationsInput{
+			ServiceIds: []string{query},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(out.ServiceConfigurations) == 0 {
+			return nil, notFound
+		}
+
+		return vpcEndpointServicesFromConfigurations(ctx, client, out.ServiceConfigurations[:1])[0], nil
+	}
+
+	// Otherwise this is a service name which could be an AWS service, a service
+	// shared with us, or one of our own
+	details, err := vpcEndpointServiceDetails(ctx, client, []string{query})
+	if err != nil {
+		return nil, err
+	}
+
+	detail, ok := details[query]
+	if !ok {
+		return nil, notFound
+	}
+
+	service := &vpcEndpointService{
+		Detail: &detail,
+	}
+
+	out, err := client.DescribeVpcEndpointServiceConfigurations(ctx, &ec2.DescribeVpcEndpointServiceConfigurationsInput{
+		Filters: []types.Filter{
+			{
+				Name:   adapterhelpers.PtrString("service-name"),
+				Values: []string{query},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.ServiceConfigurations) > 0 {
+		service.Configuration = &out.ServiceConfigurations[0]
+	}
+
+	return service, nil
+}
+
+func vpcEndpointServiceListFunc(ctx context.Context, client *ec2.Client, scope string) ([]*vpcEndpointService, error) {
+	configurations := make([]types.ServiceConfiguration, 0)
+
+	paginator := ec2.NewDescribeVpcEndpointServiceConfigurationsPaginator(client, &ec2.DescribeVpcEndpointServiceConfigurationsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		configurations = append(configurations, out.ServiceConfigurations...)
+	}
+
+	return vpcEndpointServicesFromConfigurations(ctx, client, configurations), nil
+}
+
+// vpcEndpointServiceSearchFunc Searches for endpoint services by ARN. If the
+// ARN is for a load balancer this returns the services that it backs, which
+// allows load balancers to link to the services in front of them
+func vpcEndpointServiceSearchFunc(ctx context.Context, client *ec2.Client, scope string, query string) ([]*vpcEndpointService, error) {
+	arn, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be an endpoint service or load balancer ARN",
+			Scope:       scope,
+		}
+	}
+
+	if arn.Service != "elasticloadbalancing" {
+		service, err := vpcEndpointServiceGetFunc(ctx, client, scope, arn.ResourceID())
+		if err != nil {
+			return nil, err
+		}
+
+		return []*vpcEndpointService{service}, nil
+	}
+
+	// There is no filter for the load balancer so we need to check all of
+	// the services that we own
+	services, err := vpcEndpointServiceListFunc(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*vpcEndpointService, 0)
+	for _, service := range services {
+		lbARNs := slices.Concat(service.Configuration.NetworkLoadBalancerArns, service.Configuration.GatewayLoadBalancerArns)
+
+		for _, lbARN := range lbARNs {
+			if lbARN == query {
+				matches = append(matches, service)
+				break
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func vpcEndpointServiceItemMapper(_, scope string, service *vpcEndpointService) (*sdp.Item, error) {
+	var tags []types.Tag
+	views := make([]interface{}, 0, 2)
+
+	// Start with the consumer view, then overlay the owner's configuration
+	// since it has more detail
+	if service.Detail != nil {
+		views = append(views, service.Detail)
+		tags = service.Detail.Tags
+	}
+
+	if service.Configuration != nil {
+		views = append(views, service.Configuration)
+		tags = service.Configuration.Tags
+	}
+
+	var attrs *sdp.ItemAttributes
+	for _, view := range views {
+		viewAttrs, err := adapterhelpers.ToAttributesWithExclude(view, "tags")
+		if err != nil {
+			return nil, err
+		}
+
+		if attrs == nil {
+			attrs = viewAttrs
+			continue
+		}
+
+		for key, value := range viewAttrs.GetAttrStruct().GetFields() {
+			attrs.GetAttrStruct().GetFields()[key] = value
+		}
+	}
+
+	if attrs == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "endpoint service has no configuration or details",
+			Scope:       scope,
+		}
+	}
+
+	item := sdp.Item{
+		Type:            "ec2-vpc-endpoint-service",
+		UniqueAttribute: "ServiceId",
+		Scope:           scope,
+		Attributes:      attrs,
+		Tags:            ec2TagsToMap(tags),
+	}
+
+	var serviceID *string
+	var privateDNSName *string
+	var baseEndpointDNSNames []string
+
+	if service.Detail != nil {
+		serviceID = service.Detail.ServiceId
+		privateDNSName = service.Detail.PrivateDnsName
+		baseEndpointDNSNames = service.Detail.BaseEndpointDnsNames
+	}
+
+	if configuration := service.Configuration; configuration != nil {
+		serviceID = configuration.ServiceId
+		privateDNSName = configuration.PrivateDnsName
+		baseEndpointDNSNames = configuration.BaseEndpointDnsNames
+
+		switch configuration.ServiceState {
+		case types.ServiceStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.ServiceStatePending, types.ServiceStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.ServiceStateFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		lbARNs := slices.Concat(configuration.NetworkLoadBalancerArns, configuration.GatewayLoadBalancerArns)
+
+		for _, lbARN := range lbARNs {
+			lbScope := scope
+			if arn, err := adapterhelpers.ParseARN(lbARN); err == nil {
+				lbScope = adapterhelpers.FormatScope(arn.AccountID, arn.Region)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elbv2-load-balancer",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  lbARN,
+					Scope:  lbScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the load balancer will affect everything
+					// that connects through the service
+					In: true,
+					// The service doesn't affect the load balancer
+					Out: false,
+				},
+			})
+		}
+
+		if serviceID != nil {
+			// Only the owner can see who is connected to the service
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc-endpoint-connection",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *serviceID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Consumers don't affect the service
+					In: false,
+					// Changing the service affects all of its consumers
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if privateDNSName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *privateDNSName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// These are tightly linked
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	for _, name := range baseEndpointDNSNames {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  name,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// These are tightly linked
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewEC2VpcEndpointServiceAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*vpcEndpointService, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.GetListAdapter[*vpcEndpointService, *ec2.Client, *ec2.Options]{
+		ItemType:        "ec2-vpc-endpoint-service",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: vpcEndpointServiceAdapterMetadata,
+		GetFunc:         vpcEndpointServiceGetFunc,
+		ListFunc:        vpcEndpointServiceListFunc,
+		SearchFunc:      vpcEndpointServiceSearchFunc,
+		ItemMapper:      vpcEndpointServiceItemMapper,
+	}
+}
+
+var vpcEndpointServiceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-vpc-endpoint-service",
+	DescriptiveName: "VPC Endpoint Service",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an endpoint service by ID, or any available endpoint service (including AWS services) by service name",
+		ListDescription:   "List all endpoint services owned by this account",
+		SearchDescription: "Search for endpoint services by ARN, or by the ARN of the load balancer behind them",
+	},
+	PotentialLinks: []string{"elbv2-load-balancer", "ec2-vpc-endpoint-connection", "dns"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_vpc_endpoint_service.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var vpcEndpointServiceAdapterIAMActions = IAMActions.Register(vpcEndpointServiceAdapterMetadata,
+	"ec2:DescribeVpcEndpointServiceConfigurations",
+	"ec2:DescribeVpcEndpointServices",
+)
@@ -0,0 +1,145 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVpcEndpointServiceItemMapper(t *testing.T) {
+	t.Run("owned service", func(t *testing.T) {
+		service := &vpcEndpointService{
+			Configuration: &types.ServiceConfiguration{
+				AcceptanceRequired:      adapterhelpers.PtrBool(true),
+				AvailabilityZones:       []string{"eu-west-2a", "eu-west-2b"},
+				BaseEndpointDnsNames:    []string{"vpce-svc-0a1b2c3d4e5f6a7b8.eu-west-2.vpce.amazonaws.com"},
+				ManagesVpcEndpoints:     adapterhelpers.PtrBool(false),
+				NetworkLoadBalancerArns: []string{"arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/net/privatelink/0a1b2c3d4e5f6a7b"},
+				PrivateDnsName:          adapterhelpers.PtrString("api.example.com"),
+				ServiceId:               adapterhelpers.PtrString("vpce-svc-0a1b2c3d4e5f6a7b8"),
+				ServiceName:             adapterhelpers.PtrString("com.amazonaws.vpce.eu-west-2.vpce-svc-0a1b2c3d4e5f6a7b8"),
+				ServiceState:            types.ServiceStateAvailable,
+				ServiceType: []types.ServiceTypeDetail{
+					{
+						ServiceType: types.ServiceTypeInterface,
+					},
+				},
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("Name"),
+						Value: adapterhelpers.PtrString("api"),
+					},
+				},
+			},
+			Detail: &types.ServiceDetail{
+				Owner:                      adapterhelpers.PtrString("123456789012"),
+				ServiceId:                  adapterhelpers.PtrString("vpce-svc-0a1b2c3d4e5f6a7b8"),
+				ServiceName:                adapterhelpers.PtrString("com.amazonaws.vpce.eu-west-2.vpce-svc-0a1b2c3d4e5f6a7b8"),
+				VpcEndpointPolicySupported: adapterhelpers.PtrBool(false),
+			},
+		}
+
+		item, err := vpcEndpointServiceItemMapper("", "123456789012.eu-west-2", service)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		if item.GetHealth() != sdp.Health_HEALTH_OK {
+			t.Errorf("expected health to be OK, got %v", item.GetHealth())
+		}
+
+		if item.GetTags()["Name"] != "api" {
+			t.Errorf("expected Name tag to be api, got %v", item.GetTags()["Name"])
+		}
+
+		// Attributes from both views should be present
+		for _, attr := range []string{"NetworkLoadBalancerArns", "Owner"} {
+			if _, err := item.GetAttributes().Get(attr); err != nil {
+				t.Errorf("expected attribute %v to be set", attr)
+			}
+		}
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "elbv2-load-balancer",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/net/privatelink/0a1b2c3d4e5f6a7b",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "ec2-vpc-endpoint-connection",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "vpce-svc-0a1b2c3d4e5f6a7b8",
+				ExpectedScope:  "123456789012.eu-west-2",
+			},
+			{
+				ExpectedType:   "dns",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "api.example.com",
+				ExpectedScope:  "global",
+			},
+			{
+				ExpectedType:   "dns",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "vpce-svc-0a1b2c3d4e5f6a7b8.eu-west-2.vpce.amazonaws.com",
+				ExpectedScope:  "global",
+			},
+		}
+
+		tests.Execute(t, item)
+	})
+
+	t.Run("AWS service", func(t *testing.T) {
+		service := &vpcEndpointService{
+			Detail: &types.ServiceDetail{
+				AvailabilityZones:    []string{"eu-west-2a", "eu-west-2b", "eu-west-2c"},
+				BaseEndpointDnsNames: []string{"s3.eu-west-2.amazonaws.com"},
+				Owner:                adapterhelpers.PtrString("amazon"),
+				ServiceId:            adapterhelpers.PtrString("vpce-svc-0b1c2d3e4f5a6b7c8"),
+				ServiceName:          adapterhelpers.PtrString("com.amazonaws.eu-west-2.s3"),
+				ServiceType: []types.ServiceTypeDetail{
+					{
+						ServiceType: types.ServiceTypeGateway,
+					},
+				},
+			},
+		}
+
+		item, err := vpcEndpointServiceItemMapper("", "123456789012.eu-west-2", service)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		// We can't see the connections to services we don't own
+		for _, lir := range item.GetLinkedItemQueries() {
+			if lir.GetQuery().GetType() == "ec2-vpc-endpoint-connection" {
+				t.Error("expected no link to ec2-vpc-endpoint-connection")
+			}
+		}
+	})
+}
+
+func TestNewEC2VpcEndpointServiceAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2VpcEndpointServiceAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
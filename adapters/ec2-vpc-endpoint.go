@@ -103,6 +103,23 @@ func vpcEndpointOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *
 			})
 		}
 
+		if endpoint.ServiceName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc-endpoint-service",
+					Method: sdp.QueryMethod_GET,
+					Query:  *endpoint.ServiceName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the service will affect the endpoint
+					In: true,
+					// The endpoint doesn't affect the service
+					Out: false,
+				},
+			})
+		}
+
 		if endpointWithPolicy.PolicyDocument != nil {
 			item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(endpointWithPolicy.PolicyDocument)...)
 		}
@@ -248,3 +265,7 @@ var vpcEndpointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var vpcEndpointAdapterIAMActions = IAMActions.Register(vpcEndpointAdapterMetadata,
+	"ec2:DescribeVpcEndpoints",
+)
@@ -105,6 +105,12 @@ func TestVpcEndpointOutputMapper(t *testing.T) {
 			ExpectedQuery:  "vpc-0d7892e00e573e701",
 			ExpectedScope:  "foo",
 		},
+		{
+			ExpectedType:   "ec2-vpc-endpoint-service",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "com.amazonaws.us-east-1.s3",
+			ExpectedScope:  "foo",
+		},
 		{
 			ExpectedType:   "ec2-route-table",
 			ExpectedMethod: sdp.QueryMethod_GET,
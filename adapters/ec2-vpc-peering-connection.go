@@ -147,3 +147,7 @@ var vpcPeeringConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var vpcPeeringConnectionAdapterIAMActions = IAMActions.Register(vpcPeeringConnectionAdapterMetadata,
+	"ec2:DescribeVpcPeeringConnections",
+)
@@ -45,6 +45,42 @@ func vpcOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.Desc
 			Tags:            ec2TagsToMap(vpc.Tags),
 		}
 
+		// VPCs without a DHCP option set have an ID of "default"
+		if vpc.DhcpOptionsId != nil && *vpc.DhcpOptionsId != "default" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-dhcp-options",
+					Method: sdp.QueryMethod_GET,
+					Query:  *vpc.DhcpOptionsId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the DHCP options affects DNS and NTP for
+					// everything in the VPC
+					In: true,
+					// The VPC doesn't affect the options
+					Out: false,
+				},
+			})
+		}
+
+		if vpc.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-flow-log",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *vpc.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Flow logs don't affect the VPC
+					In: false,
+					// Deleting the VPC will stop the flow logs
+					Out: true,
+				},
+			})
+		}
+
 		items = append(items, &item)
 	}
 
@@ -82,5 +118,10 @@ var vpcAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_vpc.id"},
 	},
-	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	PotentialLinks: []string{"ec2-dhcp-options", "ec2-flow-log"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var vpcAdapterIAMActions = IAMActions.Register(vpcAdapterMetadata,
+	"ec2:DescribeVpcs",
+)
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
 )
 
 func TestVpcInputMapperGet(t *testing.T) {
@@ -95,6 +96,23 @@ func TestVpcOutputMapper(t *testing.T) {
 	if len(items) != 1 {
 		t.Fatalf("expected 1 item, got %v", len(items))
 	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-dhcp-options",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dopt-0959b838bf4a4c7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-flow-log",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, items[0])
 }
 
 func TestNewEC2VpcAdapter(t *testing.T) {
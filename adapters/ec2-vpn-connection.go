@@ -0,0 +1,269 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func vpnConnectionInputMapperGet(scope string, query string) (*ec2.DescribeVpnConnectionsInput, error) {
+	return &ec2.DescribeVpnConnectionsInput{
+		VpnConnectionIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func vpnConnectionInputMapperList(scope string) (*ec2.DescribeVpnConnectionsInput, error) {
+	return &ec2.DescribeVpnConnectionsInput{}, nil
+}
+
+// vpnConnectionInputMapperSearch Searches for VPN connections by the ID of the
+// customer gateway, VPN gateway or transit gateway that they use. ARNs are also
+// supported
+func vpnConnectionInputMapperSearch(_ context.Context, _ *ec2.Client, scope string, query string) (*ec2.DescribeVpnConnectionsInput, error) {
+	if arn, err := adapterhelpers.ParseARN(query); err == nil {
+		return &ec2.DescribeVpnConnectionsInput{
+			VpnConnectionIds: []string{
+				arn.ResourceID(),
+			},
+		}, nil
+	}
+
+	var filterName string
+	switch {
+	case strings.HasPrefix(query, "cgw-"):
+		filterName = "customer-gateway-id"
+	case strings.HasPrefix(query, "vgw-"):
+		filterName = "vpn-gateway-id"
+	case strings.HasPrefix(query, "tgw-"):
+		filterName = "transit-gateway-id"
+	default:
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be an ARN, or a customer gateway, VPN gateway or transit gateway ID",
+		}
+	}
+
+	return &ec2.DescribeVpnConnectionsInput{
+		Filters: []types.Filter{
+			{
+				Name:   &filterName,
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
+// vpnConnectionHealth Calculates the health of an available VPN connection
+// from the status of its tunnels. If all tunnels are down then no traffic can
+// flow, if only some are down then the connection has lost its redundancy
+func vpnConnectionHealth(telemetry []types.VgwTelemetry) *sdp.Health {
+	var up, down int
+
+	for _, tunnel := range telemetry {
+		switch tunnel.Status {
+		case types.TelemetryStatusUp:
+			up++
+		case types.TelemetryStatusDown:
+			down++
+		}
+	}
+
+	switch {
+	case down == 0:
+		return sdp.Health_HEALTH_OK.Enum()
+	case up == 0:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	default:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	}
+}
+
+func vpnConnectionOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeVpnConnectionsInput, output *ec2.DescribeVpnConnectionsOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("empty output")
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, connection := range output.VpnConnections {
+		// Remove the pre-shared keys before converting to attributes so that
+		// they aren't exposed. The customer gateway configuration also
+		// contains the keys so is excluded entirely
+		if connection.Options != nil {
+			options := *connection.Options
+			options.TunnelOptions = make([]types.TunnelOption, len(connection.Options.TunnelOptions))
+
+			for i, tunnel := range connection.Options.TunnelOptions {
+				tunnel.PreSharedKey = nil
+				options.TunnelOptions[i] = tunnel
+			}
+
+			connection.Options = &options
+		}
+
+		attrs, err := adapterhelpers.ToAttributesWithExclude(connection, "tags", "CustomerGatewayConfiguration")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-vpn-connection",
+			UniqueAttribute: "VpnConnectionId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(connection.Tags),
+		}
+
+		switch connection.State {
+		case types.VpnStateAvailable:
+			item.Health = vpnConnectionHealth(connection.VgwTelemetry)
+		case types.VpnStatePending, types.VpnStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+
+		if connection.CustomerGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-customer-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.CustomerGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the customer gateway will affect the connection
+					In: true,
+					// The connection doesn't affect the customer gateway
+					// itself
+					Out: false,
+				},
+			})
+		}
+
+		if connection.VpnGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpn-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.VpnGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing or detaching the VPN gateway will affect the
+					// connection
+					In: true,
+					// The connection carries traffic for the gateway's VPC
+					Out: true,
+				},
+			})
+		}
+
+		if connection.TransitGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.TransitGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the transit gateway will affect the connection
+					In: true,
+					// The connection carries traffic for the transit gateway
+					Out: true,
+				},
+			})
+		}
+
+		if connection.Options != nil && connection.Options.TransportTransitGatewayAttachmentId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-transit-gateway-attachment",
+					Method: sdp.QueryMethod_GET,
+					Query:  *connection.Options.TransportTransitGatewayAttachmentId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Private IP VPNs run over this attachment (usually a
+					// Direct Connect gateway) so will break if it does
+					In: true,
+					// The connection doesn't affect the transport attachment
+					Out: false,
+				},
+			})
+		}
+
+		for _, tunnel := range connection.VgwTelemetry {
+			if tunnel.OutsideIpAddress != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ip",
+						Method: sdp.QueryMethod_GET,
+						Query:  *tunnel.OutsideIpAddress,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// IPs always link
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2VpnConnectionAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeVpnConnectionsInput, *ec2.DescribeVpnConnectionsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeVpnConnectionsInput, *ec2.DescribeVpnConnectionsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-vpn-connection",
+		AdapterMetadata: vpnConnectionAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeVpnConnectionsInput) (*ec2.DescribeVpnConnectionsOutput, error) {
+			return client.DescribeVpnConnections(ctx, input)
+		},
+		InputMapperGet:    vpnConnectionInputMapperGet,
+		InputMapperList:   vpnConnectionInputMapperList,
+		InputMapperSearch: vpnConnectionInputMapperSearch,
+		OutputMapper:      vpnConnectionOutputMapper,
+	}
+}
+
+var vpnConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-vpn-connection",
+	DescriptiveName: "Site-to-Site VPN Connection",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Site-to-Site VPN connection by ID",
+		ListDescription:   "List all Site-to-Site VPN connections",
+		SearchDescription: "Search for Site-to-Site VPN connections by ARN, or by customer gateway, VPN gateway or transit gateway ID",
+	},
+	PotentialLinks: []string{"ec2-customer-gateway", "ec2-vpn-gateway", "ec2-transit-gateway", "ec2-transit-gateway-attachment", "ip"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_vpn_connection.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var vpnConnectionAdapterIAMActions = IAMActions.Register(vpnConnectionAdapterMetadata,
+	"ec2:DescribeVpnConnections",
+)
@@ -0,0 +1,222 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVpnConnectionInputMapperGet(t *testing.T) {
+	input, err := vpnConnectionInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.VpnConnectionIds) != 1 {
+		t.Fatalf("expected 1 VPN connection ID, got %v", len(input.VpnConnectionIds))
+	}
+
+	if input.VpnConnectionIds[0] != "bar" {
+		t.Errorf("expected VPN connection ID to be bar, got %v", input.VpnConnectionIds[0])
+	}
+}
+
+func TestVpnConnectionInputMapperSearch(t *testing.T) {
+	tests := map[string]string{
+		"cgw-0a1b2c3d4e5f6a7b8": "customer-gateway-id",
+		"vgw-0a1b2c3d4e5f6a7b8": "vpn-gateway-id",
+		"tgw-0a1b2c3d4e5f6a7b8": "transit-gateway-id",
+	}
+
+	for query, filter := range tests {
+		t.Run(query, func(t *testing.T) {
+			input, err := vpnConnectionInputMapperSearch(context.Background(), nil, "foo", query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(input.Filters) != 1 || *input.Filters[0].Name != filter {
+				t.Errorf("expected %v filter, got %v", filter, input.Filters)
+			}
+		})
+	}
+
+	t.Run("ARN", func(t *testing.T) {
+		input, err := vpnConnectionInputMapperSearch(context.Background(), nil, "foo", "arn:aws:ec2:eu-west-2:123456789012:vpn-connection/vpn-0f1e2d3c4b5a69788")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.VpnConnectionIds) != 1 || input.VpnConnectionIds[0] != "vpn-0f1e2d3c4b5a69788" {
+			t.Errorf("expected VPN connection ID vpn-0f1e2d3c4b5a69788, got %v", input.VpnConnectionIds)
+		}
+	})
+
+	t.Run("bad query", func(t *testing.T) {
+		_, err := vpnConnectionInputMapperSearch(context.Background(), nil, "foo", "igw-0a1b2c3d4e5f6a7b8")
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+func TestVpnConnectionOutputMapper(t *testing.T) {
+	output := &ec2.DescribeVpnConnectionsOutput{
+		VpnConnections: []types.VpnConnection{
+			{
+				Category:                     adapterhelpers.PtrString("VPN"),
+				CustomerGatewayConfiguration: adapterhelpers.PtrString("<vpn_connection>secret</vpn_connection>"),
+				CustomerGatewayId:            adapterhelpers.PtrString("cgw-0a1b2c3d4e5f6a7b8"),
+				Options: &types.VpnConnectionOptions{
+					StaticRoutesOnly: adapterhelpers.PtrBool(false),
+					TunnelOptions: []types.TunnelOption{
+						{
+							OutsideIpAddress: adapterhelpers.PtrString("203.0.113.10"),
+							PreSharedKey:     adapterhelpers.PtrString("supersecret"),
+						},
+					},
+				},
+				State:            types.VpnStateAvailable,
+				TransitGatewayId: adapterhelpers.PtrString("tgw-0e1d2c3b4a5f6e7d8"),
+				Type:             types.GatewayTypeIpsec1,
+				VgwTelemetry: []types.VgwTelemetry{
+					{
+						AcceptedRouteCount: adapterhelpers.PtrInt32(3),
+						LastStatusChange:   adapterhelpers.PtrTime(time.Now()),
+						OutsideIpAddress:   adapterhelpers.PtrString("203.0.113.10"),
+						Status:             types.TelemetryStatusUp,
+					},
+					{
+						AcceptedRouteCount: adapterhelpers.PtrInt32(0),
+						LastStatusChange:   adapterhelpers.PtrTime(time.Now()),
+						OutsideIpAddress:   adapterhelpers.PtrString("203.0.113.20"),
+						Status:             types.TelemetryStatusDown,
+						StatusMessage:      adapterhelpers.PtrString("IPSEC IS DOWN"),
+					},
+				},
+				VpnConnectionId: adapterhelpers.PtrString("vpn-0f1e2d3c4b5a69788"),
+			},
+		},
+	}
+
+	items, err := vpnConnectionOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	// One of the two tunnels is down
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	// Neither the customer gateway configuration nor the tunnel options
+	// should expose the pre-shared keys
+	if strings.Contains(item.GetAttributes().String(), "secret") {
+		t.Errorf("expected pre-shared keys to be removed, got %v", item.GetAttributes().String())
+	}
+
+	// The original output shouldn't have been modified
+	if *output.VpnConnections[0].Options.TunnelOptions[0].PreSharedKey != "supersecret" {
+		t.Error("expected the output to be unmodified")
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-customer-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "cgw-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-transit-gateway",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tgw-0e1d2c3b4a5f6e7d8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "203.0.113.10",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "203.0.113.20",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestVpnConnectionHealth(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Statuses []types.TelemetryStatus
+		Expected sdp.Health
+	}{
+		{
+			Name:     "all up",
+			Statuses: []types.TelemetryStatus{types.TelemetryStatusUp, types.TelemetryStatusUp},
+			Expected: sdp.Health_HEALTH_OK,
+		},
+		{
+			Name:     "one down",
+			Statuses: []types.TelemetryStatus{types.TelemetryStatusUp, types.TelemetryStatusDown},
+			Expected: sdp.Health_HEALTH_WARNING,
+		},
+		{
+			Name:     "all down",
+			Statuses: []types.TelemetryStatus{types.TelemetryStatusDown, types.TelemetryStatusDown},
+			Expected: sdp.Health_HEALTH_ERROR,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			telemetry := make([]types.VgwTelemetry, 0)
+			for _, status := range test.Statuses {
+				telemetry = append(telemetry, types.VgwTelemetry{Status: status})
+			}
+
+			if health := vpnConnectionHealth(telemetry); *health != test.Expected {
+				t.Errorf("expected %v, got %v", test.Expected, *health)
+			}
+		})
+	}
+}
+
+func TestNewEC2VpnConnectionAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2VpnConnectionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
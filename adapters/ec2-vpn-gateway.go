@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func vpnGatewayInputMapperGet(scope string, query string) (*ec2.DescribeVpnGatewaysInput, error) {
+	return &ec2.DescribeVpnGatewaysInput{
+		VpnGatewayIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func vpnGatewayInputMapperList(scope string) (*ec2.DescribeVpnGatewaysInput, error) {
+	return &ec2.DescribeVpnGatewaysInput{}, nil
+}
+
+func vpnGatewayOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeVpnGatewaysInput, output *ec2.DescribeVpnGatewaysOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("empty output")
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, gateway := range output.VpnGateways {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(gateway, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-vpn-gateway",
+			UniqueAttribute: "VpnGatewayId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(gateway.Tags),
+		}
+
+		switch gateway.State {
+		case types.VpnStateAvailable:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.VpnStatePending, types.VpnStateDeleting:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+
+		for _, attachment := range gateway.VpcAttachments {
+			// Detached VPCs are still returned for a while after the gateway
+			// has been detached
+			if attachment.VpcId == nil || attachment.State == types.AttachmentStatusDetached {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attachment.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the VPC will detach the gateway
+					In: true,
+					// Changing the gateway affects traffic in and out of the
+					// VPC
+					Out: true,
+				},
+			})
+		}
+
+		if gateway.VpnGatewayId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpn-connection",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *gateway.VpnGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The connections don't affect the gateway itself
+					In: false,
+					// Changing the gateway will affect all of its connections
+					Out: true,
+				},
+			})
+
+			// A virtual private gateway can be associated with a single Direct
+			// Connect gateway, which we can find by the gateway ID
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "directconnect-direct-connect-gateway-association",
+					Method: sdp.QueryMethod_GET,
+					Query:  *gateway.VpnGatewayId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the association changes which traffic reaches
+					// the gateway
+					In: true,
+					// Deleting the gateway will break the association
+					Out: true,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2VpnGatewayAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeVpnGatewaysInput, *ec2.DescribeVpnGatewaysOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeVpnGatewaysInput, *ec2.DescribeVpnGatewaysOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-vpn-gateway",
+		AdapterMetadata: vpnGatewayAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeVpnGatewaysInput) (*ec2.DescribeVpnGatewaysOutput, error) {
+			return client.DescribeVpnGateways(ctx, input)
+		},
+		InputMapperGet:  vpnGatewayInputMapperGet,
+		InputMapperList: vpnGatewayInputMapperList,
+		OutputMapper:    vpnGatewayOutputMapper,
+	}
+}
+
+var vpnGatewayAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-vpn-gateway",
+	DescriptiveName: "Virtual Private Gateway",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a virtual private gateway by ID",
+		ListDescription:   "List all virtual private gateways",
+		SearchDescription: "Search for virtual private gateways by ARN",
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-vpn-connection", "directconnect-direct-connect-gateway-association"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_vpn_gateway.id"},
+		{TerraformQueryMap: "aws_vpn_gateway_attachment.vpn_gateway_id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var vpnGatewayAdapterIAMActions = IAMActions.Register(vpnGatewayAdapterMetadata,
+	"ec2:DescribeVpnGateways",
+)
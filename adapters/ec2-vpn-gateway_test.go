@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVpnGatewayInputMapperGet(t *testing.T) {
+	input, err := vpnGatewayInputMapperGet("foo", "bar")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.VpnGatewayIds) != 1 {
+		t.Fatalf("expected 1 VPN gateway ID, got %v", len(input.VpnGatewayIds))
+	}
+
+	if input.VpnGatewayIds[0] != "bar" {
+		t.Errorf("expected VPN gateway ID to be bar, got %v", input.VpnGatewayIds[0])
+	}
+}
+
+func TestVpnGatewayOutputMapper(t *testing.T) {
+	output := &ec2.DescribeVpnGatewaysOutput{
+		VpnGateways: []types.VpnGateway{
+			{
+				AmazonSideAsn: adapterhelpers.PtrInt64(64512),
+				State:         types.VpnStateAvailable,
+				Type:          types.GatewayTypeIpsec1,
+				VpcAttachments: []types.VpcAttachment{
+					{
+						State: types.AttachmentStatusAttached,
+						VpcId: adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+					},
+					{
+						State: types.AttachmentStatusDetached,
+						VpcId: adapterhelpers.PtrString("vpc-01b2c3d4e5f6a7b8c"),
+					},
+				},
+				VpnGatewayId: adapterhelpers.PtrString("vgw-0a1b2c3d4e5f6a7b8"),
+			},
+		},
+	}
+
+	items, err := vpnGatewayOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	// The detached VPC shouldn't be linked
+	if len(item.GetLinkedItemQueries()) != 3 {
+		t.Errorf("expected 3 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-vpn-connection",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "vgw-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "directconnect-direct-connect-gateway-association",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vgw-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2VpnGatewayAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2VpnGatewayAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,185 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// parseECRImageQuery Parses a query in the same format as an image reference:
+// {repository-name}@{digest} or {repository-name}:{tag}
+func parseECRImageQuery(query string) (string, *types.ImageIdentifier, error) {
+	if repositoryName, digest, found := strings.Cut(query, "@"); found {
+		if repositoryName != "" && digest != "" {
+			return repositoryName, &types.ImageIdentifier{
+				ImageDigest: &digest,
+			}, nil
+		}
+	} else if i := strings.LastIndex(query, ":"); i > 0 && i > strings.LastIndex(query, "/") && i < len(query)-1 {
+		return query[:i], &types.ImageIdentifier{
+			ImageTag: adapterhelpers.PtrString(query[i+1:]),
+		}, nil
+	}
+
+	return "", nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: fmt.Sprintf("query must be in the format of: repository-name@digest or repository-name:tag, but found: %s", query),
+	}
+}
+
+func ecrImageGetFunc(ctx context.Context, client *ecr.Client, scope string, query string) (*types.ImageDetail, error) {
+	repositoryName, imageID, err := parseECRImageQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.DescribeImages(ctx, &ecr.DescribeImagesInput{
+		RepositoryName: &repositoryName,
+		ImageIds:       []types.ImageIdentifier{*imageID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.ImageDetails) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "image " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return &out.ImageDetails[0], nil
+}
+
+func ecrImageSearchFunc(ctx context.Context, client *ecr.Client, scope string, query string) ([]*types.ImageDetail, error) {
+	images := make([]*types.ImageDetail, 0)
+
+	paginator := ecr.NewDescribeImagesPaginator(client, &ecr.DescribeImagesInput{
+		RepositoryName: &query,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, image := range out.ImageDetails {
+			images = append(images, &image)
+		}
+	}
+
+	return images, nil
+}
+
+func ecrImageItemMapper(_, scope string, image *types.ImageDetail) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(image)
+	if err != nil {
+		return nil, err
+	}
+
+	if image.RepositoryName == nil || image.ImageDigest == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "image has no repository name or digest",
+			Scope:       scope,
+		}
+	}
+
+	// Digests are unique within a repository whereas tags can move, so we
+	// use the same format as a pinned image reference
+	err = attributes.Set("UniqueName", fmt.Sprintf("%s@%s", *image.RepositoryName, *image.ImageDigest))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ecr-image",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	var scanStatus types.ScanStatus
+	if image.ImageScanStatus != nil {
+		scanStatus = image.ImageScanStatus.Status
+	}
+
+	switch scanStatus {
+	case types.ScanStatusFailed, types.ScanStatusUnsupportedImage, types.ScanStatusScanEligibilityExpired, types.ScanStatusFindingsUnavailable:
+		// The scan couldn't run, which doesn't tell us anything about the
+		// image itself
+		item.Health = sdp.Health_HEALTH_UNKNOWN.Enum()
+	case types.ScanStatusInProgress, types.ScanStatusPending:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	default:
+		// Critical or high severity vulnerabilities are worth knowing about
+		// even though the image still works
+		if image.ImageScanFindingsSummary != nil {
+			counts := image.ImageScanFindingsSummary.FindingSeverityCounts
+			if counts[string(types.FindingSeverityCritical)] > 0 || counts[string(types.FindingSeverityHigh)] > 0 {
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+			} else {
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			}
+		} else if scanStatus == types.ScanStatusComplete || scanStatus == types.ScanStatusActive {
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ecr-repository",
+			Method: sdp.QueryMethod_GET,
+			Query:  *image.RepositoryName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the repository deletes the image, and its lifecycle
+			// policy can expire it
+			In: true,
+			// The image doesn't affect the repository
+			Out: false,
+		},
+	})
+
+	return &item, nil
+}
+
+func NewECRImageAdapter(client *ecr.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ImageDetail, *ecr.Client, *ecr.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ImageDetail, *ecr.Client, *ecr.Options]{
+		ItemType:        "ecr-image",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ecrImageAdapterMetadata,
+		GetFunc:         ecrImageGetFunc,
+		DisableList:     true,
+		SearchFunc:      ecrImageSearchFunc,
+		ItemMapper:      ecrImageItemMapper,
+	}
+}
+
+var ecrImageAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ecr-image",
+	DescriptiveName: "ECR Image",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an image by repository-name@digest or repository-name:tag",
+		SearchDescription: "Search for images by repository name",
+	},
+	PotentialLinks: []string{"ecr-repository"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var ecrImageAdapterIAMActions = IAMActions.Register(ecrImageAdapterMetadata,
+	"ecr:DescribeImages",
+)
@@ -0,0 +1,116 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestParseECRImageQuery(t *testing.T) {
+	repositoryName, imageID, err := parseECRImageQuery("team/orders@sha256:0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if repositoryName != "team/orders" || imageID.ImageDigest == nil || *imageID.ImageDigest != "sha256:0123456789abcdef" {
+		t.Errorf("unexpected result: %v %v", repositoryName, imageID)
+	}
+
+	repositoryName, imageID, err = parseECRImageQuery("team/orders:1.2.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if repositoryName != "team/orders" || imageID.ImageTag == nil || *imageID.ImageTag != "1.2.3" {
+		t.Errorf("unexpected result: %v %v", repositoryName, imageID)
+	}
+
+	for _, query := range []string{"team/orders", "orders:", "@sha256:0123456789abcdef"} {
+		if _, _, err := parseECRImageQuery(query); err == nil {
+			t.Errorf("expected error for query %v", query)
+		}
+	}
+}
+
+func TestECRImageItemMapper(t *testing.T) {
+	image := &types.ImageDetail{
+		ImageDigest:            adapterhelpers.PtrString("sha256:0123456789abcdef"),
+		ImageManifestMediaType: adapterhelpers.PtrString("application/vnd.docker.distribution.manifest.v2+json"),
+		ImagePushedAt:          adapterhelpers.PtrTime(time.Now()),
+		ImageScanFindingsSummary: &types.ImageScanFindingsSummary{
+			FindingSeverityCounts: map[string]int32{
+				"HIGH": 2,
+				"LOW":  5,
+			},
+		},
+		ImageScanStatus: &types.ImageScanStatus{
+			Status: types.ScanStatusComplete,
+		},
+		ImageSizeInBytes: adapterhelpers.PtrInt64(52428800),
+		ImageTags:        []string{"1.2.3", "latest"},
+		RegistryId:       adapterhelpers.PtrString("123456789012"),
+		RepositoryName:   adapterhelpers.PtrString("team/orders"),
+	}
+
+	item, err := ecrImageItemMapper("team/orders:1.2.3", "123456789012.eu-west-2", image)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "team/orders@sha256:0123456789abcdef" {
+		t.Errorf("expected unique attribute team/orders@sha256:0123456789abcdef, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "team/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// A scan that couldn't run doesn't say anything about the image, even if
+	// there are findings from an earlier scan
+	image.ImageScanStatus.Status = types.ScanStatusUnsupportedImage
+
+	item, err = ecrImageItemMapper("team/orders:1.2.3", "123456789012.eu-west-2", image)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_UNKNOWN {
+		t.Errorf("expected health to be UNKNOWN, got %v", item.GetHealth())
+	}
+}
+
+func TestNewECRImageAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := ecr.NewFromConfig(config)
+
+	adapter := NewECRImageAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
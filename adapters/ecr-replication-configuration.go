@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// There is one replication configuration per registry in each region, and the
+// registry ID is the account ID, so it is used as the query
+func ecrReplicationConfigurationGetFunc(ctx context.Context, client *ecr.Client, scope string, query string) (*ecr.DescribeRegistryOutput, error) {
+	out, err := client.DescribeRegistry(ctx, &ecr.DescribeRegistryInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.RegistryId == nil || *out.RegistryId != query {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "replication configuration for registry " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return out, nil
+}
+
+func ecrReplicationConfigurationListFunc(ctx context.Context, client *ecr.Client, scope string) ([]*ecr.DescribeRegistryOutput, error) {
+	out, err := client.DescribeRegistry(ctx, &ecr.DescribeRegistryInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Registries without any replication rules aren't worth returning
+	if out.RegistryId == nil || out.ReplicationConfiguration == nil || len(out.ReplicationConfiguration.Rules) == 0 {
+		return []*ecr.DescribeRegistryOutput{}, nil
+	}
+
+	return []*ecr.DescribeRegistryOutput{out}, nil
+}
+
+func ecrReplicationConfigurationItemMapper(_, scope string, registry *ecr.DescribeRegistryOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(registry, "ResultMetadata")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ecr-replication-configuration",
+		UniqueAttribute: "RegistryId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if registry.ReplicationConfiguration == nil {
+		return &item, nil
+	}
+
+	seen := make(map[string]bool)
+
+	for _, rule := range registry.ReplicationConfiguration.Rules {
+		for _, destination := range rule.Destinations {
+			if destination.RegistryId == nil || destination.Region == nil {
+				continue
+			}
+
+			destinationScope := adapterhelpers.FormatScope(*destination.RegistryId, *destination.Region)
+			if seen[destinationScope] {
+				continue
+			}
+			seen[destinationScope] = true
+
+			// Images are replicated to repositories in the destination
+			// registry, which has its own replication configuration
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ecr-replication-configuration",
+					Method: sdp.QueryMethod_GET,
+					Query:  *destination.RegistryId,
+					Scope:  destinationScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The destination doesn't affect what is replicated
+					In: false,
+					// Changing the rules changes what is replicated to the
+					// destination
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewECRReplicationConfigurationAdapter(client *ecr.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*ecr.DescribeRegistryOutput, *ecr.Client, *ecr.Options] {
+	return &adapterhelpers.GetListAdapter[*ecr.DescribeRegistryOutput, *ecr.Client, *ecr.Options]{
+		ItemType:        "ecr-replication-configuration",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ecrReplicationConfigurationAdapterMetadata,
+		GetFunc:         ecrReplicationConfigurationGetFunc,
+		ListFunc:        ecrReplicationConfigurationListFunc,
+		ItemMapper:      ecrReplicationConfigurationItemMapper,
+	}
+}
+
+var ecrReplicationConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ecr-replication-configuration",
+	DescriptiveName: "ECR Replication Configuration",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:             true,
+		List:            true,
+		GetDescription:  "Get the replication configuration of a registry by registry ID (account ID)",
+		ListDescription: "List the replication configuration of the registry",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ecr_replication_configuration.registry_id"},
+	},
+	PotentialLinks: []string{"ecr-replication-configuration"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var ecrReplicationConfigurationAdapterIAMActions = IAMActions.Register(ecrReplicationConfigurationAdapterMetadata,
+	"ecr:DescribeRegistry",
+)
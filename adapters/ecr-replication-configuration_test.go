@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestECRReplicationConfigurationItemMapper(t *testing.T) {
+	registry := &ecr.DescribeRegistryOutput{
+		RegistryId: adapterhelpers.PtrString("123456789012"),
+		ReplicationConfiguration: &types.ReplicationConfiguration{
+			Rules: []types.ReplicationRule{
+				{
+					Destinations: []types.ReplicationDestination{
+						{
+							Region:     adapterhelpers.PtrString("eu-west-1"),
+							RegistryId: adapterhelpers.PtrString("123456789012"),
+						},
+						{
+							Region:     adapterhelpers.PtrString("us-east-1"),
+							RegistryId: adapterhelpers.PtrString("210987654321"),
+						},
+					},
+					RepositoryFilters: []types.RepositoryFilter{
+						{
+							Filter:     adapterhelpers.PtrString("team/"),
+							FilterType: types.RepositoryFilterTypePrefixMatch,
+						},
+					},
+				},
+				{
+					Destinations: []types.ReplicationDestination{
+						{
+							Region:     adapterhelpers.PtrString("eu-west-1"),
+							RegistryId: adapterhelpers.PtrString("123456789012"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	item, err := ecrReplicationConfigurationItemMapper("", "123456789012.eu-west-2", registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if len(item.GetLinkedItemQueries()) != 2 {
+		t.Errorf("expected 2 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-replication-configuration",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "123456789012",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ecr-replication-configuration",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "210987654321",
+			ExpectedScope:  "210987654321.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewECRReplicationConfigurationAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := ecr.NewFromConfig(config)
+
+	adapter := NewECRReplicationConfigurationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,214 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// ecrLifecyclePolicy The parsed lifecycle policy of a repository, which
+// controls when images are expired
+//
+// https://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html#lifecycle_policy_parameters
+type ecrLifecyclePolicy struct {
+	Rules []ecrLifecyclePolicyRule `json:"rules"`
+}
+
+type ecrLifecyclePolicyRule struct {
+	RulePriority int    `json:"rulePriority"`
+	Description  string `json:"description,omitempty"`
+	Selection    struct {
+		TagStatus      string   `json:"tagStatus"`
+		TagPrefixList  []string `json:"tagPrefixList,omitempty"`
+		TagPatternList []string `json:"tagPatternList,omitempty"`
+		CountType      string   `json:"countType"`
+		CountUnit      string   `json:"countUnit,omitempty"`
+		CountNumber    int      `json:"countNumber"`
+	} `json:"selection"`
+	Action struct {
+		Type string `json:"type"`
+	} `json:"action"`
+}
+
+type ecrRepository struct {
+	*types.Repository
+	RepositoryPolicy *policy.Policy
+	LifecyclePolicy  *ecrLifecyclePolicy
+}
+
+// ecrRepositoryEnrich Adds the repository and lifecycle policies. Repositories
+// don't need either of these, and we still want to return the repository if we
+// can't get them
+func ecrRepositoryEnrich(ctx context.Context, client *ecr.Client, repository *types.Repository) *ecrRepository {
+	repo := ecrRepository{
+		Repository: repository,
+	}
+
+	repositoryPolicy, err := client.GetRepositoryPolicy(ctx, &ecr.GetRepositoryPolicyInput{
+		RegistryId:     repository.RegistryId,
+		RepositoryName: repository.RepositoryName,
+	})
+	if err == nil && repositoryPolicy.PolicyText != nil && *repositoryPolicy.PolicyText != "" {
+		repo.RepositoryPolicy, _ = ParsePolicyDocument(*repositoryPolicy.PolicyText)
+	}
+
+	lifecyclePolicy, err := client.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{
+		RegistryId:     repository.RegistryId,
+		RepositoryName: repository.RepositoryName,
+	})
+	if err == nil && lifecyclePolicy.LifecyclePolicyText != nil {
+		var parsed ecrLifecyclePolicy
+		if json.Unmarshal([]byte(*lifecyclePolicy.LifecyclePolicyText), &parsed) == nil {
+			repo.LifecyclePolicy = &parsed
+		}
+	}
+
+	return &repo
+}
+
+func ecrRepositoryGetFunc(ctx context.Context, client *ecr.Client, scope string, query string) (*ecrRepository, error) {
+	out, err := client.DescribeRepositories(ctx, &ecr.DescribeRepositoriesInput{
+		RepositoryNames: []string{query},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Repositories) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "repository " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return ecrRepositoryEnrich(ctx, client, &out.Repositories[0]), nil
+}
+
+func ecrRepositoryListFunc(ctx context.Context, client *ecr.Client, scope string) ([]*ecrRepository, error) {
+	repositories := make([]*ecrRepository, 0)
+
+	paginator := ecr.NewDescribeRepositoriesPaginator(client, &ecr.DescribeRepositoriesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repository := range out.Repositories {
+			repositories = append(repositories, ecrRepositoryEnrich(ctx, client, &repository))
+		}
+	}
+
+	return repositories, nil
+}
+
+func ecrRepositoryListTagsFunc(ctx context.Context, repository *ecrRepository, client *ecr.Client) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &ecr.ListTagsForResourceInput{
+		ResourceArn: repository.RepositoryArn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func ecrRepositoryItemMapper(_, scope string, repository *ecrRepository) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ecr-repository",
+		UniqueAttribute: "RepositoryName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if repository.RepositoryName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ecr-image",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *repository.RepositoryName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Images don't affect the repository
+				In: false,
+				// Deleting the repository deletes its images, and the
+				// lifecycle policy expires them
+				Out: true,
+			},
+		})
+	}
+
+	if repository.EncryptionConfiguration != nil && repository.EncryptionConfiguration.KmsKey != nil {
+		if link := kmsKeyLink(*repository.EncryptionConfiguration.KmsKey, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// The repository policy controls which principals can push and pull
+	item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(repository.RepositoryPolicy)...)
+
+	return &item, nil
+}
+
+func NewECRRepositoryAdapter(client *ecr.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*ecrRepository, *ecr.Client, *ecr.Options] {
+	return &adapterhelpers.GetListAdapter[*ecrRepository, *ecr.Client, *ecr.Options]{
+		ItemType:        "ecr-repository",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ecrRepositoryAdapterMetadata,
+		GetFunc:         ecrRepositoryGetFunc,
+		ListFunc:        ecrRepositoryListFunc,
+		ListTagsFunc:    ecrRepositoryListTagsFunc,
+		ItemMapper:      ecrRepositoryItemMapper,
+	}
+}
+
+var ecrRepositoryAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ecr-repository",
+	DescriptiveName: "ECR Repository",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a repository by name",
+		ListDescription:   "List all repositories",
+		SearchDescription: "Search for a repository by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ecr_repository.name"},
+		{TerraformQueryMap: "aws_ecr_repository_policy.repository"},
+		{TerraformQueryMap: "aws_ecr_lifecycle_policy.repository"},
+	},
+	PotentialLinks: []string{"ecr-image", "kms-key", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var ecrRepositoryAdapterIAMActions = IAMActions.Register(ecrRepositoryAdapterMetadata,
+	"ecr:DescribeRepositories",
+	"ecr:GetLifecyclePolicy",
+	"ecr:GetRepositoryPolicy",
+	"ecr:ListTagsForResource",
+)
@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestECRRepositoryItemMapper(t *testing.T) {
+	repositoryPolicy, err := ParsePolicyDocument(`{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Effect": "Allow",
+				"Principal": {
+					"AWS": "arn:aws:iam::123456789012:role/ci"
+				},
+				"Action": ["ecr:PutImage"]
+			}
+		]
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repository := &ecrRepository{
+		Repository: &types.Repository{
+			CreatedAt: adapterhelpers.PtrTime(time.Now()),
+			EncryptionConfiguration: &types.EncryptionConfiguration{
+				EncryptionType: types.EncryptionTypeKms,
+				KmsKey:         adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			},
+			ImageScanningConfiguration: &types.ImageScanningConfiguration{
+				ScanOnPush: true,
+			},
+			ImageTagMutability: types.ImageTagMutabilityImmutable,
+			RegistryId:         adapterhelpers.PtrString("123456789012"),
+			RepositoryArn:      adapterhelpers.PtrString("arn:aws:ecr:eu-west-2:123456789012:repository/team/orders"),
+			RepositoryName:     adapterhelpers.PtrString("team/orders"),
+			RepositoryUri:      adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-2.amazonaws.com/team/orders"),
+		},
+		RepositoryPolicy: repositoryPolicy,
+		LifecyclePolicy: &ecrLifecyclePolicy{
+			Rules: []ecrLifecyclePolicyRule{
+				{
+					RulePriority: 1,
+					Description:  "Expire untagged images",
+				},
+			},
+		},
+	}
+
+	item, err := ecrRepositoryItemMapper("", "123456789012.eu-west-2", repository)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-image",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "team/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/ci",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewECRRepositoryAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+
+	client := ecr.NewFromConfig(config)
+
+	adapter := NewECRRepositoryAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"strings"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// ecrImageReference An image in a private ECR repository, parsed from an image
+// URI
+type ecrImageReference struct {
+	RegistryID     string
+	Region         string
+	RepositoryName string
+	Tag            string
+	Digest         string
+}
+
+// ImageQuery The query that the ecr-image adapter uses to get the image. This
+// is {repository-name}@{digest} if the digest is known, otherwise
+// {repository-name}:{tag}
+func (r *ecrImageReference) ImageQuery() string {
+	if r.Digest != "" {
+		return r.RepositoryName + "@" + r.Digest
+	}
+
+	if r.Tag != "" {
+		return r.RepositoryName + ":" + r.Tag
+	}
+
+	// Docker uses the latest tag when none is specified
+	return r.RepositoryName + ":latest"
+}
+
+// parseECRImageURI Parses an image URI in the format:
+//
+// {registry-id}.dkr.ecr.{region}.amazonaws.com/{repository-name}[:{tag}][@{digest}]
+//
+// Repository names can contain slashes. Returns nil if the URI isn't for a
+// private ECR repository, e.g. Docker Hub or ECR Public images
+func parseECRImageURI(uri string) *ecrImageReference {
+	host, path, found := strings.Cut(uri, "/")
+	if !found || path == "" {
+		return nil
+	}
+
+	// The host is {registry-id}.dkr.{ecr|ecr-fips}.{region}.amazonaws.com
+	// with an optional .cn suffix
+	hostSections := strings.Split(host, ".")
+	if len(hostSections) < 6 || hostSections[1] != "dkr" || !strings.HasPrefix(hostSections[2], "ecr") || hostSections[4] != "amazonaws" {
+		return nil
+	}
+
+	ref := ecrImageReference{
+		RegistryID: hostSections[0],
+		Region:     hostSections[3],
+	}
+
+	path, ref.Digest, _ = strings.Cut(path, "@")
+
+	// Tags come after the last colon, but only if it's in the last section of
+	// the path since the repository name can't contain colons
+	if i := strings.LastIndex(path, ":"); i > strings.LastIndex(path, "/") {
+		ref.Tag = path[i+1:]
+		path = path[:i]
+	}
+
+	ref.RepositoryName = path
+
+	return &ref
+}
+
+// ecrImageLinks Links to the repository and image that an image URI refers to.
+// Returns nil if the URI isn't for a private ECR repository
+func ecrImageLinks(uri string) []*sdp.LinkedItemQuery {
+	ref := parseECRImageURI(uri)
+	if ref == nil {
+		return nil
+	}
+
+	scope := adapterhelpers.FormatScope(ref.RegistryID, ref.Region)
+
+	return []*sdp.LinkedItemQuery{
+		{
+			Query: &sdp.Query{
+				Type:   "ecr-repository",
+				Method: sdp.QueryMethod_GET,
+				Query:  ref.RepositoryName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the repository is deleted or its policy changes, the
+				// image can't be pulled
+				In: true,
+				// Pulling an image doesn't affect the repository
+				Out: false,
+			},
+		},
+		{
+			Query: &sdp.Query{
+				Type:   "ecr-image",
+				Method: sdp.QueryMethod_GET,
+				Query:  ref.ImageQuery(),
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Pushing a new image to a mutable tag changes what is run
+				In: true,
+				// Running the image doesn't affect it
+				Out: false,
+			},
+		},
+	}
+}
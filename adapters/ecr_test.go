@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"testing"
+)
+
+func TestParseECRImageURI(t *testing.T) {
+	tests := []struct {
+		URI        string
+		Repository string
+		Tag        string
+		Digest     string
+		Query      string
+	}{
+		{
+			URI:        "123456789012.dkr.ecr.eu-west-2.amazonaws.com/orders:1.2.3",
+			Repository: "orders",
+			Tag:        "1.2.3",
+			Query:      "orders:1.2.3",
+		},
+		{
+			URI:        "123456789012.dkr.ecr.eu-west-2.amazonaws.com/team/orders",
+			Repository: "team/orders",
+			Query:      "team/orders:latest",
+		},
+		{
+			URI:        "123456789012.dkr.ecr.eu-west-2.amazonaws.com/team/orders:v1@sha256:0123456789abcdef",
+			Repository: "team/orders",
+			Tag:        "v1",
+			Digest:     "sha256:0123456789abcdef",
+			Query:      "team/orders@sha256:0123456789abcdef",
+		},
+		{
+			URI:        "123456789012.dkr.ecr-fips.us-east-1.amazonaws.com/orders@sha256:0123456789abcdef",
+			Repository: "orders",
+			Digest:     "sha256:0123456789abcdef",
+			Query:      "orders@sha256:0123456789abcdef",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.URI, func(t *testing.T) {
+			ref := parseECRImageURI(test.URI)
+			if ref == nil {
+				t.Fatal("expected a reference, got nil")
+			}
+
+			if ref.RegistryID != "123456789012" {
+				t.Errorf("expected registry ID 123456789012, got %v", ref.RegistryID)
+			}
+
+			if ref.RepositoryName != test.Repository {
+				t.Errorf("expected repository %v, got %v", test.Repository, ref.RepositoryName)
+			}
+
+			if ref.Tag != test.Tag {
+				t.Errorf("expected tag %v, got %v", test.Tag, ref.Tag)
+			}
+
+			if ref.Digest != test.Digest {
+				t.Errorf("expected digest %v, got %v", test.Digest, ref.Digest)
+			}
+
+			if ref.ImageQuery() != test.Query {
+				t.Errorf("expected query %v, got %v", test.Query, ref.ImageQuery())
+			}
+		})
+	}
+
+	for _, uri := range []string{"httpd:2.4", "public.ecr.aws/nginx/nginx:latest", "ghcr.io/org/app:v1", "https://foo"} {
+		if ref := parseECRImageURI(uri); ref != nil {
+			t.Errorf("expected nil for %v, got %v", uri, ref)
+		}
+	}
+}
@@ -107,6 +107,10 @@ var capacityProviderAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
 
+var capacityProviderAdapterIAMActions = IAMActions.Register(capacityProviderAdapterMetadata,
+	"ecs:DescribeCapacityProviders",
+)
+
 // Incredibly annoyingly the go package adapters't provide a paginator builder for
 // DescribeCapacityProviders despite the fact that it's paginated, so I'm going
 // to create one myself below
@@ -270,3 +270,8 @@ var ecsClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ecs-container-instance", "ecs-service", "ecs-task", "ecs-capacity-provider"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var ecsClusterAdapterIAMActions = IAMActions.Register(ecsClusterAdapterMetadata,
+	"ecs:DescribeClusters",
+	"ecs:ListClusters",
+)
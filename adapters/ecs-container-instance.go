@@ -169,3 +169,8 @@ var containerInstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var containerInstanceAdapterIAMActions = IAMActions.Register(containerInstanceAdapterMetadata,
+	"ecs:DescribeContainerInstances",
+	"ecs:ListContainerInstances",
+)
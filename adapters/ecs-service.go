@@ -428,3 +428,8 @@ var ecsServiceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ecs-cluster", "elbv2-target-group", "servicediscovery-service", "ecs-task-definition", "ecs-capacity-provider", "ec2-subnet", "ecs-security-group", "dns"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var ecsServiceAdapterIAMActions = IAMActions.Register(ecsServiceAdapterMetadata,
+	"ecs:DescribeServices",
+	"ecs:ListServices",
+)
@@ -62,6 +62,10 @@ func taskDefinitionGetFunc(ctx context.Context, client ECSClient, scope string,
 	var link *sdp.LinkedItemQuery
 
 	for _, cd := range td.ContainerDefinitions {
+		if cd.Image != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, ecrImageLinks(*cd.Image)...)
+		}
+
 		for _, secret := range cd.Secrets {
 			link = getSecretLinkedItem(secret)
 
@@ -223,6 +227,11 @@ var taskDefinitionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_ecs_task_definition.family"},
 	},
-	PotentialLinks: []string{"iam-role", "secretsmanager-secret", "ssm-parameter"},
+	PotentialLinks: []string{"iam-role", "secretsmanager-secret", "ssm-parameter", "ecr-repository", "ecr-image"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var taskDefinitionAdapterIAMActions = IAMActions.Register(taskDefinitionAdapterMetadata,
+	"ecs:DescribeTaskDefinition",
+	"ecs:ListTaskDefinitions",
+)
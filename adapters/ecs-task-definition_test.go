@@ -142,6 +142,13 @@ func (t *ecsTestClient) DescribeTaskDefinition(ctx context.Context, params *ecs.
 						},
 					},
 				},
+				{
+					Name:      adapterhelpers.PtrString("tools"),
+					Image:     adapterhelpers.PtrString("123456789012.dkr.ecr.us-east-2.amazonaws.com/tools/busybox:1.36"),
+					Cpu:       10,
+					Memory:    adapterhelpers.PtrInt32(200),
+					Essential: adapterhelpers.PtrBool(false),
+				},
 			},
 			Family:   adapterhelpers.PtrString("ecs-template-ecs-demo-app"),
 			Revision: 1,
@@ -249,6 +256,18 @@ func TestTaskDefinitionGetFunc(t *testing.T) {
 			ExpectedQuery:  "database01.my-company.com",
 			ExpectedScope:  "global",
 		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tools/busybox",
+			ExpectedScope:  "123456789012.us-east-2",
+		},
+		{
+			ExpectedType:   "ecr-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tools/busybox:1.36",
+			ExpectedScope:  "123456789012.us-east-2",
+		},
 	}
 
 	tests.Execute(t, item)
@@ -266,3 +266,8 @@ var ecsTaskAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ecs-cluster", "ecs-container-instance", "ecs-task-definition", "ec2-network-interface", "ip"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var ecsTaskAdapterIAMActions = IAMActions.Register(ecsTaskAdapterMetadata,
+	"ecs:DescribeTasks",
+	"ecs:ListTasks",
+)
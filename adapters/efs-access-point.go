@@ -96,3 +96,7 @@ var accessPointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var accessPointAdapterIAMActions = IAMActions.Register(accessPointAdapterMetadata,
+	"elasticfilesystem:DescribeAccessPoints",
+)
@@ -83,3 +83,7 @@ var backupPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var backupPolicyAdapterIAMActions = IAMActions.Register(backupPolicyAdapterMetadata,
+	"elasticfilesystem:DescribeBackupPolicy",
+)
@@ -132,3 +132,7 @@ var efsFileSystemAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var efsFileSystemAdapterIAMActions = IAMActions.Register(efsFileSystemAdapterMetadata,
+	"elasticfilesystem:DescribeFileSystems",
+)
@@ -161,3 +161,7 @@ var efsMountTargetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var efsMountTargetAdapterIAMActions = IAMActions.Register(efsMountTargetAdapterMetadata,
+	"elasticfilesystem:DescribeMountTargets",
+)
@@ -162,3 +162,7 @@ var replicationConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
+
+var replicationConfigurationAdapterIAMActions = IAMActions.Register(replicationConfigurationAdapterMetadata,
+	"elasticfilesystem:DescribeReplicationConfigurations",
+)
@@ -113,3 +113,8 @@ var eksAddonAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var eksAddonAdapterIAMActions = IAMActions.Register(eksAddonAdapterMetadata,
+	"eks:DescribeAddon",
+	"eks:ListAddons",
+)
@@ -301,3 +301,8 @@ var eksClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var eksClusterAdapterIAMActions = IAMActions.Register(eksClusterAdapterMetadata,
+	"eks:DescribeCluster",
+	"eks:ListClusters",
+)
@@ -152,3 +152,8 @@ var fargateProfileAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"iam-role", "ec2-subnet"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var fargateProfileAdapterIAMActions = IAMActions.Register(fargateProfileAdapterMetadata,
+	"eks:DescribeFargateProfile",
+	"eks:ListFargateProfiles",
+)
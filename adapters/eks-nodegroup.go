@@ -239,3 +239,8 @@ var nodegroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-key-pair", "ec2-security-group", "ec2-subnet", "autoscaling-auto-scaling-group", "ec2-launch-template"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var nodegroupAdapterIAMActions = IAMActions.Register(nodegroupAdapterMetadata,
+	"eks:DescribeNodegroup",
+	"eks:ListNodegroups",
+)
@@ -0,0 +1,186 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func cacheClusterOutputMapper(ctx context.Context, client elasticacheClient, scope string, _ *elasticache.DescribeCacheClustersInput, output *elasticache.DescribeCacheClustersOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, cluster := range output.CacheClusters {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "elasticache-cache-cluster",
+			UniqueAttribute: "CacheClusterId",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            elasticacheGetTags(ctx, client, cluster.ARN),
+		}
+
+		if cluster.CacheClusterStatus != nil {
+			switch *cluster.CacheClusterStatus {
+			case "available":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "creating", "modifying", "rebooting cache cluster nodes", "snapshotting", "deleting":
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			case "incompatible-network", "restore-failed":
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			}
+		}
+
+		for _, node := range cluster.CacheNodes {
+			if link := elasticacheEndpointLink(node.Endpoint); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		// Memcached clusters have a configuration endpoint for auto discovery
+		if link := elasticacheEndpointLink(cluster.ConfigurationEndpoint); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+
+		if cluster.CacheSubnetGroupName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-subnet-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.CacheSubnetGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The subnet group controls where the nodes are placed
+					In: true,
+					// The cluster won't affect the subnet group
+					Out: false,
+				},
+			})
+		}
+
+		if cluster.CacheParameterGroup != nil && cluster.CacheParameterGroup.CacheParameterGroupName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-parameter-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.CacheParameterGroup.CacheParameterGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the parameters changes how the engine behaves
+					In: true,
+					// The cluster won't affect the parameter group
+					Out: false,
+				},
+			})
+		}
+
+		if cluster.ReplicationGroupId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-replication-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.ReplicationGroupId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The replication group manages its member clusters
+					In: true,
+					// If a member cluster fails, the replication group is
+					// affected
+					Out: true,
+				},
+			})
+		}
+
+		for _, sg := range cluster.SecurityGroups {
+			if sg.SecurityGroupId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, elasticacheSecurityGroupLink(*sg.SecurityGroupId, scope))
+			}
+		}
+
+		if cluster.NotificationConfiguration != nil && cluster.NotificationConfiguration.TopicArn != nil {
+			if a, err := adapterhelpers.ParseARN(*cluster.NotificationConfiguration.TopicArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "sns-topic",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *cluster.NotificationConfiguration.TopicArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The topic won't affect the cluster
+						In: false,
+						// The cluster sends events to the topic
+						Out: true,
+					},
+				})
+			}
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, elasticacheLogDeliveryLinks(cluster.LogDeliveryConfigurations, scope)...)
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewElastiCacheCacheClusterAdapter(client elasticacheClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeCacheClustersInput, *elasticache.DescribeCacheClustersOutput, elasticacheClient, *elasticache.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeCacheClustersInput, *elasticache.DescribeCacheClustersOutput, elasticacheClient, *elasticache.Options]{
+		ItemType:        "elasticache-cache-cluster",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: elasticacheCacheClusterAdapterMetadata,
+		PaginatorBuilder: func(client elasticacheClient, params *elasticache.DescribeCacheClustersInput) adapterhelpers.Paginator[*elasticache.DescribeCacheClustersOutput, *elasticache.Options] {
+			return elasticache.NewDescribeCacheClustersPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client elasticacheClient, input *elasticache.DescribeCacheClustersInput) (*elasticache.DescribeCacheClustersOutput, error) {
+			return client.DescribeCacheClusters(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*elasticache.DescribeCacheClustersInput, error) {
+			return &elasticache.DescribeCacheClustersInput{
+				CacheClusterId: &query,
+				// Node endpoints are only returned when this is set
+				ShowCacheNodeInfo: adapterhelpers.PtrBool(true),
+			}, nil
+		},
+		InputMapperList: func(scope string) (*elasticache.DescribeCacheClustersInput, error) {
+			return &elasticache.DescribeCacheClustersInput{
+				ShowCacheNodeInfo: adapterhelpers.PtrBool(true),
+			}, nil
+		},
+		OutputMapper: cacheClusterOutputMapper,
+	}
+}
+
+var elasticacheCacheClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticache-cache-cluster",
+	DescriptiveName: "ElastiCache Cache Cluster",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a cache cluster by ID",
+		ListDescription:   "List all cache clusters",
+		SearchDescription: "Search for cache clusters by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_elasticache_cluster.cluster_id"},
+	},
+	PotentialLinks: []string{"dns", "elasticache-subnet-group", "elasticache-parameter-group", "elasticache-replication-group", "ec2-security-group", "sns-topic", "logs-log-group", "firehose-delivery-stream"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var elasticacheCacheClusterAdapterIAMActions = IAMActions.Register(elasticacheCacheClusterAdapterMetadata,
+	"elasticache:DescribeCacheClusters",
+	"elasticache:ListTagsForResource",
+)
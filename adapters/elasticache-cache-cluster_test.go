@@ -0,0 +1,166 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCacheClusterOutputMapper(t *testing.T) {
+	output := elasticache.DescribeCacheClustersOutput{
+		CacheClusters: []types.CacheCluster{
+			{
+				ARN:                adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:cluster:redis-001"),
+				CacheClusterId:     adapterhelpers.PtrString("redis-001"),
+				CacheClusterStatus: adapterhelpers.PtrString("available"),
+				CacheNodeType:      adapterhelpers.PtrString("cache.t4g.micro"),
+				Engine:             adapterhelpers.PtrString("redis"),
+				EngineVersion:      adapterhelpers.PtrString("7.1.0"),
+				NumCacheNodes:      adapterhelpers.PtrInt32(1),
+				CacheNodes: []types.CacheNode{
+					{
+						CacheNodeId:     adapterhelpers.PtrString("0001"),
+						CacheNodeStatus: adapterhelpers.PtrString("available"),
+						Endpoint: &types.Endpoint{
+							Address: adapterhelpers.PtrString("redis-001.abcdef.0001.euw2.cache.amazonaws.com"), // link
+							Port:    adapterhelpers.PtrInt32(6379),
+						},
+					},
+				},
+				CacheParameterGroup: &types.CacheParameterGroupStatus{
+					CacheParameterGroupName: adapterhelpers.PtrString("default.redis7"), // link
+					ParameterApplyStatus:    adapterhelpers.PtrString("in-sync"),
+				},
+				CacheSubnetGroupName: adapterhelpers.PtrString("redis-subnets"), // link
+				ReplicationGroupId:   adapterhelpers.PtrString("redis"),         // link
+				SecurityGroups: []types.SecurityGroupMembership{
+					{
+						SecurityGroupId: adapterhelpers.PtrString("sg-0b8d4b5b5e1b5e1b5"), // link
+						Status:          adapterhelpers.PtrString("active"),
+					},
+				},
+				NotificationConfiguration: &types.NotificationConfiguration{
+					TopicArn:    adapterhelpers.PtrString("arn:aws:sns:eu-west-2:052392120703:cache-events"), // link
+					TopicStatus: adapterhelpers.PtrString("active"),
+				},
+				LogDeliveryConfigurations: []types.LogDeliveryConfiguration{
+					{
+						DestinationType: types.DestinationTypeCloudWatchLogs,
+						DestinationDetails: &types.DestinationDetails{
+							CloudWatchLogsDetails: &types.CloudWatchLogsDestinationDetails{
+								LogGroup: adapterhelpers.PtrString("/elasticache/redis/slow-log"), // link
+							},
+						},
+						LogType: types.LogTypeSlowLog,
+						Status:  types.LogDeliveryConfigurationStatusActive,
+					},
+					{
+						DestinationType: types.DestinationTypeKinesisFirehose,
+						DestinationDetails: &types.DestinationDetails{
+							KinesisFirehoseDetails: &types.KinesisFirehoseDestinationDetails{
+								DeliveryStream: adapterhelpers.PtrString("redis-engine-log"), // link
+							},
+						},
+						LogType: types.LogTypeEngineLog,
+						Status:  types.LogDeliveryConfigurationStatusActive,
+					},
+				},
+			},
+		},
+	}
+
+	items, err := cacheClusterOutputMapper(context.Background(), mockElasticacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["key"] != "value" {
+		t.Errorf("expected key to be value, got %v", item.GetTags()["key"])
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "redis-001.abcdef.0001.euw2.cache.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "elasticache-parameter-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "default.redis7",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elasticache-subnet-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "redis-subnets",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elasticache-replication-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "redis",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b8d4b5b5e1b5e1b5",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:052392120703:cache-events",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/elasticache/redis/slow-log",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "firehose-delivery-stream",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "redis-engine-log",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewElastiCacheCacheClusterAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheCacheClusterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func cacheParameterGroupOutputMapper(ctx context.Context, client elasticacheClient, scope string, _ *elasticache.DescribeCacheParameterGroupsInput, output *elasticache.DescribeCacheParameterGroupsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, pg := range output.CacheParameterGroups {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(pg)
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "elasticache-parameter-group",
+			UniqueAttribute: "CacheParameterGroupName",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            elasticacheGetTags(ctx, client, pg.ARN),
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewElastiCacheParameterGroupAdapter(client elasticacheClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeCacheParameterGroupsInput, *elasticache.DescribeCacheParameterGroupsOutput, elasticacheClient, *elasticache.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeCacheParameterGroupsInput, *elasticache.DescribeCacheParameterGroupsOutput, elasticacheClient, *elasticache.Options]{
+		ItemType:        "elasticache-parameter-group",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: elasticacheParameterGroupAdapterMetadata,
+		PaginatorBuilder: func(client elasticacheClient, params *elasticache.DescribeCacheParameterGroupsInput) adapterhelpers.Paginator[*elasticache.DescribeCacheParameterGroupsOutput, *elasticache.Options] {
+			return elasticache.NewDescribeCacheParameterGroupsPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client elasticacheClient, input *elasticache.DescribeCacheParameterGroupsInput) (*elasticache.DescribeCacheParameterGroupsOutput, error) {
+			return client.DescribeCacheParameterGroups(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*elasticache.DescribeCacheParameterGroupsInput, error) {
+			return &elasticache.DescribeCacheParameterGroupsInput{
+				CacheParameterGroupName: &query,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*elasticache.DescribeCacheParameterGroupsInput, error) {
+			return &elasticache.DescribeCacheParameterGroupsInput{}, nil
+		},
+		OutputMapper: cacheParameterGroupOutputMapper,
+	}
+}
+
+var elasticacheParameterGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticache-parameter-group",
+	DescriptiveName: "ElastiCache Parameter Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a parameter group by name",
+		ListDescription:   "List all parameter groups",
+		SearchDescription: "Search for parameter groups by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_elasticache_parameter_group.name"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var elasticacheParameterGroupAdapterIAMActions = IAMActions.Register(elasticacheParameterGroupAdapterMetadata,
+	"elasticache:DescribeCacheParameterGroups",
+	"elasticache:ListTagsForResource",
+)
@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func TestCacheParameterGroupOutputMapper(t *testing.T) {
+	output := elasticache.DescribeCacheParameterGroupsOutput{
+		CacheParameterGroups: []types.CacheParameterGroup{
+			{
+				ARN:                       adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:parametergroup:default.redis7"),
+				CacheParameterGroupName:   adapterhelpers.PtrString("default.redis7"),
+				CacheParameterGroupFamily: adapterhelpers.PtrString("redis7"),
+				Description:               adapterhelpers.PtrString("Default parameter group for redis7"),
+				IsGlobal:                  adapterhelpers.PtrBool(false),
+			},
+		},
+	}
+
+	items, err := cacheParameterGroupOutputMapper(context.Background(), mockElasticacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["key"] != "value" {
+		t.Errorf("expected key to be value, got %v", item.GetTags()["key"])
+	}
+}
+
+func TestNewElastiCacheParameterGroupAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheParameterGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func replicationGroupOutputMapper(ctx context.Context, client elasticacheClient, scope string, _ *elasticache.DescribeReplicationGroupsInput, output *elasticache.DescribeReplicationGroupsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, group := range output.ReplicationGroups {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(group)
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "elasticache-replication-group",
+			UniqueAttribute: "ReplicationGroupId",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            elasticacheGetTags(ctx, client, group.ARN),
+		}
+
+		if group.Status != nil {
+			switch *group.Status {
+			case "available":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "creating", "modifying", "snapshotting", "deleting":
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			case "create-failed":
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			}
+		}
+
+		// Cluster mode enabled groups have a single configuration endpoint,
+		// otherwise each node group has primary and reader endpoints
+		if link := elasticacheEndpointLink(group.ConfigurationEndpoint); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+
+		for _, nodeGroup := range group.NodeGroups {
+			if link := elasticacheEndpointLink(nodeGroup.PrimaryEndpoint); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+
+			if link := elasticacheEndpointLink(nodeGroup.ReaderEndpoint); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		for _, clusterID := range group.MemberClusters {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-cache-cluster",
+					Method: sdp.QueryMethod_GET,
+					Query:  clusterID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If a member cluster fails, the group is affected
+					In: true,
+					// The group manages its member clusters
+					Out: true,
+				},
+			})
+		}
+
+		if group.KmsKeyId != nil {
+			if link := kmsKeyLink(*group.KmsKeyId, scope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		for _, userGroupID := range group.UserGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-user-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  userGroupID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The user group controls who can connect
+					In: true,
+					// The replication group won't affect the user group
+					Out: false,
+				},
+			})
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, elasticacheLogDeliveryLinks(group.LogDeliveryConfigurations, scope)...)
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewElastiCacheReplicationGroupAdapter(client elasticacheClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeReplicationGroupsInput, *elasticache.DescribeReplicationGroupsOutput, elasticacheClient, *elasticache.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeReplicationGroupsInput, *elasticache.DescribeReplicationGroupsOutput, elasticacheClient, *elasticache.Options]{
+		ItemType:        "elasticache-replication-group",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: elasticacheReplicationGroupAdapterMetadata,
+		PaginatorBuilder: func(client elasticacheClient, params *elasticache.DescribeReplicationGroupsInput) adapterhelpers.Paginator[*elasticache.DescribeReplicationGroupsOutput, *elasticache.Options] {
+			return elasticache.NewDescribeReplicationGroupsPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client elasticacheClient, input *elasticache.DescribeReplicationGroupsInput) (*elasticache.DescribeReplicationGroupsOutput, error) {
+			return client.DescribeReplicationGroups(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*elasticache.DescribeReplicationGroupsInput, error) {
+			return &elasticache.DescribeReplicationGroupsInput{
+				ReplicationGroupId: &query,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*elasticache.DescribeReplicationGroupsInput, error) {
+			return &elasticache.DescribeReplicationGroupsInput{}, nil
+		},
+		OutputMapper: replicationGroupOutputMapper,
+	}
+}
+
+var elasticacheReplicationGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticache-replication-group",
+	DescriptiveName: "ElastiCache Replication Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a replication group by ID",
+		ListDescription:   "List all replication groups",
+		SearchDescription: "Search for replication groups by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_elasticache_replication_group.id"},
+	},
+	PotentialLinks: []string{"dns", "elasticache-cache-cluster", "elasticache-user-group", "kms-key", "logs-log-group", "firehose-delivery-stream"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var elasticacheReplicationGroupAdapterIAMActions = IAMActions.Register(elasticacheReplicationGroupAdapterMetadata,
+	"elasticache:DescribeReplicationGroups",
+	"elasticache:ListTagsForResource",
+)
@@ -0,0 +1,125 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestReplicationGroupOutputMapper(t *testing.T) {
+	output := elasticache.DescribeReplicationGroupsOutput{
+		ReplicationGroups: []types.ReplicationGroup{
+			{
+				ARN:                adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:replicationgroup:redis"),
+				ReplicationGroupId: adapterhelpers.PtrString("redis"),
+				Description:        adapterhelpers.PtrString("Session cache"),
+				Status:             adapterhelpers.PtrString("available"),
+				AutomaticFailover:  types.AutomaticFailoverStatusEnabled,
+				MultiAZ:            types.MultiAZStatusEnabled,
+				MemberClusters: []string{
+					"redis-001", // link
+					"redis-002", // link
+				},
+				NodeGroups: []types.NodeGroup{
+					{
+						NodeGroupId: adapterhelpers.PtrString("0001"),
+						Status:      adapterhelpers.PtrString("available"),
+						PrimaryEndpoint: &types.Endpoint{
+							Address: adapterhelpers.PtrString("master.redis.abcdef.euw2.cache.amazonaws.com"), // link
+							Port:    adapterhelpers.PtrInt32(6379),
+						},
+						ReaderEndpoint: &types.Endpoint{
+							Address: adapterhelpers.PtrString("replica.redis.abcdef.euw2.cache.amazonaws.com"), // link
+							Port:    adapterhelpers.PtrInt32(6379),
+						},
+					},
+				},
+				KmsKeyId: adapterhelpers.PtrString("arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab"), // link
+				UserGroupIds: []string{
+					"app-users", // link
+				},
+				AtRestEncryptionEnabled:  adapterhelpers.PtrBool(true),
+				TransitEncryptionEnabled: adapterhelpers.PtrBool(true),
+			},
+		},
+	}
+
+	items, err := replicationGroupOutputMapper(context.Background(), mockElasticacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["key"] != "value" {
+		t.Errorf("expected key to be value, got %v", item.GetTags()["key"])
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "master.redis.abcdef.euw2.cache.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "replica.redis.abcdef.euw2.cache.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "elasticache-cache-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "redis-001",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elasticache-cache-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "redis-002",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "elasticache-user-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "app-users",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewElastiCacheReplicationGroupAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheReplicationGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func serverlessCacheOutputMapper(ctx context.Context, client elasticacheClient, scope string, _ *elasticache.DescribeServerlessCachesInput, output *elasticache.DescribeServerlessCachesOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, cache := range output.ServerlessCaches {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(cache)
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "elasticache-serverless-cache",
+			UniqueAttribute: "ServerlessCacheName",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            elasticacheGetTags(ctx, client, cache.ARN),
+		}
+
+		if cache.Status != nil {
+			switch *cache.Status {
+			case "available":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "creating", "modifying", "deleting":
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			case "create-failed":
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			}
+		}
+
+		if link := elasticacheEndpointLink(cache.Endpoint); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+
+		if link := elasticacheEndpointLink(cache.ReaderEndpoint); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+
+		// Serverless caches don't use subnet groups, the endpoint is placed
+		// directly in these subnets
+		for _, subnetID := range cache.SubnetIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnetID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the subnet is deleted, the cache can't be reached
+					In: true,
+					// The cache won't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, securityGroupID := range cache.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, elasticacheSecurityGroupLink(securityGroupID, scope))
+		}
+
+		if cache.KmsKeyId != nil {
+			if link := kmsKeyLink(*cache.KmsKeyId, scope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if cache.UserGroupId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-user-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cache.UserGroupId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The user group controls who can connect
+					In: true,
+					// The cache won't affect the user group
+					Out: false,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewElastiCacheServerlessCacheAdapter(client elasticacheClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeServerlessCachesInput, *elasticache.DescribeServerlessCachesOutput, elasticacheClient, *elasticache.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeServerlessCachesInput, *elasticache.DescribeServerlessCachesOutput, elasticacheClient, *elasticache.Options]{
+		ItemType:        "elasticache-serverless-cache",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: elasticacheServerlessCacheAdapterMetadata,
+		PaginatorBuilder: func(client elasticacheClient, params *elasticache.DescribeServerlessCachesInput) adapterhelpers.Paginator[*elasticache.DescribeServerlessCachesOutput, *elasticache.Options] {
+			return elasticache.NewDescribeServerlessCachesPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client elasticacheClient, input *elasticache.DescribeServerlessCachesInput) (*elasticache.DescribeServerlessCachesOutput, error) {
+			return client.DescribeServerlessCaches(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*elasticache.DescribeServerlessCachesInput, error) {
+			return &elasticache.DescribeServerlessCachesInput{
+				ServerlessCacheName: &query,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*elasticache.DescribeServerlessCachesInput, error) {
+			return &elasticache.DescribeServerlessCachesInput{}, nil
+		},
+		OutputMapper: serverlessCacheOutputMapper,
+	}
+}
+
+var elasticacheServerlessCacheAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticache-serverless-cache",
+	DescriptiveName: "ElastiCache Serverless Cache",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a serverless cache by name",
+		ListDescription:   "List all serverless caches",
+		SearchDescription: "Search for serverless caches by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_elasticache_serverless_cache.name"},
+	},
+	PotentialLinks: []string{"dns", "ec2-subnet", "ec2-security-group", "kms-key", "elasticache-user-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var elasticacheServerlessCacheAdapterIAMActions = IAMActions.Register(elasticacheServerlessCacheAdapterMetadata,
+	"elasticache:DescribeServerlessCaches",
+	"elasticache:ListTagsForResource",
+)
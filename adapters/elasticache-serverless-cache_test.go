@@ -0,0 +1,110 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestServerlessCacheOutputMapper(t *testing.T) {
+	output := elasticache.DescribeServerlessCachesOutput{
+		ServerlessCaches: []types.ServerlessCache{
+			{
+				ARN:                 adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:serverlesscache:sessions"),
+				ServerlessCacheName: adapterhelpers.PtrString("sessions"),
+				Engine:              adapterhelpers.PtrString("valkey"),
+				MajorEngineVersion:  adapterhelpers.PtrString("8"),
+				Status:              adapterhelpers.PtrString("creating"),
+				Endpoint: &types.Endpoint{
+					Address: adapterhelpers.PtrString("sessions-abcdef.serverless.euw2.cache.amazonaws.com"), // link
+					Port:    adapterhelpers.PtrInt32(6379),
+				},
+				ReaderEndpoint: &types.Endpoint{
+					Address: adapterhelpers.PtrString("sessions-abcdef.serverless.euw2.cache.amazonaws.com"),
+					Port:    adapterhelpers.PtrInt32(6380),
+				},
+				SubnetIds: []string{
+					"subnet-0450a637af9984235", // link
+				},
+				SecurityGroupIds: []string{
+					"sg-0b8d4b5b5e1b5e1b5", // link
+				},
+				KmsKeyId:    adapterhelpers.PtrString("1234abcd-12ab-34cd-56ef-1234567890ab"), // link
+				UserGroupId: adapterhelpers.PtrString("app-users"),                            // link
+			},
+		},
+	}
+
+	items, err := serverlessCacheOutputMapper(context.Background(), mockElasticacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "sessions-abcdef.serverless.euw2.cache.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b8d4b5b5e1b5e1b5",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elasticache-user-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "app-users",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewElastiCacheServerlessCacheAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheServerlessCacheAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
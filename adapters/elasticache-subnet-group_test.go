@@ -0,0 +1,82 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCacheSubnetGroupOutputMapper(t *testing.T) {
+	output := elasticache.DescribeCacheSubnetGroupsOutput{
+		CacheSubnetGroups: []types.CacheSubnetGroup{
+			{
+				ARN:                         adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:subnetgroup:redis-subnets"),
+				CacheSubnetGroupName:        adapterhelpers.PtrString("redis-subnets"),
+				CacheSubnetGroupDescription: adapterhelpers.PtrString("Private subnets"),
+				VpcId:                       adapterhelpers.PtrString("vpc-0d7892e00e573e701"), // link
+				Subnets: []types.Subnet{
+					{
+						SubnetIdentifier: adapterhelpers.PtrString("subnet-0450a637af9984235"), // link
+						SubnetAvailabilityZone: &types.AvailabilityZone{
+							Name: adapterhelpers.PtrString("eu-west-2c"),
+						},
+					},
+				},
+				SupportedNetworkTypes: []types.NetworkType{
+					types.NetworkTypeIpv4,
+				},
+			},
+		},
+	}
+
+	items, err := cacheSubnetGroupOutputMapper(context.Background(), mockElasticacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewElastiCacheSubnetGroupAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheSubnetGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,124 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func userGroupOutputMapper(ctx context.Context, client elasticacheClient, scope string, _ *elasticache.DescribeUserGroupsInput, output *elasticache.DescribeUserGroupsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, group := range output.UserGroups {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(group)
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "elasticache-user-group",
+			UniqueAttribute: "UserGroupId",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            elasticacheGetTags(ctx, client, group.ARN),
+		}
+
+		if group.Status != nil {
+			switch *group.Status {
+			case "active":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "creating", "modifying", "deleting":
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			}
+		}
+
+		for _, replicationGroupID := range group.ReplicationGroups {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-replication-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  replicationGroupID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The replication group won't affect the user group
+					In: false,
+					// Changing the users changes who can connect
+					Out: true,
+				},
+			})
+		}
+
+		for _, cacheName := range group.ServerlessCaches {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticache-serverless-cache",
+					Method: sdp.QueryMethod_GET,
+					Query:  cacheName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The cache won't affect the user group
+					In: false,
+					// Changing the users changes who can connect
+					Out: true,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewElastiCacheUserGroupAdapter(client elasticacheClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeUserGroupsInput, *elasticache.DescribeUserGroupsOutput, elasticacheClient, *elasticache.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*elasticache.DescribeUserGroupsInput, *elasticache.DescribeUserGroupsOutput, elasticacheClient, *elasticache.Options]{
+		ItemType:        "elasticache-user-group",
+		Region:          region,
+		AccountID:       accountID,
+		Client:          client,
+		AdapterMetadata: elasticacheUserGroupAdapterMetadata,
+		PaginatorBuilder: func(client elasticacheClient, params *elasticache.DescribeUserGroupsInput) adapterhelpers.Paginator[*elasticache.DescribeUserGroupsOutput, *elasticache.Options] {
+			return elasticache.NewDescribeUserGroupsPaginator(client, params)
+		},
+		DescribeFunc: func(ctx context.Context, client elasticacheClient, input *elasticache.DescribeUserGroupsInput) (*elasticache.DescribeUserGroupsOutput, error) {
+			return client.DescribeUserGroups(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*elasticache.DescribeUserGroupsInput, error) {
+			return &elasticache.DescribeUserGroupsInput{
+				UserGroupId: &query,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*elasticache.DescribeUserGroupsInput, error) {
+			return &elasticache.DescribeUserGroupsInput{}, nil
+		},
+		OutputMapper: userGroupOutputMapper,
+	}
+}
+
+var elasticacheUserGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticache-user-group",
+	DescriptiveName: "ElastiCache User Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a user group by ID",
+		ListDescription:   "List all user groups",
+		SearchDescription: "Search for user groups by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_elasticache_user_group.user_group_id"},
+	},
+	PotentialLinks: []string{"elasticache-replication-group", "elasticache-serverless-cache"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var elasticacheUserGroupAdapterIAMActions = IAMActions.Register(elasticacheUserGroupAdapterMetadata,
+	"elasticache:DescribeUserGroups",
+	"elasticache:ListTagsForResource",
+)
@@ -0,0 +1,81 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestUserGroupOutputMapper(t *testing.T) {
+	output := elasticache.DescribeUserGroupsOutput{
+		UserGroups: []types.UserGroup{
+			{
+				ARN:         adapterhelpers.PtrString("arn:aws:elasticache:eu-west-2:052392120703:usergroup:app-users"),
+				UserGroupId: adapterhelpers.PtrString("app-users"),
+				Engine:      adapterhelpers.PtrString("redis"),
+				Status:      adapterhelpers.PtrString("active"),
+				UserIds: []string{
+					"default",
+					"app",
+				},
+				ReplicationGroups: []string{
+					"redis", // link
+				},
+				ServerlessCaches: []string{
+					"sessions", // link
+				},
+			},
+		},
+	}
+
+	items, err := userGroupOutputMapper(context.Background(), mockElasticacheClient{}, "foo", nil, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("got %v items, expected 1", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elasticache-replication-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "redis",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "elasticache-serverless-cache",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sessions",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewElastiCacheUserGroupAdapter(t *testing.T) {
+	client, account, region := elasticacheGetAutoConfig(t)
+
+	adapter := NewElastiCacheUserGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
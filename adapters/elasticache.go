@@ -0,0 +1,171 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type elasticacheClient interface {
+	DescribeCacheClusters(ctx context.Context, params *elasticache.DescribeCacheClustersInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error)
+	DescribeCacheParameterGroups(ctx context.Context, params *elasticache.DescribeCacheParameterGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheParameterGroupsOutput, error)
+	DescribeCacheSubnetGroups(ctx context.Context, params *elasticache.DescribeCacheSubnetGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheSubnetGroupsOutput, error)
+	DescribeReplicationGroups(ctx context.Context, params *elasticache.DescribeReplicationGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error)
+	DescribeServerlessCaches(ctx context.Context, params *elasticache.DescribeServerlessCachesInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeServerlessCachesOutput, error)
+	DescribeUserGroups(ctx context.Context, params *elasticache.DescribeUserGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeUserGroupsOutput, error)
+	ListTagsForResource(ctx context.Context, params *elasticache.ListTagsForResourceInput, optFns ...func(*elasticache.Options)) (*elasticache.ListTagsForResourceOutput, error)
+}
+
+type mockElasticacheClient struct{}
+
+func (m mockElasticacheClient) DescribeCacheClusters(ctx context.Context, params *elasticache.DescribeCacheClustersInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+	return nil, nil
+}
+
+func (m mockElasticacheClient) DescribeCacheParameterGroups(ctx context.Context, params *elasticache.DescribeCacheParameterGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheParameterGroupsOutput, error) {
+	return nil, nil
+}
+
+func (m mockElasticacheClient) DescribeCacheSubnetGroups(ctx context.Context, params *elasticache.DescribeCacheSubnetGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeCacheSubnetGroupsOutput, error) {
+	return nil, nil
+}
+
+func (m mockElasticacheClient) DescribeReplicationGroups(ctx context.Context, params *elasticache.DescribeReplicationGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+	return nil, nil
+}
+
+func (m mockElasticacheClient) DescribeServerlessCaches(ctx context.Context, params *elasticache.DescribeServerlessCachesInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeServerlessCachesOutput, error) {
+	return nil, nil
+}
+
+func (m mockElasticacheClient) DescribeUserGroups(ctx context.Context, params *elasticache.DescribeUserGroupsInput, optFns ...func(*elasticache.Options)) (*elasticache.DescribeUserGroupsOutput, error) {
+	return nil, nil
+}
+
+func (m mockElasticacheClient) ListTagsForResource(ctx context.Context, params *elasticache.ListTagsForResourceInput, optFns ...func(*elasticache.Options)) (*elasticache.ListTagsForResourceOutput, error) {
+	return &elasticache.ListTagsForResourceOutput{
+		TagList: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("key"),
+				Value: adapterhelpers.PtrString("value"),
+			},
+		},
+	}, nil
+}
+
+// elasticacheGetTags Gets the tags for an ElastiCache resource by ARN
+func elasticacheGetTags(ctx context.Context, client elasticacheClient, arn *string) map[string]string {
+	if arn == nil {
+		return nil
+	}
+
+	out, err := client.ListTagsForResource(ctx, &elasticache.ListTagsForResourceInput{
+		ResourceName: arn,
+	})
+	if err != nil {
+		return adapterhelpers.HandleTagsError(ctx, err)
+	}
+
+	tags := make(map[string]string)
+
+	for _, tag := range out.TagList {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags
+}
+
+// elasticacheEndpointLink Links to the DNS name of an endpoint. Returns nil if
+// the endpoint has no address yet, e.g. while it is being created
+func elasticacheEndpointLink(endpoint *types.Endpoint) *sdp.LinkedItemQuery {
+	if endpoint == nil || endpoint.Address == nil || *endpoint.Address == "" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "dns",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *endpoint.Address,
+			Scope:  "global",
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// DNS always links
+			In:  true,
+			Out: true,
+		},
+	}
+}
+
+// elasticacheLogDeliveryLinks Links to the log groups and delivery streams that
+// slow and engine logs are sent to. These are referenced by name so are always
+// in the same scope
+func elasticacheLogDeliveryLinks(configs []types.LogDeliveryConfiguration, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, config := range configs {
+		if config.DestinationDetails == nil {
+			continue
+		}
+
+		var query *sdp.Query
+
+		if details := config.DestinationDetails.CloudWatchLogsDetails; details != nil && details.LogGroup != nil {
+			query = &sdp.Query{
+				Type:   "logs-log-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *details.LogGroup,
+				Scope:  scope,
+			}
+		} else if details := config.DestinationDetails.KinesisFirehoseDetails; details != nil && details.DeliveryStream != nil {
+			query = &sdp.Query{
+				Type:   "firehose-delivery-stream",
+				Method: sdp.QueryMethod_GET,
+				Query:  *details.DeliveryStream,
+				Scope:  scope,
+			}
+		}
+
+		if query == nil {
+			continue
+		}
+
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: query,
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the destination is deleted, logs won't be delivered but
+				// the cache will still work
+				In: false,
+				// The cache sends logs to the destination
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
+
+// elasticacheSecurityGroupLink Links to a security group that controls access
+// to a cache
+func elasticacheSecurityGroupLink(securityGroupID string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ec2-security-group",
+			Method: sdp.QueryMethod_GET,
+			Query:  securityGroupID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the rules can block access to the cache
+			In: true,
+			// The cache won't affect the security group
+			Out: false,
+		},
+	}
+}
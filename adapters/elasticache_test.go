@@ -0,0 +1,15 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func elasticacheGetAutoConfig(t *testing.T) (*elasticache.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := elasticache.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -137,3 +137,7 @@ var instanceHealthAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-instance"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var instanceHealthAdapterIAMActions = IAMActions.Register(instanceHealthAdapterMetadata,
+	"elasticloadbalancing:DescribeInstanceHealth",
+)
@@ -187,3 +187,8 @@ var elbv2ListenerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"elbv2-load-balancer", "acm-certificate", "elbv2-rule", "cognito-idp-user-pool", "http", "elbv2-target-group"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var elbv2ListenerAdapterIAMActions = IAMActions.Register(elbv2ListenerAdapterMetadata,
+	"elasticloadbalancing:DescribeListeners",
+	"elasticloadbalancing:DescribeTags",
+)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 
 	"github.com/overmindtech/aws-source/adapterhelpers"
 	"github.com/overmindtech/sdp-go"
@@ -72,6 +73,26 @@ func elbv2LoadBalancerOutputMapper(ctx context.Context, client elbv2Client, scop
 					Out: true,
 				},
 			})
+
+			// Network and gateway load balancers can be used behind PrivateLink
+			// endpoint services, which can have consumers in other accounts
+			if lb.Type == types.LoadBalancerTypeEnumNetwork || lb.Type == types.LoadBalancerTypeEnumGateway {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-vpc-endpoint-service",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *lb.LoadBalancerArn,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The service doesn't affect the load balancer
+						In: false,
+						// Changing the load balancer will affect the service
+						// and everything that connects through it
+						Out: true,
+					},
+				})
+			}
 		}
 
 		if lb.DNSName != nil {
@@ -301,6 +322,11 @@ var loadBalancerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 			TerraformMethod:   sdp.QueryMethod_GET,
 		},
 	},
-	PotentialLinks: []string{"elbv2-target-group", "elbv2-listener", "dns", "route53-hosted-zone", "ec2-vpc", "ec2-subnet", "ec2-address", "ip", "ec2-security-group", "ec2-coip-pool"},
+	PotentialLinks: []string{"elbv2-target-group", "elbv2-listener", "dns", "route53-hosted-zone", "ec2-vpc", "ec2-subnet", "ec2-address", "ip", "ec2-security-group", "ec2-coip-pool", "ec2-vpc-endpoint-service"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var loadBalancerAdapterIAMActions = IAMActions.Register(loadBalancerAdapterMetadata,
+	"elasticloadbalancing:DescribeLoadBalancers",
+	"elasticloadbalancing:DescribeTags",
+)
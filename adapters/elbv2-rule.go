@@ -128,3 +128,8 @@ var ruleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var ruleAdapterIAMActions = IAMActions.Register(ruleAdapterMetadata,
+	"elasticloadbalancing:DescribeRules",
+	"elasticloadbalancing:DescribeTags",
+)
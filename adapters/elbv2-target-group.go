@@ -174,3 +174,8 @@ var targetGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-vpc", "elbv2-load-balancer", "elbv2-target-health"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var targetGroupAdapterIAMActions = IAMActions.Register(targetGroupAdapterMetadata,
+	"elasticloadbalancing:DescribeTags",
+	"elasticloadbalancing:DescribeTargetGroups",
+)
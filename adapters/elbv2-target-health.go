@@ -269,3 +269,7 @@ var targetHealthAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-instance", "lambda-function", "ip", "elbv2-load-balancer"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var targetHealthAdapterIAMActions = IAMActions.Register(targetHealthAdapterMetadata,
+	"elasticloadbalancing:DescribeTargetHealth",
+)
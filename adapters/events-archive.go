@@ -0,0 +1,165 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func eventsArchiveGetFunc(ctx context.Context, client *eventbridge.Client, scope string, query string) (*eventbridge.DescribeArchiveOutput, error) {
+	return client.DescribeArchive(ctx, &eventbridge.DescribeArchiveInput{
+		ArchiveName: &query,
+	})
+}
+
+// eventsArchivesList There is no paginator for ListArchives and the list
+// doesn't include the event pattern, so each archive is described
+// individually
+func eventsArchivesList(ctx context.Context, client *eventbridge.Client, scope string, input *eventbridge.ListArchivesInput) ([]*eventbridge.DescribeArchiveOutput, error) {
+	archives := make([]*eventbridge.DescribeArchiveOutput, 0)
+
+	for {
+		out, err := client.ListArchives(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.Archives {
+			if summary.ArchiveName == nil {
+				continue
+			}
+
+			archive, err := eventsArchiveGetFunc(ctx, client, scope, *summary.ArchiveName)
+			if err != nil {
+				return nil, err
+			}
+
+			archives = append(archives, archive)
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return archives, nil
+}
+
+func eventsArchiveListFunc(ctx context.Context, client *eventbridge.Client, scope string) ([]*eventbridge.DescribeArchiveOutput, error) {
+	return eventsArchivesList(ctx, client, scope, &eventbridge.ListArchivesInput{})
+}
+
+// eventsArchiveSearchFunc Supports searching by the ARN of an archive, or by
+// the ARN of an event bus to return all archives of that bus
+func eventsArchiveSearchFunc(ctx context.Context, client *eventbridge.Client, scope string, query string) ([]*eventbridge.DescribeArchiveOutput, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be an archive or event bus ARN",
+			Scope:       scope,
+		}
+	}
+
+	if a.Type() == "archive" {
+		archive, err := eventsArchiveGetFunc(ctx, client, scope, a.ResourceID())
+		if err != nil {
+			return nil, err
+		}
+
+		return []*eventbridge.DescribeArchiveOutput{archive}, nil
+	}
+
+	return eventsArchivesList(ctx, client, scope, &eventbridge.ListArchivesInput{
+		EventSourceArn: &query,
+	})
+}
+
+func eventsArchiveItemMapper(_, scope string, archive *eventbridge.DescribeArchiveOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "events-archive",
+		UniqueAttribute: "ArchiveName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch archive.State {
+	case types.ArchiveStateEnabled:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ArchiveStateCreating, types.ArchiveStateUpdating:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ArchiveStateCreateFailed, types.ArchiveStateUpdateFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if archive.EventSourceArn != nil {
+		if a, err := adapterhelpers.ParseARN(*archive.EventSourceArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "events-event-bus",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *archive.EventSourceArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The archive stores events from the bus
+					In: true,
+					// Changing the archive doesn't affect the bus
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewEventsArchiveAdapter(client *eventbridge.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*eventbridge.DescribeArchiveOutput, *eventbridge.Client, *eventbridge.Options] {
+	return &adapterhelpers.GetListAdapter[*eventbridge.DescribeArchiveOutput, *eventbridge.Client, *eventbridge.Options]{
+		ItemType:        "events-archive",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: eventsArchiveAdapterMetadata,
+		GetFunc:         eventsArchiveGetFunc,
+		ListFunc:        eventsArchiveListFunc,
+		SearchFunc:      eventsArchiveSearchFunc,
+		ItemMapper:      eventsArchiveItemMapper,
+	}
+}
+
+var eventsArchiveAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "events-archive",
+	DescriptiveName: "EventBridge Archive",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an archive by name",
+		ListDescription:   "List all archives",
+		SearchDescription: "Search for an archive by ARN, or for all archives of an event bus by the bus ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_cloudwatch_event_archive.name",
+		},
+	},
+	PotentialLinks: []string{"events-event-bus"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var eventsArchiveAdapterIAMActions = IAMActions.Register(eventsArchiveAdapterMetadata,
+	"events:DescribeArchive",
+	"events:ListArchives",
+)
@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestEventsArchiveItemMapper(t *testing.T) {
+	archive := &eventbridge.DescribeArchiveOutput{
+		ArchiveArn:     adapterhelpers.PtrString("arn:aws:events:eu-west-2:123456789012:archive/orders"),
+		ArchiveName:    adapterhelpers.PtrString("orders"),
+		CreationTime:   adapterhelpers.PtrTime(time.Now()),
+		EventCount:     100,
+		EventPattern:   adapterhelpers.PtrString(`{"source":["orders"]}`),
+		EventSourceArn: adapterhelpers.PtrString("arn:aws:events:eu-west-2:123456789012:event-bus/orders"),
+		RetentionDays:  adapterhelpers.PtrInt32(30),
+		SizeBytes:      1024,
+		State:          types.ArchiveStateEnabled,
+	}
+
+	item, err := eventsArchiveItemMapper("", "123456789012.eu-west-2", archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "events-event-bus",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:events:eu-west-2:123456789012:event-bus/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEventsArchiveAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := eventbridge.NewFromConfig(config)
+
+	adapter := NewEventsArchiveAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
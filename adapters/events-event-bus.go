@@ -0,0 +1,183 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type eventBusWithPolicy struct {
+	*eventbridge.DescribeEventBusOutput
+	Policy *policy.Policy
+}
+
+func eventBusGetFunc(ctx context.Context, client *eventbridge.Client, scope string, query string) (*eventBusWithPolicy, error) {
+	out, err := client.DescribeEventBus(ctx, &eventbridge.DescribeEventBusInput{
+		Name: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bus := eventBusWithPolicy{
+		DescribeEventBusOutput: out,
+	}
+
+	if out.Policy != nil {
+		// If we can't parse the policy we still want to return the bus
+		bus.Policy, _ = ParsePolicyDocument(*out.Policy)
+	}
+
+	return &bus, nil
+}
+
+// eventBusListFunc There is no paginator for ListEventBuses and the list
+// doesn't include the encryption or dead-letter config, so each bus is
+// described individually
+func eventBusListFunc(ctx context.Context, client *eventbridge.Client, scope string) ([]*eventBusWithPolicy, error) {
+	buses := make([]*eventBusWithPolicy, 0)
+	input := &eventbridge.ListEventBusesInput{}
+
+	for {
+		out, err := client.ListEventBuses(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.EventBuses {
+			if summary.Name == nil {
+				continue
+			}
+
+			bus, err := eventBusGetFunc(ctx, client, scope, *summary.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			buses = append(buses, bus)
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return buses, nil
+}
+
+func eventBusListTagsFunc(ctx context.Context, bus *eventBusWithPolicy, client *eventbridge.Client) (map[string]string, error) {
+	return eventsTags(ctx, client, bus.Arn)
+}
+
+func eventBusItemMapper(_, scope string, bus *eventBusWithPolicy) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(bus)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "events-event-bus",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if bus.Name != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "events-rule",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *bus.Name,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing a rule doesn't affect the bus
+				In: false,
+				// Rules only match events that arrive on the bus
+				Out: true,
+			},
+		})
+	}
+
+	if bus.Arn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "events-archive",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *bus.Arn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing an archive doesn't affect the bus
+				In: false,
+				// Archives only store events that arrive on the bus
+				Out: true,
+			},
+		})
+	}
+
+	if bus.KmsKeyIdentifier != nil {
+		if link := kmsKeyLink(*bus.KmsKeyIdentifier, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if bus.DeadLetterConfig != nil && bus.DeadLetterConfig.Arn != nil {
+		if link := eventsDeadLetterQueueLink(*bus.DeadLetterConfig.Arn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// The policy controls which accounts and organisations can put events
+	// onto the bus
+	item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(bus.Policy)...)
+
+	return &item, nil
+}
+
+func NewEventsEventBusAdapter(client *eventbridge.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*eventBusWithPolicy, *eventbridge.Client, *eventbridge.Options] {
+	return &adapterhelpers.GetListAdapter[*eventBusWithPolicy, *eventbridge.Client, *eventbridge.Options]{
+		ItemType:        "events-event-bus",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: eventBusAdapterMetadata,
+		GetFunc:         eventBusGetFunc,
+		ListFunc:        eventBusListFunc,
+		ListTagsFunc:    eventBusListTagsFunc,
+		ItemMapper:      eventBusItemMapper,
+	}
+}
+
+var eventBusAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "events-event-bus",
+	DescriptiveName: "EventBridge Event Bus",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an event bus by name",
+		ListDescription:   "List all event buses",
+		SearchDescription: "Search for an event bus by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_cloudwatch_event_bus.arn",
+		},
+	},
+	PotentialLinks: []string{"events-rule", "events-archive", "kms-key", "sqs-queue", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var eventBusAdapterIAMActions = IAMActions.Register(eventBusAdapterMetadata,
+	"events:DescribeEventBus",
+	"events:ListEventBuses",
+	"events:ListTagsForResource",
+)
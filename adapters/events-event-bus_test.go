@@ -0,0 +1,79 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestEventBusItemMapper(t *testing.T) {
+	bus := &eventBusWithPolicy{
+		DescribeEventBusOutput: &eventbridge.DescribeEventBusOutput{
+			Arn:              adapterhelpers.PtrString("arn:aws:events:eu-west-2:123456789012:event-bus/orders"),
+			CreationTime:     adapterhelpers.PtrTime(time.Now()),
+			LastModifiedTime: adapterhelpers.PtrTime(time.Now()),
+			Name:             adapterhelpers.PtrString("orders"),
+			KmsKeyIdentifier: adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/12345678-1234-1234-1234-123456789012"),
+			DeadLetterConfig: &types.DeadLetterConfig{
+				Arn: adapterhelpers.PtrString("arn:aws:sqs:eu-west-2:123456789012:orders-dlq"),
+			},
+		},
+	}
+
+	item, err := eventBusItemMapper("", "123456789012.eu-west-2", bus)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "events-rule",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "events-archive",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:events:eu-west-2:123456789012:event-bus/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:orders-dlq",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEventsEventBusAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := eventbridge.NewFromConfig(config)
+
+	adapter := NewEventsEventBusAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,322 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type eventsRule struct {
+	*eventbridge.DescribeRuleOutput
+	Targets []types.Target
+}
+
+// eventsRuleUniqueName Returns the unique name of a rule. This is the same as
+// the resource ID from the rule's ARN i.e. just the rule name for rules on the
+// default bus, otherwise {eventBusName}/{ruleName}
+func eventsRuleUniqueName(eventBusName string, ruleName string) string {
+	if eventBusName == "" || eventBusName == "default" {
+		return ruleName
+	}
+
+	return eventBusName + "/" + ruleName
+}
+
+// parseEventsRuleUniqueName Splits a unique name into the event bus name and
+// rule name. Rule names can't contain slashes, but partner event bus names can
+func parseEventsRuleUniqueName(query string) (string, string) {
+	i := strings.LastIndex(query, "/")
+	if i == -1 {
+		return "default", query
+	}
+
+	return query[:i], query[i+1:]
+}
+
+func eventsRuleGetFunc(ctx context.Context, client *eventbridge.Client, scope string, query string) (*eventsRule, error) {
+	eventBusName, ruleName := parseEventsRuleUniqueName(query)
+
+	out, err := client.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name:         &ruleName,
+		EventBusName: &eventBusName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rule := eventsRule{
+		DescribeRuleOutput: out,
+	}
+
+	// There is no paginator for ListTargetsByRule
+	input := &eventbridge.ListTargetsByRuleInput{
+		Rule:         &ruleName,
+		EventBusName: &eventBusName,
+	}
+
+	for {
+		targets, err := client.ListTargetsByRule(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		rule.Targets = append(rule.Targets, targets.Targets...)
+
+		if targets.NextToken == nil || *targets.NextToken == "" {
+			break
+		}
+
+		input.NextToken = targets.NextToken
+	}
+
+	return &rule, nil
+}
+
+// eventsRulesForBus Gets all rules, including their targets, on a given bus
+func eventsRulesForBus(ctx context.Context, client *eventbridge.Client, scope string, eventBusName string) ([]*eventsRule, error) {
+	rules := make([]*eventsRule, 0)
+	input := &eventbridge.ListRulesInput{
+		EventBusName: &eventBusName,
+	}
+
+	for {
+		out, err := client.ListRules(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.Rules {
+			if summary.Name == nil {
+				continue
+			}
+
+			rule, err := eventsRuleGetFunc(ctx, client, scope, eventsRuleUniqueName(eventBusName, *summary.Name))
+			if err != nil {
+				return nil, err
+			}
+
+			rules = append(rules, rule)
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return rules, nil
+}
+
+// eventsRuleListFunc Rules are listed per bus, so we need to list the buses
+// first
+func eventsRuleListFunc(ctx context.Context, client *eventbridge.Client, scope string) ([]*eventsRule, error) {
+	rules := make([]*eventsRule, 0)
+	input := &eventbridge.ListEventBusesInput{}
+
+	for {
+		out, err := client.ListEventBuses(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bus := range out.EventBuses {
+			if bus.Name == nil {
+				continue
+			}
+
+			busRules, err := eventsRulesForBus(ctx, client, scope, *bus.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			rules = append(rules, busRules...)
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return rules, nil
+}
+
+// eventsRuleSearchFunc Supports searching by the ARN of a rule, or by the name
+// or ARN of an event bus to return all rules on that bus
+func eventsRuleSearchFunc(ctx context.Context, client *eventbridge.Client, scope string, query string) ([]*eventsRule, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		// If it's not an ARN assume it's the name of a bus
+		return eventsRulesForBus(ctx, client, scope, query)
+	}
+
+	switch a.Type() {
+	case "rule":
+		rule, err := eventsRuleGetFunc(ctx, client, scope, a.ResourceID())
+		if err != nil {
+			return nil, err
+		}
+
+		return []*eventsRule{rule}, nil
+	case "event-bus":
+		return eventsRulesForBus(ctx, client, scope, a.ResourceID())
+	default:
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "unsupported ARN type for events-rule search: " + a.Type(),
+			Scope:       scope,
+		}
+	}
+}
+
+func eventsRuleListTagsFunc(ctx context.Context, rule *eventsRule, client *eventbridge.Client) (map[string]string, error) {
+	return eventsTags(ctx, client, rule.Arn)
+}
+
+func eventsRuleItemMapper(_, scope string, rule *eventsRule) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	var eventBusName, ruleName string
+	if rule.EventBusName != nil {
+		eventBusName = *rule.EventBusName
+	}
+	if rule.Name != nil {
+		ruleName = *rule.Name
+	}
+
+	err = attributes.Set("UniqueName", eventsRuleUniqueName(eventBusName, ruleName))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "events-rule",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// Disabling a rule is deliberate, so disabled rules don't report a health
+	if rule.State == types.RuleStateEnabled || rule.State == types.RuleStateEnabledWithAllCloudtrailManagementEvents {
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	if eventBusName != "" {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "events-event-bus",
+				Method: sdp.QueryMethod_GET,
+				Query:  eventBusName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The rule only matches events that arrive on the bus
+				In: true,
+				// Changing the rule doesn't affect the bus
+				Out: false,
+			},
+		})
+	}
+
+	if rule.RoleArn != nil {
+		if link := iamRoleLink(*rule.RoleArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	for _, target := range rule.Targets {
+		if target.Arn != nil {
+			if link := eventsTargetLink(*target.Arn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if target.RoleArn != nil {
+			if link := iamRoleLink(*target.RoleArn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if target.DeadLetterConfig != nil && target.DeadLetterConfig.Arn != nil {
+			if link := eventsDeadLetterQueueLink(*target.DeadLetterConfig.Arn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if target.EcsParameters != nil && target.EcsParameters.TaskDefinitionArn != nil {
+			if a, err := adapterhelpers.ParseARN(*target.EcsParameters.TaskDefinitionArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ecs-task-definition",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *target.EcsParameters.TaskDefinitionArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the task definition changes what the rule
+						// runs
+						In: true,
+						// The rule runs tasks using the definition
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewEventsRuleAdapter(client *eventbridge.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*eventsRule, *eventbridge.Client, *eventbridge.Options] {
+	return &adapterhelpers.GetListAdapter[*eventsRule, *eventbridge.Client, *eventbridge.Options]{
+		ItemType:        "events-rule",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: eventsRuleAdapterMetadata,
+		GetFunc:         eventsRuleGetFunc,
+		ListFunc:        eventsRuleListFunc,
+		SearchFunc:      eventsRuleSearchFunc,
+		ListTagsFunc:    eventsRuleListTagsFunc,
+		ItemMapper:      eventsRuleItemMapper,
+	}
+}
+
+var eventsRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "events-rule",
+	DescriptiveName: "EventBridge Rule",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a rule by name, or {eventBusName}/{ruleName} for rules on a custom event bus",
+		ListDescription:   "List all rules on all event buses",
+		SearchDescription: "Search for a rule by ARN, or for all rules on an event bus by its name or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_cloudwatch_event_rule.arn",
+		},
+	},
+	PotentialLinks: []string{"events-event-bus", "iam-role", "lambda-function", "sqs-queue", "sns-topic", "sfn-state-machine", "ecs-cluster", "ecs-task-definition", "kinesis-stream", "firehose-delivery-stream", "logs-log-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var eventsRuleAdapterIAMActions = IAMActions.Register(eventsRuleAdapterMetadata,
+	"events:DescribeRule",
+	"events:ListEventBuses",
+	"events:ListRules",
+	"events:ListTargetsByRule",
+	"events:ListTagsForResource",
+)
@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestParseEventsRuleUniqueName(t *testing.T) {
+	tests := []struct {
+		Query        string
+		EventBusName string
+		RuleName     string
+	}{
+		{
+			Query:        "my-rule",
+			EventBusName: "default",
+			RuleName:     "my-rule",
+		},
+		{
+			Query:        "orders/my-rule",
+			EventBusName: "orders",
+			RuleName:     "my-rule",
+		},
+		{
+			Query:        "aws.partner/example.com/123/my-rule",
+			EventBusName: "aws.partner/example.com/123",
+			RuleName:     "my-rule",
+		},
+	}
+
+	for _, test := range tests {
+		eventBusName, ruleName := parseEventsRuleUniqueName(test.Query)
+
+		if eventBusName != test.EventBusName {
+			t.Errorf("expected event bus name %v, got %v", test.EventBusName, eventBusName)
+		}
+
+		if ruleName != test.RuleName {
+			t.Errorf("expected rule name %v, got %v", test.RuleName, ruleName)
+		}
+
+		if unique := eventsRuleUniqueName(eventBusName, ruleName); unique != test.Query {
+			t.Errorf("expected unique name %v, got %v", test.Query, unique)
+		}
+	}
+}
+
+func TestEventsRuleItemMapper(t *testing.T) {
+	rule := &eventsRule{
+		DescribeRuleOutput: &eventbridge.DescribeRuleOutput{
+			Arn:          adapterhelpers.PtrString("arn:aws:events:eu-west-2:123456789012:rule/orders/order-created"),
+			EventBusName: adapterhelpers.PtrString("orders"),
+			EventPattern: adapterhelpers.PtrString(`{"source":["orders"],"detail-type":["OrderCreated"]}`),
+			Name:         adapterhelpers.PtrString("order-created"),
+			State:        types.RuleStateEnabled,
+		},
+		Targets: []types.Target{
+			{
+				Id:  adapterhelpers.PtrString("lambda"),
+				Arn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:process-order"),
+				DeadLetterConfig: &types.DeadLetterConfig{
+					Arn: adapterhelpers.PtrString("arn:aws:sqs:eu-west-2:123456789012:order-dlq"),
+				},
+			},
+			{
+				Id:      adapterhelpers.PtrString("ecs"),
+				Arn:     adapterhelpers.PtrString("arn:aws:ecs:eu-west-2:123456789012:cluster/orders"),
+				RoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/events-ecs"),
+				EcsParameters: &types.EcsParameters{
+					TaskDefinitionArn: adapterhelpers.PtrString("arn:aws:ecs:eu-west-2:123456789012:task-definition/fulfil-order:3"),
+				},
+			},
+			{
+				Id:  adapterhelpers.PtrString("sfn"),
+				Arn: adapterhelpers.PtrString("arn:aws:states:eu-west-2:123456789012:stateMachine:order-workflow"),
+			},
+		},
+	}
+
+	item, err := eventsRuleItemMapper("", "123456789012.eu-west-2", rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.UniqueAttributeValue() != "orders/order-created" {
+		t.Errorf("expected unique attribute value to be orders/order-created, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "events-event-bus",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:process-order",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:order-dlq",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecs-cluster",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ecs:eu-west-2:123456789012:cluster/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/events-ecs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ecs-task-definition",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ecs:eu-west-2:123456789012:task-definition/fulfil-order:3",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sfn-state-machine",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:states:eu-west-2:123456789012:stateMachine:order-workflow",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEventsRuleAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := eventbridge.NewFromConfig(config)
+
+	adapter := NewEventsRuleAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// eventsTargetLink Converts the ARN of something that EventBridge (or
+// EventBridge Pipes and Scheduler) sends events to, or reads events from, into
+// a link. Returns nil if the ARN isn't something we have an adapter for
+func eventsTargetLink(targetARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(targetARN)
+	if err != nil {
+		return nil
+	}
+
+	var queryType string
+
+	switch a.Service {
+	case "lambda":
+		queryType = "lambda-function"
+	case "sqs":
+		queryType = "sqs-queue"
+	case "sns":
+		queryType = "sns-topic"
+	case "states":
+		queryType = "sfn-state-machine"
+	case "ecs":
+		// ECS targets are the cluster that the task will be run in
+		queryType = "ecs-cluster"
+	case "kinesis":
+		queryType = "kinesis-stream"
+	case "firehose":
+		queryType = "firehose-delivery-stream"
+	case "logs":
+		queryType = "logs-log-group"
+	case "events":
+		// Rules can send events to buses in other accounts or regions. API
+		// destinations are also "events" ARNs but we don't have an adapter
+		// for them
+		if a.Type() != "event-bus" {
+			return nil
+		}
+
+		queryType = "events-event-bus"
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   queryType,
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  targetARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the target is deleted or its permissions change, delivery
+			// will fail
+			In: true,
+			// Events are sent to the target, so changing what is matched
+			// changes what the target receives
+			Out: true,
+		},
+	}
+}
+
+// eventsDeadLetterQueueLink Links to the SQS queue that failed events are sent
+// to
+func eventsDeadLetterQueueLink(queueARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(queueARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "sqs-queue",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  queueARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the queue is deleted, failed events will be lost
+			In: true,
+			// Failed events are sent to the queue
+			Out: true,
+		},
+	}
+}
+
+// eventsTags Gets the tags for an EventBridge resource
+func eventsTags(ctx context.Context, client *eventbridge.Client, arn *string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	if arn == nil {
+		return tags, nil
+	}
+
+	out, err := client.ListTagsForResource(ctx, &eventbridge.ListTagsForResourceInput{
+		ResourceARN: arn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
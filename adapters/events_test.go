@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestEventsTargetLink(t *testing.T) {
+	tests := []struct {
+		ARN          string
+		ExpectedType string
+	}{
+		{
+			ARN:          "arn:aws:lambda:eu-west-2:123456789012:function:my-function",
+			ExpectedType: "lambda-function",
+		},
+		{
+			ARN:          "arn:aws:sqs:eu-west-2:123456789012:my-queue",
+			ExpectedType: "sqs-queue",
+		},
+		{
+			ARN:          "arn:aws:sns:eu-west-2:123456789012:my-topic",
+			ExpectedType: "sns-topic",
+		},
+		{
+			ARN:          "arn:aws:states:eu-west-2:123456789012:stateMachine:my-state-machine",
+			ExpectedType: "sfn-state-machine",
+		},
+		{
+			ARN:          "arn:aws:ecs:eu-west-2:123456789012:cluster/my-cluster",
+			ExpectedType: "ecs-cluster",
+		},
+		{
+			ARN:          "arn:aws:kinesis:eu-west-2:123456789012:stream/my-stream",
+			ExpectedType: "kinesis-stream",
+		},
+		{
+			ARN:          "arn:aws:firehose:eu-west-2:123456789012:deliverystream/my-stream",
+			ExpectedType: "firehose-delivery-stream",
+		},
+		{
+			ARN:          "arn:aws:logs:eu-west-2:123456789012:log-group:/aws/events/my-group",
+			ExpectedType: "logs-log-group",
+		},
+		{
+			ARN:          "arn:aws:events:us-east-1:210987654321:event-bus/central",
+			ExpectedType: "events-event-bus",
+		},
+		{
+			ARN: "arn:aws:events:eu-west-2:123456789012:api-destination/my-destination/12345678",
+		},
+		{
+			ARN: "arn:aws:scheduler:::aws-sdk:sqs:sendMessage",
+		},
+		{
+			ARN: "not-an-arn",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ARN, func(t *testing.T) {
+			link := eventsTargetLink(test.ARN)
+
+			if test.ExpectedType == "" {
+				if link != nil {
+					t.Errorf("expected no link, got %v", link.GetQuery().GetType())
+				}
+
+				return
+			}
+
+			if link == nil {
+				t.Fatalf("expected a %v link, got nil", test.ExpectedType)
+			}
+
+			if link.GetQuery().GetType() != test.ExpectedType {
+				t.Errorf("expected type %v, got %v", test.ExpectedType, link.GetQuery().GetType())
+			}
+
+			if link.GetQuery().GetMethod() != sdp.QueryMethod_SEARCH {
+				t.Errorf("expected method SEARCH, got %v", link.GetQuery().GetMethod())
+			}
+
+			if link.GetQuery().GetQuery() != test.ARN {
+				t.Errorf("expected query %v, got %v", test.ARN, link.GetQuery().GetQuery())
+			}
+		})
+	}
+}
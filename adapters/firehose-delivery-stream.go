@@ -0,0 +1,484 @@
+package adapters
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func deliveryStreamGetFunc(ctx context.Context, client *firehose.Client, scope string, query string) (*types.DeliveryStreamDescription, error) {
+	out, err := client.DescribeDeliveryStream(ctx, &firehose.DescribeDeliveryStreamInput{
+		DeliveryStreamName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.DeliveryStreamDescription == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "delivery stream " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	stream := out.DeliveryStreamDescription
+
+	// The Splunk HEC token is a credential and shouldn't be stored as an
+	// attribute
+	for i := range stream.Destinations {
+		if splunk := stream.Destinations[i].SplunkDestinationDescription; splunk != nil {
+			splunk.HECToken = nil
+		}
+	}
+
+	return stream, nil
+}
+
+// deliveryStreamListFunc There is no paginator for ListDeliveryStreams and the
+// list only contains names, so each stream is described individually
+func deliveryStreamListFunc(ctx context.Context, client *firehose.Client, scope string) ([]*types.DeliveryStreamDescription, error) {
+	streams := make([]*types.DeliveryStreamDescription, 0)
+	input := &firehose.ListDeliveryStreamsInput{}
+
+	for {
+		out, err := client.ListDeliveryStreams(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range out.DeliveryStreamNames {
+			stream, err := deliveryStreamGetFunc(ctx, client, scope, name)
+			if err != nil {
+				return nil, err
+			}
+
+			streams = append(streams, stream)
+		}
+
+		if out.HasMoreDeliveryStreams == nil || !*out.HasMoreDeliveryStreams || len(out.DeliveryStreamNames) == 0 {
+			break
+		}
+
+		input.ExclusiveStartDeliveryStreamName = &out.DeliveryStreamNames[len(out.DeliveryStreamNames)-1]
+	}
+
+	return streams, nil
+}
+
+func deliveryStreamListTagsFunc(ctx context.Context, stream *types.DeliveryStreamDescription, client *firehose.Client) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	input := &firehose.ListTagsForDeliveryStreamInput{
+		DeliveryStreamName: stream.DeliveryStreamName,
+	}
+
+	for {
+		out, err := client.ListTagsForDeliveryStream(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+
+		if out.HasMoreTags == nil || !*out.HasMoreTags || len(out.Tags) == 0 {
+			break
+		}
+
+		input.ExclusiveStartTagKey = out.Tags[len(out.Tags)-1].Key
+	}
+
+	return tags, nil
+}
+
+// deliveryStreamDestinationBP Firehose buffers records and writes them to its
+// destinations. If a destination breaks, delivery fails and records are
+// backed up or dropped
+func deliveryStreamDestinationBP() *sdp.BlastPropagation {
+	return &sdp.BlastPropagation{
+		In:  true,
+		Out: true,
+	}
+}
+
+// deliveryStreamDependencyBP Things the stream depends on (roles, keys,
+// secrets, processors) but doesn't affect
+func deliveryStreamDependencyBP() *sdp.BlastPropagation {
+	return &sdp.BlastPropagation{
+		In:  true,
+		Out: false,
+	}
+}
+
+// deliveryStreamLinks Collects the links for a delivery stream. The
+// destination descriptions share a lot of structure but no common type, so
+// each part is added separately
+type deliveryStreamLinks struct {
+	scope     string
+	accountID string
+	links     []*sdp.LinkedItemQuery
+}
+
+func (d *deliveryStreamLinks) arnLink(queryType string, arn *string, bp *sdp.BlastPropagation) {
+	if arn == nil {
+		return
+	}
+
+	a, err := adapterhelpers.ParseARN(*arn)
+	if err != nil {
+		return
+	}
+
+	d.links = append(d.links, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   queryType,
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *arn,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: bp,
+	})
+}
+
+func (d *deliveryStreamLinks) role(roleARN *string) {
+	d.arnLink("iam-role", roleARN, deliveryStreamDependencyBP())
+}
+
+func (d *deliveryStreamLinks) endpoint(endpoint *string) {
+	if endpoint == nil || *endpoint == "" {
+		return
+	}
+
+	d.links = append(d.links, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "http",
+			Method: sdp.QueryMethod_GET,
+			Query:  *endpoint,
+			Scope:  "global",
+		},
+		BlastPropagation: deliveryStreamDestinationBP(),
+	})
+}
+
+func (d *deliveryStreamLinks) s3(s3 *types.S3DestinationDescription) {
+	if s3 == nil {
+		return
+	}
+
+	// Bucket ARNs don't contain the account or region
+	if s3.BucketARN != nil && d.accountID != "" {
+		if a, err := adapterhelpers.ParseARN(*s3.BucketARN); err == nil {
+			d.links = append(d.links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  a.Resource,
+					Scope:  adapterhelpers.FormatScope(d.accountID, ""),
+				},
+				BlastPropagation: deliveryStreamDestinationBP(),
+			})
+		}
+	}
+
+	d.role(s3.RoleARN)
+	d.logging(s3.CloudWatchLoggingOptions)
+
+	if s3.EncryptionConfiguration != nil && s3.EncryptionConfiguration.KMSEncryptionConfig != nil {
+		d.arnLink("kms-key", s3.EncryptionConfiguration.KMSEncryptionConfig.AWSKMSKeyARN, deliveryStreamDependencyBP())
+	}
+}
+
+func (d *deliveryStreamLinks) logging(options *types.CloudWatchLoggingOptions) {
+	if options == nil || options.Enabled == nil || !*options.Enabled || options.LogGroupName == nil {
+		return
+	}
+
+	d.links = append(d.links, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "logs-log-group",
+			Method: sdp.QueryMethod_GET,
+			Query:  *options.LogGroupName,
+			Scope:  d.scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the log group is deleted, errors won't be logged
+			In: false,
+			// The stream writes delivery errors to the log group
+			Out: true,
+		},
+	})
+}
+
+func (d *deliveryStreamLinks) processing(config *types.ProcessingConfiguration) {
+	if config == nil || config.Enabled == nil || !*config.Enabled {
+		return
+	}
+
+	for _, processor := range config.Processors {
+		for _, parameter := range processor.Parameters {
+			if parameter.ParameterName == types.ProcessorParameterNameLambdaArn {
+				// Transformation functions can't change what we're delivering
+				// to, but can break delivery
+				d.arnLink("lambda-function", parameter.ParameterValue, deliveryStreamDependencyBP())
+			}
+		}
+	}
+}
+
+func (d *deliveryStreamLinks) secret(config *types.SecretsManagerConfiguration) {
+	if config == nil || config.Enabled == nil || !*config.Enabled {
+		return
+	}
+
+	d.arnLink("secretsmanager-secret", config.SecretARN, deliveryStreamDependencyBP())
+	d.role(config.RoleARN)
+}
+
+// redshift The JDBC URL looks like
+// jdbc:redshift://{cluster}.{id}.{region}.redshift.amazonaws.com:5439/{database}
+func (d *deliveryStreamLinks) redshift(jdbcURL *string) {
+	if jdbcURL == nil {
+		return
+	}
+
+	u, err := url.Parse(strings.TrimPrefix(*jdbcURL, "jdbc:"))
+	if err != nil || u.Hostname() == "" {
+		return
+	}
+
+	host := u.Hostname()
+
+	d.links = append(d.links, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "dns",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  host,
+			Scope:  "global",
+		},
+		BlastPropagation: deliveryStreamDestinationBP(),
+	})
+
+	// Provisioned cluster endpoints start with the cluster identifier.
+	// Serverless workgroup endpoints are
+	// {workgroup}.{account}.{region}.redshift-serverless.amazonaws.com
+	sections := strings.Split(host, ".")
+	if len(sections) < 4 || d.accountID == "" {
+		return
+	}
+
+	queryType := "redshift-cluster"
+	if sections[len(sections)-3] == "redshift-serverless" {
+		queryType = "redshift-serverless-workgroup"
+	} else if sections[len(sections)-3] != "redshift" {
+		return
+	}
+
+	region := sections[len(sections)-4]
+
+	d.links = append(d.links, &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   queryType,
+			Method: sdp.QueryMethod_GET,
+			Query:  sections[0],
+			Scope:  adapterhelpers.FormatScope(d.accountID, region),
+		},
+		BlastPropagation: deliveryStreamDestinationBP(),
+	})
+}
+
+func deliveryStreamItemMapper(_, scope string, stream *types.DeliveryStreamDescription) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "firehose-delivery-stream",
+		UniqueAttribute: "DeliveryStreamName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch stream.DeliveryStreamStatus {
+	case types.DeliveryStreamStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+
+		if stream.DeliveryStreamEncryptionConfiguration != nil {
+			switch stream.DeliveryStreamEncryptionConfiguration.Status {
+			case types.DeliveryStreamEncryptionStatusEnablingFailed, types.DeliveryStreamEncryptionStatusDisablingFailed:
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+			}
+		}
+	case types.DeliveryStreamStatusCreating, types.DeliveryStreamStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.DeliveryStreamStatusCreatingFailed, types.DeliveryStreamStatusDeletingFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	links := deliveryStreamLinks{
+		scope:     scope,
+		accountID: accountID,
+	}
+
+	if source := stream.Source; source != nil {
+		if kinesisSource := source.KinesisStreamSourceDescription; kinesisSource != nil {
+			links.arnLink("kinesis-stream", kinesisSource.KinesisStreamARN, &sdp.BlastPropagation{
+				// The delivery stream reads from the Kinesis stream
+				In: true,
+				// Reading from the stream doesn't affect it
+				Out: false,
+			})
+			links.role(kinesisSource.RoleARN)
+		}
+	}
+
+	if encryption := stream.DeliveryStreamEncryptionConfiguration; encryption != nil && encryption.KeyType == types.KeyTypeCustomerManagedCmk {
+		links.arnLink("kms-key", encryption.KeyARN, deliveryStreamDependencyBP())
+	}
+
+	for _, destination := range stream.Destinations {
+		if s3 := destination.ExtendedS3DestinationDescription; s3 != nil {
+			links.s3(&types.S3DestinationDescription{
+				BucketARN:                s3.BucketARN,
+				RoleARN:                  s3.RoleARN,
+				CloudWatchLoggingOptions: s3.CloudWatchLoggingOptions,
+				EncryptionConfiguration:  s3.EncryptionConfiguration,
+			})
+			links.processing(s3.ProcessingConfiguration)
+			links.s3(s3.S3BackupDescription)
+		} else {
+			// The plain S3 description is also returned alongside the
+			// extended one, so only use it if the extended one is missing
+			links.s3(destination.S3DestinationDescription)
+		}
+
+		if redshift := destination.RedshiftDestinationDescription; redshift != nil {
+			links.redshift(redshift.ClusterJDBCURL)
+			links.role(redshift.RoleARN)
+			links.logging(redshift.CloudWatchLoggingOptions)
+			links.processing(redshift.ProcessingConfiguration)
+			links.secret(redshift.SecretsManagerConfiguration)
+			links.s3(redshift.S3DestinationDescription)
+			links.s3(redshift.S3BackupDescription)
+		}
+
+		if opensearch := destination.AmazonopensearchserviceDestinationDescription; opensearch != nil {
+			links.arnLink("opensearch-domain", opensearch.DomainARN, deliveryStreamDestinationBP())
+			links.endpoint(opensearch.ClusterEndpoint)
+			links.role(opensearch.RoleARN)
+			links.logging(opensearch.CloudWatchLoggingOptions)
+			links.processing(opensearch.ProcessingConfiguration)
+			links.s3(opensearch.S3DestinationDescription)
+		}
+
+		if elasticsearch := destination.ElasticsearchDestinationDescription; elasticsearch != nil {
+			// Elasticsearch domains are managed by the OpenSearch service
+			links.arnLink("opensearch-domain", elasticsearch.DomainARN, deliveryStreamDestinationBP())
+			links.endpoint(elasticsearch.ClusterEndpoint)
+			links.role(elasticsearch.RoleARN)
+			links.logging(elasticsearch.CloudWatchLoggingOptions)
+			links.processing(elasticsearch.ProcessingConfiguration)
+			links.s3(elasticsearch.S3DestinationDescription)
+		}
+
+		if serverless := destination.AmazonOpenSearchServerlessDestinationDescription; serverless != nil {
+			links.endpoint(serverless.CollectionEndpoint)
+			links.role(serverless.RoleARN)
+			links.logging(serverless.CloudWatchLoggingOptions)
+			links.processing(serverless.ProcessingConfiguration)
+			links.s3(serverless.S3DestinationDescription)
+		}
+
+		if splunk := destination.SplunkDestinationDescription; splunk != nil {
+			links.endpoint(splunk.HECEndpoint)
+			links.logging(splunk.CloudWatchLoggingOptions)
+			links.processing(splunk.ProcessingConfiguration)
+			links.secret(splunk.SecretsManagerConfiguration)
+			links.s3(splunk.S3DestinationDescription)
+		}
+
+		if httpEndpoint := destination.HttpEndpointDestinationDescription; httpEndpoint != nil {
+			if httpEndpoint.EndpointConfiguration != nil {
+				links.endpoint(httpEndpoint.EndpointConfiguration.Url)
+			}
+			links.role(httpEndpoint.RoleARN)
+			links.logging(httpEndpoint.CloudWatchLoggingOptions)
+			links.processing(httpEndpoint.ProcessingConfiguration)
+			links.secret(httpEndpoint.SecretsManagerConfiguration)
+			links.s3(httpEndpoint.S3DestinationDescription)
+		}
+
+		if snowflake := destination.SnowflakeDestinationDescription; snowflake != nil {
+			links.endpoint(snowflake.AccountUrl)
+			links.role(snowflake.RoleARN)
+			links.logging(snowflake.CloudWatchLoggingOptions)
+			links.processing(snowflake.ProcessingConfiguration)
+			links.secret(snowflake.SecretsManagerConfiguration)
+			links.s3(snowflake.S3DestinationDescription)
+		}
+
+		if iceberg := destination.IcebergDestinationDescription; iceberg != nil {
+			links.role(iceberg.RoleARN)
+			links.logging(iceberg.CloudWatchLoggingOptions)
+			links.processing(iceberg.ProcessingConfiguration)
+			links.s3(iceberg.S3DestinationDescription)
+		}
+	}
+
+	item.LinkedItemQueries = links.links
+
+	return &item, nil
+}
+
+func NewFirehoseDeliveryStreamAdapter(client *firehose.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DeliveryStreamDescription, *firehose.Client, *firehose.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DeliveryStreamDescription, *firehose.Client, *firehose.Options]{
+		ItemType:        "firehose-delivery-stream",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: deliveryStreamAdapterMetadata,
+		GetFunc:         deliveryStreamGetFunc,
+		ListFunc:        deliveryStreamListFunc,
+		ListTagsFunc:    deliveryStreamListTagsFunc,
+		ItemMapper:      deliveryStreamItemMapper,
+	}
+}
+
+var deliveryStreamAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "firehose-delivery-stream",
+	DescriptiveName: "Firehose Delivery Stream",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a delivery stream by name",
+		ListDescription:   "List all delivery streams",
+		SearchDescription: "Search for a delivery stream by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_kinesis_firehose_delivery_stream.arn",
+		},
+	},
+	PotentialLinks: []string{"kinesis-stream", "s3-bucket", "iam-role", "kms-key", "logs-log-group", "lambda-function", "secretsmanager-secret", "opensearch-domain", "redshift-cluster", "redshift-serverless-workgroup", "http", "dns"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var deliveryStreamAdapterIAMActions = IAMActions.Register(deliveryStreamAdapterMetadata,
+	"firehose:DescribeDeliveryStream",
+	"firehose:ListDeliveryStreams",
+	"firehose:ListTagsForDeliveryStream",
+)
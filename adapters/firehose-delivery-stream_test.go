@@ -0,0 +1,165 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestDeliveryStreamItemMapper(t *testing.T) {
+	stream := &types.DeliveryStreamDescription{
+		DeliveryStreamARN:    adapterhelpers.PtrString("arn:aws:firehose:eu-west-2:123456789012:deliverystream/orders"),
+		DeliveryStreamName:   adapterhelpers.PtrString("orders"),
+		DeliveryStreamStatus: types.DeliveryStreamStatusActive,
+		DeliveryStreamType:   types.DeliveryStreamTypeKinesisStreamAsSource,
+		CreateTimestamp:      adapterhelpers.PtrTime(time.Now()),
+		HasMoreDestinations:  adapterhelpers.PtrBool(false),
+		VersionId:            adapterhelpers.PtrString("1"),
+		DeliveryStreamEncryptionConfiguration: &types.DeliveryStreamEncryptionConfiguration{
+			KeyARN:  adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/12345678-1234-1234-1234-123456789012"),
+			KeyType: types.KeyTypeCustomerManagedCmk,
+			Status:  types.DeliveryStreamEncryptionStatusEnabled,
+		},
+		Source: &types.SourceDescription{
+			KinesisStreamSourceDescription: &types.KinesisStreamSourceDescription{
+				KinesisStreamARN: adapterhelpers.PtrString("arn:aws:kinesis:eu-west-2:123456789012:stream/orders"),
+				RoleARN:          adapterhelpers.PtrString("arn:aws:iam::123456789012:role/firehose-source"),
+			},
+		},
+		Destinations: []types.DestinationDescription{
+			{
+				DestinationId: adapterhelpers.PtrString("destinationId-000000000001"),
+				RedshiftDestinationDescription: &types.RedshiftDestinationDescription{
+					ClusterJDBCURL: adapterhelpers.PtrString("jdbc:redshift://warehouse.abc123xyz.eu-west-2.redshift.amazonaws.com:5439/orders"),
+					RoleARN:        adapterhelpers.PtrString("arn:aws:iam::123456789012:role/firehose-delivery"),
+					CloudWatchLoggingOptions: &types.CloudWatchLoggingOptions{
+						Enabled:       adapterhelpers.PtrBool(true),
+						LogGroupName:  adapterhelpers.PtrString("/aws/kinesisfirehose/orders"),
+						LogStreamName: adapterhelpers.PtrString("DestinationDelivery"),
+					},
+					ProcessingConfiguration: &types.ProcessingConfiguration{
+						Enabled: adapterhelpers.PtrBool(true),
+						Processors: []types.Processor{
+							{
+								Type: types.ProcessorTypeLambda,
+								Parameters: []types.ProcessorParameter{
+									{
+										ParameterName:  types.ProcessorParameterNameLambdaArn,
+										ParameterValue: adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:transform-orders:$LATEST"),
+									},
+								},
+							},
+						},
+					},
+					S3DestinationDescription: &types.S3DestinationDescription{
+						BucketARN: adapterhelpers.PtrString("arn:aws:s3:::orders-staging"),
+						RoleARN:   adapterhelpers.PtrString("arn:aws:iam::123456789012:role/firehose-delivery"),
+					},
+				},
+			},
+			{
+				DestinationId: adapterhelpers.PtrString("destinationId-000000000002"),
+				SplunkDestinationDescription: &types.SplunkDestinationDescription{
+					HECEndpoint: adapterhelpers.PtrString("https://splunk.example.com:8088"),
+				},
+			},
+		},
+	}
+
+	item, err := deliveryStreamItemMapper("", "123456789012.eu-west-2", stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kinesis-stream",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kinesis:eu-west-2:123456789012:stream/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/firehose-source",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "warehouse.abc123xyz.eu-west-2.redshift.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "redshift-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "warehouse",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/firehose-delivery",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/kinesisfirehose/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:transform-orders:$LATEST",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders-staging",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://splunk.example.com:8088",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewFirehoseDeliveryStreamAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := firehose.NewFromConfig(config)
+
+	adapter := NewFirehoseDeliveryStreamAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -86,3 +86,8 @@ var iamGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var iamGroupAdapterIAMActions = IAMActions.Register(iamGroupAdapterMetadata,
+	"iam:GetGroup",
+	"iam:ListGroups",
+)
@@ -152,3 +152,9 @@ var instanceProfileAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"iam-role", "iam-policy"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var instanceProfileAdapterIAMActions = IAMActions.Register(instanceProfileAdapterMetadata,
+	"iam:GetInstanceProfile",
+	"iam:ListInstanceProfileTags",
+	"iam:ListInstanceProfiles",
+)
@@ -329,3 +329,11 @@ var policyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"iam-group", "iam-user", "iam-role"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var policyAdapterIAMActions = IAMActions.Register(policyAdapterMetadata,
+	"iam:GetPolicy",
+	"iam:GetPolicyVersion",
+	"iam:ListEntitiesForPolicy",
+	"iam:ListPolicies",
+	"iam:ListPolicyTags",
+)
@@ -312,3 +312,12 @@ var roleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"iam-policy"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var roleAdapterIAMActions = IAMActions.Register(roleAdapterMetadata,
+	"iam:GetRole",
+	"iam:GetRolePolicy",
+	"iam:ListAttachedRolePolicies",
+	"iam:ListRolePolicies",
+	"iam:ListRoleTags",
+	"iam:ListRoles",
+)
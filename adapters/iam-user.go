@@ -194,3 +194,10 @@ var iamUserAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"iam-group"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var iamUserAdapterIAMActions = IAMActions.Register(iamUserAdapterMetadata,
+	"iam:GetUser",
+	"iam:ListGroupsForUser",
+	"iam:ListUserTags",
+	"iam:ListUsers",
+)
@@ -222,3 +222,28 @@ func ParsePolicyDocument(encoded string) (*policy.Policy, error) {
 
 	return &policyDocument, nil
 }
+
+// iamRoleLink Links to an IAM role that a resource assumes, or passes to
+// another service. Returns nil if the ARN can't be parsed
+func iamRoleLink(roleARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(roleARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "iam-role",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  roleARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the role's permissions change, what the resource can access
+			// changes
+			In: true,
+			// Changing the resource doesn't affect the role
+			Out: false,
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func streamConsumerGetFunc(ctx context.Context, client *kinesis.Client, scope string, query string) (*types.ConsumerDescription, error) {
+	out, err := client.DescribeStreamConsumer(ctx, &kinesis.DescribeStreamConsumerInput{
+		ConsumerARN: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.ConsumerDescription == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "consumer " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return out.ConsumerDescription, nil
+}
+
+// streamConsumersForStream The list of consumers doesn't include the stream
+// ARN, so it is added from the input
+func streamConsumersForStream(ctx context.Context, client *kinesis.Client, streamARN string) ([]*types.ConsumerDescription, error) {
+	consumers := make([]*types.ConsumerDescription, 0)
+
+	paginator := kinesis.NewListStreamConsumersPaginator(client, &kinesis.ListStreamConsumersInput{
+		StreamARN: &streamARN,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, consumer := range out.Consumers {
+			consumers = append(consumers, &types.ConsumerDescription{
+				ConsumerARN:               consumer.ConsumerARN,
+				ConsumerCreationTimestamp: consumer.ConsumerCreationTimestamp,
+				ConsumerName:              consumer.ConsumerName,
+				ConsumerStatus:            consumer.ConsumerStatus,
+				StreamARN:                 &streamARN,
+			})
+		}
+	}
+
+	return consumers, nil
+}
+
+// streamConsumerListFunc Consumers are listed per stream, so we need to list
+// the streams first
+func streamConsumerListFunc(ctx context.Context, client *kinesis.Client, scope string) ([]*types.ConsumerDescription, error) {
+	consumers := make([]*types.ConsumerDescription, 0)
+
+	paginator := kinesis.NewListStreamsPaginator(client, &kinesis.ListStreamsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stream := range out.StreamSummaries {
+			if stream.StreamARN == nil {
+				continue
+			}
+
+			streamConsumers, err := streamConsumersForStream(ctx, client, *stream.StreamARN)
+			if err != nil {
+				return nil, err
+			}
+
+			consumers = append(consumers, streamConsumers...)
+		}
+	}
+
+	return consumers, nil
+}
+
+// streamConsumerSearchFunc Supports searching by the ARN of a consumer, or by
+// the ARN of a stream to return all of its consumers. Consumer ARNs look like
+// arn:aws:kinesis:{region}:{account}:stream/{stream}/consumer/{consumer}:{timestamp}
+func streamConsumerSearchFunc(ctx context.Context, client *kinesis.Client, scope string, query string) ([]*types.ConsumerDescription, error) {
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be a stream or consumer ARN",
+			Scope:       scope,
+		}
+	}
+
+	if strings.Contains(a.Resource, "/consumer/") {
+		consumer, err := streamConsumerGetFunc(ctx, client, scope, query)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.ConsumerDescription{consumer}, nil
+	}
+
+	return streamConsumersForStream(ctx, client, query)
+}
+
+func streamConsumerItemMapper(_, scope string, consumer *types.ConsumerDescription) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "kinesis-stream-consumer",
+		UniqueAttribute: "ConsumerARN",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch consumer.ConsumerStatus {
+	case types.ConsumerStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ConsumerStatusCreating, types.ConsumerStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if consumer.StreamARN != nil {
+		if a, err := adapterhelpers.ParseARN(*consumer.StreamARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "kinesis-stream",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *consumer.StreamARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The consumer reads from the stream
+					In: true,
+					// Consumers don't affect the stream
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewKinesisStreamConsumerAdapter(client *kinesis.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ConsumerDescription, *kinesis.Client, *kinesis.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ConsumerDescription, *kinesis.Client, *kinesis.Options]{
+		ItemType:        "kinesis-stream-consumer",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: streamConsumerAdapterMetadata,
+		GetFunc:         streamConsumerGetFunc,
+		ListFunc:        streamConsumerListFunc,
+		SearchFunc:      streamConsumerSearchFunc,
+		ItemMapper:      streamConsumerItemMapper,
+	}
+}
+
+var streamConsumerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "kinesis-stream-consumer",
+	DescriptiveName: "Kinesis Stream Consumer",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a stream consumer by ARN",
+		ListDescription:   "List all consumers of all streams",
+		SearchDescription: "Search for a consumer by ARN, or for all consumers of a stream by the stream ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_kinesis_stream_consumer.arn",
+		},
+	},
+	PotentialLinks: []string{"kinesis-stream"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var streamConsumerAdapterIAMActions = IAMActions.Register(streamConsumerAdapterMetadata,
+	"kinesis:DescribeStreamConsumer",
+	"kinesis:ListStreamConsumers",
+	"kinesis:ListStreams",
+)
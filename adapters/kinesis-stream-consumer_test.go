@@ -0,0 +1,60 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestStreamConsumerItemMapper(t *testing.T) {
+	consumer := &types.ConsumerDescription{
+		ConsumerARN:               adapterhelpers.PtrString("arn:aws:kinesis:eu-west-2:123456789012:stream/orders/consumer/analytics:1700000000"),
+		ConsumerCreationTimestamp: adapterhelpers.PtrTime(time.Now()),
+		ConsumerName:              adapterhelpers.PtrString("analytics"),
+		ConsumerStatus:            types.ConsumerStatusCreating,
+		StreamARN:                 adapterhelpers.PtrString("arn:aws:kinesis:eu-west-2:123456789012:stream/orders"),
+	}
+
+	item, err := streamConsumerItemMapper("", "123456789012.eu-west-2", consumer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kinesis-stream",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kinesis:eu-west-2:123456789012:stream/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewKinesisStreamConsumerAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := kinesis.NewFromConfig(config)
+
+	adapter := NewKinesisStreamConsumerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
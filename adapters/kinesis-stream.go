@@ -0,0 +1,197 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type kinesisStream struct {
+	*types.StreamDescriptionSummary
+	ResourcePolicy *policy.Policy
+}
+
+func kinesisStreamGetFunc(ctx context.Context, client *kinesis.Client, scope string, query string) (*kinesisStream, error) {
+	out, err := client.DescribeStreamSummary(ctx, &kinesis.DescribeStreamSummaryInput{
+		StreamName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.StreamDescriptionSummary == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "stream " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	stream := kinesisStream{
+		StreamDescriptionSummary: out.StreamDescriptionSummary,
+	}
+
+	// If we can't get the policy we still want to return the stream
+	resourcePolicy, err := client.GetResourcePolicy(ctx, &kinesis.GetResourcePolicyInput{
+		ResourceARN: out.StreamDescriptionSummary.StreamARN,
+	})
+	if err == nil && resourcePolicy.Policy != nil && *resourcePolicy.Policy != "" {
+		stream.ResourcePolicy, _ = ParsePolicyDocument(*resourcePolicy.Policy)
+	}
+
+	return &stream, nil
+}
+
+func kinesisStreamListFunc(ctx context.Context, client *kinesis.Client, scope string) ([]*kinesisStream, error) {
+	streams := make([]*kinesisStream, 0)
+
+	paginator := kinesis.NewListStreamsPaginator(client, &kinesis.ListStreamsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.StreamSummaries {
+			if summary.StreamName == nil {
+				continue
+			}
+
+			stream, err := kinesisStreamGetFunc(ctx, client, scope, *summary.StreamName)
+			if err != nil {
+				return nil, err
+			}
+
+			streams = append(streams, stream)
+		}
+	}
+
+	return streams, nil
+}
+
+func kinesisStreamListTagsFunc(ctx context.Context, stream *kinesisStream, client *kinesis.Client) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	input := &kinesis.ListTagsForStreamInput{
+		StreamARN: stream.StreamARN,
+	}
+
+	for {
+		out, err := client.ListTagsForStream(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+
+		if out.HasMoreTags == nil || !*out.HasMoreTags || len(out.Tags) == 0 {
+			break
+		}
+
+		input.ExclusiveStartTagKey = out.Tags[len(out.Tags)-1].Key
+	}
+
+	return tags, nil
+}
+
+func kinesisStreamItemMapper(_, scope string, stream *kinesisStream) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "kinesis-stream",
+		UniqueAttribute: "StreamName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch stream.StreamStatus {
+	case types.StreamStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.StreamStatusCreating, types.StreamStatusUpdating, types.StreamStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if stream.StreamARN != nil && stream.ConsumerCount != nil && *stream.ConsumerCount > 0 {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "kinesis-stream-consumer",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *stream.StreamARN,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Consumers read from the stream, so changes to the stream
+				// affect them
+				Out: true,
+				// Consumers don't affect the stream
+				In: false,
+			},
+		})
+	}
+
+	if stream.EncryptionType == types.EncryptionTypeKms && stream.KeyId != nil {
+		if link := kmsKeyLink(*stream.KeyId, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// The resource policy controls which principals can read and write
+	item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(stream.ResourcePolicy)...)
+
+	return &item, nil
+}
+
+func NewKinesisStreamAdapter(client *kinesis.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*kinesisStream, *kinesis.Client, *kinesis.Options] {
+	return &adapterhelpers.GetListAdapter[*kinesisStream, *kinesis.Client, *kinesis.Options]{
+		ItemType:        "kinesis-stream",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: kinesisStreamAdapterMetadata,
+		GetFunc:         kinesisStreamGetFunc,
+		ListFunc:        kinesisStreamListFunc,
+		ListTagsFunc:    kinesisStreamListTagsFunc,
+		ItemMapper:      kinesisStreamItemMapper,
+	}
+}
+
+var kinesisStreamAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "kinesis-stream",
+	DescriptiveName: "Kinesis Data Stream",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a stream by name",
+		ListDescription:   "List all streams",
+		SearchDescription: "Search for a stream by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_kinesis_stream.arn",
+		},
+	},
+	PotentialLinks: []string{"kinesis-stream-consumer", "kms-key", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
+
+var kinesisStreamAdapterIAMActions = IAMActions.Register(kinesisStreamAdapterMetadata,
+	"kinesis:DescribeStreamSummary",
+	"kinesis:GetResourcePolicy",
+	"kinesis:ListStreams",
+	"kinesis:ListTagsForStream",
+)
@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/kinesis/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestKinesisStreamItemMapper(t *testing.T) {
+	resourcePolicy, err := ParsePolicyDocument(`{
+		"Version": "2012-10-17",
+		"Statement": [
+			{
+				"Effect": "Allow",
+				"Principal": {
+					"AWS": "arn:aws:iam::123456789012:role/stream-reader"
+				},
+				"Action": "kinesis:GetRecords",
+				"Resource": "arn:aws:kinesis:eu-west-2:123456789012:stream/orders"
+			}
+		]
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream := &kinesisStream{
+		StreamDescriptionSummary: &types.StreamDescriptionSummary{
+			ConsumerCount:           adapterhelpers.PtrInt32(1),
+			EncryptionType:          types.EncryptionTypeKms,
+			KeyId:                   adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/12345678-1234-1234-1234-123456789012"),
+			OpenShardCount:          adapterhelpers.PtrInt32(2),
+			RetentionPeriodHours:    adapterhelpers.PtrInt32(24),
+			StreamARN:               adapterhelpers.PtrString("arn:aws:kinesis:eu-west-2:123456789012:stream/orders"),
+			StreamCreationTimestamp: adapterhelpers.PtrTime(time.Now()),
+			StreamModeDetails: &types.StreamModeDetails{
+				StreamMode: types.StreamModeOnDemand,
+			},
+			StreamName:   adapterhelpers.PtrString("orders"),
+			StreamStatus: types.StreamStatusActive,
+		},
+		ResourcePolicy: resourcePolicy,
+	}
+
+	item, err := kinesisStreamItemMapper("", "123456789012.eu-west-2", stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kinesis-stream-consumer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kinesis:eu-west-2:123456789012:stream/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/stream-reader",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewKinesisStreamAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := kinesis.NewFromConfig(config)
+
+	adapter := NewKinesisStreamAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
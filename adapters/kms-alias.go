@@ -137,3 +137,7 @@ var kmsAliasAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"kms-key"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var kmsAliasAdapterIAMActions = IAMActions.Register(kmsAliasAdapterMetadata,
+	"kms:ListAliases",
+)
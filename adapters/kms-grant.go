@@ -227,6 +227,10 @@ var grantAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
 
+var grantAdapterIAMActions = IAMActions.Register(grantAdapterMetadata,
+	"kms:ListGrants",
+)
+
 // example: user/user-name-with-path
 func iamSourceAndQuery(resource string) (string, string) {
 	tmp := strings.Split(resource, "/") // [user, user-name-with-path]
@@ -134,3 +134,8 @@ var keyPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"kms-key"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var keyPolicyAdapterIAMActions = IAMActions.Register(keyPolicyAdapterMetadata,
+	"kms:GetKeyPolicy",
+	"kms:ListKeyPolicies",
+)
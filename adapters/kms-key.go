@@ -167,3 +167,9 @@ var kmsKeyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"kms-custom-key-store", "kms-key-policy", "kms-grant"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var kmsKeyAdapterIAMActions = IAMActions.Register(kmsKeyAdapterMetadata,
+	"kms:DescribeKey",
+	"kms:ListKeys",
+	"kms:ListResourceTags",
+)
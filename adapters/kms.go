@@ -2,9 +2,13 @@ package adapters
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
 )
 
 func kmsTags(ctx context.Context, cli kmsClient, keyID string) (map[string]string, error) {
@@ -22,6 +26,43 @@ func kmsTags(ctx context.Context, cli kmsClient, keyID string) (map[string]strin
 	return kmsTagsToMap(output.Tags), nil
 }
 
+// kmsKeyLink Links to the KMS key that a resource is encrypted with. Services
+// accept a key ID, key ARN, alias name or alias ARN. Aliases (including AWS
+// managed aliases such as alias/aws/sqs) can't be resolved to a key without an
+// API call, so nil is returned for them
+func kmsKeyLink(keyID string, scope string) *sdp.LinkedItemQuery {
+	if strings.HasPrefix(keyID, "alias/") {
+		return nil
+	}
+
+	query := &sdp.Query{
+		Type:   "kms-key",
+		Method: sdp.QueryMethod_GET,
+		Query:  keyID,
+		Scope:  scope,
+	}
+
+	if a, err := adapterhelpers.ParseARN(keyID); err == nil {
+		if a.Type() == "alias" {
+			return nil
+		}
+
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the key is disabled or deleted, the resource can't be
+			// encrypted or decrypted
+			In: true,
+			// Changing the resource doesn't affect the key
+			Out: false,
+		},
+	}
+}
+
 func kmsTagsToMap(tags []types.Tag) map[string]string {
 	tagsMap := make(map[string]string)
 
@@ -0,0 +1,41 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestKMSKeyLink(t *testing.T) {
+	scope := "123456789012.eu-west-2"
+
+	if link := kmsKeyLink("alias/aws/kinesis", scope); link != nil {
+		t.Errorf("expected no link for an alias, got %v", link)
+	}
+
+	if link := kmsKeyLink("arn:aws:kms:eu-west-2:123456789012:alias/my-key", scope); link != nil {
+		t.Errorf("expected no link for an alias ARN, got %v", link)
+	}
+
+	link := kmsKeyLink("12345678-1234-1234-1234-123456789012", scope)
+	if link == nil {
+		t.Fatal("expected a link for a key ID")
+	}
+
+	if link.GetQuery().GetMethod() != sdp.QueryMethod_GET {
+		t.Errorf("expected method GET, got %v", link.GetQuery().GetMethod())
+	}
+
+	link = kmsKeyLink("arn:aws:kms:eu-west-1:210987654321:key/12345678-1234-1234-1234-123456789012", scope)
+	if link == nil {
+		t.Fatal("expected a link for a key ARN")
+	}
+
+	if link.GetQuery().GetMethod() != sdp.QueryMethod_SEARCH {
+		t.Errorf("expected method SEARCH, got %v", link.GetQuery().GetMethod())
+	}
+
+	if link.GetQuery().GetScope() != "210987654321.eu-west-1" {
+		t.Errorf("expected the scope of the key ARN, got %v", link.GetQuery().GetScope())
+	}
+}
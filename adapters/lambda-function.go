@@ -14,16 +14,32 @@ import (
 )
 
 type FunctionDetails struct {
-	Code               *types.FunctionCodeLocation
-	Concurrency        *types.Concurrency
-	Configuration      *types.FunctionConfiguration
-	UrlConfigs         []*types.FunctionUrlConfig
-	EventInvokeConfigs []*types.FunctionEventInvokeConfig
-	Policy             *PolicyDocument
-	Tags               map[string]string
+	Code                *types.FunctionCodeLocation
+	Concurrency         *types.Concurrency
+	Configuration       *types.FunctionConfiguration
+	UrlConfigs          []*types.FunctionUrlConfig
+	EventInvokeConfigs  []*types.FunctionEventInvokeConfig
+	EventSourceMappings []*types.EventSourceMappingConfiguration
+	Policy              *PolicyDocument
+	Tags                map[string]string
 }
 
 // FunctionGetFunc Gets the details of a specific lambda function
+// lambdaHasLinkedItemQuery Returns whether `links` already contains a query
+// for the same item
+func lambdaHasLinkedItemQuery(links []*sdp.LinkedItemQuery, query *sdp.Query) bool {
+	for _, link := range links {
+		if link.GetQuery().GetType() == query.GetType() &&
+			link.GetQuery().GetMethod() == query.GetMethod() &&
+			link.GetQuery().GetQuery() == query.GetQuery() &&
+			link.GetQuery().GetScope() == query.GetScope() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func functionGetFunc(ctx context.Context, client LambdaClient, scope string, input *lambda.GetFunctionInput) (*sdp.Item, error) {
 	out, err := client.GetFunction(ctx, input)
 
@@ -84,6 +100,28 @@ func functionGetFunc(ctx context.Context, client LambdaClient, scope string, inp
 		}
 	}
 
+	// Get event source mappings, these are the queues and streams that the
+	// function is polling
+	eventSourceMappings := lambda.NewListEventSourceMappingsPaginator(client, &lambda.ListEventSourceMappingsInput{
+		FunctionName: out.Configuration.FunctionName,
+	})
+
+	var mappingOut *lambda.ListEventSourceMappingsOutput
+
+	for eventSourceMappings.HasMorePages() {
+		mappingOut, err = eventSourceMappings.NextPage(ctx)
+
+		if err != nil {
+			// The paginator doesn't advance on error, so we have to stop here
+			// rather than retrying the same page forever
+			break
+		}
+
+		for _, mapping := range mappingOut.EventSourceMappings {
+			function.EventSourceMappings = append(function.EventSourceMappings, &mapping)
+		}
+	}
+
 	// Get policies as this is often where triggers are stored
 	policyResponse, err := client.GetPolicy(ctx, &lambda.GetPolicyInput{
 		FunctionName: out.Configuration.FunctionName,
@@ -140,37 +178,53 @@ func functionGetFunc(ctx context.Context, client LambdaClient, scope string, inp
 		}
 
 		if function.Code.ImageUri != nil {
-			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
-				Query: &sdp.Query{
-					Type:   "http",
-					Method: sdp.QueryMethod_GET,
-					Query:  *function.Code.ImageUri,
-					Scope:  "global",
-				},
-				BlastPropagation: &sdp.BlastPropagation{
-					// Changing the image will affect the function
-					In: true,
-					// Changing the function won't affect the image
-					Out: false,
-				},
-			})
+			if links := ecrImageLinks(*function.Code.ImageUri); links != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, links...)
+			} else {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "http",
+						Method: sdp.QueryMethod_GET,
+						Query:  *function.Code.ImageUri,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the image will affect the function
+						In: true,
+						// Changing the function won't affect the image
+						Out: false,
+					},
+				})
+			}
 		}
 
 		if function.Code.ResolvedImageUri != nil {
-			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
-				Query: &sdp.Query{
-					Type:   "http",
-					Method: sdp.QueryMethod_GET,
-					Query:  *function.Code.ResolvedImageUri,
-					Scope:  "global",
-				},
-				BlastPropagation: &sdp.BlastPropagation{
-					// Changing the image will affect the function
-					In: true,
-					// Changing the function won't affect the image
-					Out: false,
-				},
-			})
+			if links := ecrImageLinks(*function.Code.ResolvedImageUri); links != nil {
+				for _, link := range links {
+					// The resolved image is in the same repository as
+					// ImageUri, which will usually have been linked already
+					if lambdaHasLinkedItemQuery(item.LinkedItemQueries, link.GetQuery()) {
+						continue
+					}
+
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			} else {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "http",
+						Method: sdp.QueryMethod_GET,
+						Query:  *function.Code.ResolvedImageUri,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the image will affect the function
+						In: true,
+						// Changing the function won't affect the image
+						Out: false,
+					},
+				})
+			}
 		}
 	}
 
@@ -473,6 +527,26 @@ func functionGetFunc(ctx context.Context, client LambdaClient, scope string, inp
 		}
 	}
 
+	for _, mapping := range function.EventSourceMappings {
+		if mapping.EventSourceArn != nil {
+			// Possible links from `GetEventLinkedItem()`, SQS queues are the
+			// most common
+			lir, err := GetEventLinkedItem(*mapping.EventSourceArn)
+
+			if err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, lir)
+			}
+		}
+
+		if mapping.DestinationConfig != nil && mapping.DestinationConfig.OnFailure != nil && mapping.DestinationConfig.OnFailure.Destination != nil {
+			lir, err := GetEventLinkedItem(*mapping.DestinationConfig.OnFailure.Destination)
+
+			if err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, lir)
+			}
+		}
+	}
+
 	return &item, nil
 }
 
@@ -590,6 +664,20 @@ func GetEventLinkedItem(destinationARN string) (*sdp.LinkedItemQuery, error) {
 				Out: true,
 			},
 		}, nil
+	case "states":
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "sfn-state-machine",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  destinationARN,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// These are tightly linked
+				In:  true,
+				Out: true,
+			},
+		}, nil
 	case "events":
 		return &sdp.LinkedItemQuery{
 			Query: &sdp.Query{
@@ -656,6 +744,15 @@ var lambdaFunctionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_lambda_function_event_invoke_config.id"},
 		{TerraformQueryMap: "aws_lambda_function_url.function_arn"},
 	},
-	PotentialLinks: []string{"iam-role", "s3-bucket", "sns-topic", "sqs-queue", "lambda-function", "events-event-bus", "elbv2-target-group", "vpc-lattice-target-group", "logs-log-group"},
+	PotentialLinks: []string{"iam-role", "s3-bucket", "sns-topic", "sqs-queue", "lambda-function", "events-event-bus", "sfn-state-machine", "elbv2-target-group", "vpc-lattice-target-group", "logs-log-group", "ecr-repository", "ecr-image"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var lambdaFunctionAdapterIAMActions = IAMActions.Register(lambdaFunctionAdapterMetadata,
+	"lambda:GetFunction",
+	"lambda:GetPolicy",
+	"lambda:ListEventSourceMappings",
+	"lambda:ListFunctionEventInvokeConfigs",
+	"lambda:ListFunctionUrlConfigs",
+	"lambda:ListFunctions",
+)
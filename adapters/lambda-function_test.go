@@ -134,6 +134,20 @@ func (t *TestLambdaClient) ListFunctionEventInvokeConfigs(context.Context, *lamb
 	}, nil
 }
 
+func (t *TestLambdaClient) ListEventSourceMappings(context.Context, *lambda.ListEventSourceMappingsInput, ...func(*lambda.Options)) (*lambda.ListEventSourceMappingsOutput, error) {
+	return &lambda.ListEventSourceMappingsOutput{
+		EventSourceMappings: []types.EventSourceMappingConfiguration{
+			{
+				UUID:           adapterhelpers.PtrString("a1b2c3d4-5678-90ab-cdef-11111EXAMPLE"),
+				EventSourceArn: adapterhelpers.PtrString("arn:aws:sqs:eu-west-2:052392120703:notifications"), // link
+				FunctionArn:    adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:052392120703:function:aws-controltower-NotificationForwarder"),
+				BatchSize:      adapterhelpers.PtrInt32(10),
+				State:          adapterhelpers.PtrString("Enabled"),
+			},
+		},
+	}, nil
+}
+
 func (t *TestLambdaClient) ListFunctionUrlConfigs(context.Context, *lambda.ListFunctionUrlConfigsInput, ...func(*lambda.Options)) (*lambda.ListFunctionUrlConfigsOutput, error) {
 	return &lambda.ListFunctionUrlConfigsOutput{
 		FunctionUrlConfigs: []types.FunctionUrlConfig{
@@ -212,6 +226,12 @@ func TestFunctionGetFunc(t *testing.T) {
 			ExpectedQuery:  "arn:aws:sns:us-east-2:444455556666:MyTopic",
 			ExpectedScope:  "444455556666.us-east-2",
 		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:052392120703:notifications",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
 		{
 			ExpectedType:   "efs-access-point",
 			ExpectedMethod: sdp.QueryMethod_SEARCH,
@@ -319,6 +339,64 @@ func TestFunctionGetFunc(t *testing.T) {
 	tests.Execute(t, item)
 }
 
+// testECRLambdaClient Returns a function that is deployed from an ECR image
+type testECRLambdaClient struct {
+	TestLambdaClient
+}
+
+func (t *testECRLambdaClient) GetFunction(ctx context.Context, params *lambda.GetFunctionInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionOutput, error) {
+	return &lambda.GetFunctionOutput{
+		Configuration: testFuncConfig,
+		Code: &types.FunctionCodeLocation{
+			RepositoryType:   adapterhelpers.PtrString("ECR"),
+			ImageUri:         adapterhelpers.PtrString("052392120703.dkr.ecr.eu-west-2.amazonaws.com/notifications/forwarder:v2"),
+			ResolvedImageUri: adapterhelpers.PtrString("052392120703.dkr.ecr.eu-west-2.amazonaws.com/notifications/forwarder@sha256:0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0"),
+		},
+	}, nil
+}
+
+func TestFunctionGetFuncECRImage(t *testing.T) {
+	item, err := functionGetFunc(context.Background(), &testECRLambdaClient{}, "foo", &lambda.GetFunctionInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "notifications/forwarder",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecr-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "notifications/forwarder:v2",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecr-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "notifications/forwarder@sha256:0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	var repositoryLinks int
+
+	for _, link := range item.GetLinkedItemQueries() {
+		if link.GetQuery().GetType() == "ecr-repository" {
+			repositoryLinks++
+		}
+	}
+
+	if repositoryLinks != 1 {
+		t.Errorf("expected 1 ecr-repository link, got %v", repositoryLinks)
+	}
+}
+
 func TestGetEventLinkedItem(t *testing.T) {
 	type EventLinkedItemTest struct {
 		ARN          string
@@ -347,6 +425,11 @@ func TestGetEventLinkedItem(t *testing.T) {
 			ExpectedType: "lambda-function",
 			ExpectError:  false,
 		},
+		{
+			ARN:          "arn:aws:states:eu-west-2:052392120703:stateMachine:order-processing",
+			ExpectedType: "sfn-state-machine",
+			ExpectError:  false,
+		},
 		{
 			ARN:         "something-bad",
 			ExpectError: true,
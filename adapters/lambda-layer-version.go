@@ -146,3 +146,8 @@ var layerVersionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"signer-signing-job", "signer-signing-profile"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var layerVersionAdapterIAMActions = IAMActions.Register(layerVersionAdapterMetadata,
+	"lambda:GetLayerVersion",
+	"lambda:ListLayerVersions",
+)
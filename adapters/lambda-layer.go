@@ -90,3 +90,7 @@ var lambdaLayerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"lambda-layer-version"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var lambdaLayerAdapterIAMActions = IAMActions.Register(lambdaLayerAdapterMetadata,
+	"lambda:ListLayers",
+)
@@ -13,6 +13,7 @@ type LambdaClient interface {
 	GetLayerVersion(ctx context.Context, params *lambda.GetLayerVersionInput, optFns ...func(*lambda.Options)) (*lambda.GetLayerVersionOutput, error)
 	GetPolicy(ctx context.Context, params *lambda.GetPolicyInput, optFns ...func(*lambda.Options)) (*lambda.GetPolicyOutput, error)
 
+	lambda.ListEventSourceMappingsAPIClient
 	lambda.ListFunctionEventInvokeConfigsAPIClient
 	lambda.ListFunctionUrlConfigsAPIClient
 	lambda.ListFunctionsAPIClient
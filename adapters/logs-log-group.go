@@ -0,0 +1,214 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// logGroupWithPolicy A log group along with its data protection policy, which
+// has to be fetched separately
+type logGroupWithPolicy struct {
+	types.LogGroup
+	DataProtectionPolicy *string
+}
+
+func logGroupOutputMapper(ctx context.Context, client logsClient, scope string, _ *cloudwatchlogs.DescribeLogGroupsInput, output *cloudwatchlogs.DescribeLogGroupsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, logGroup := range output.LogGroups {
+		if logGroup.LogGroupName == nil {
+			continue
+		}
+
+		details := logGroupWithPolicy{
+			LogGroup: logGroup,
+		}
+
+		if client != nil && logGroup.DataProtectionStatus == types.DataProtectionStatusActivated {
+			// If we can't get the policy we still want to return the group
+			policy, err := client.GetDataProtectionPolicy(ctx, &cloudwatchlogs.GetDataProtectionPolicyInput{
+				LogGroupIdentifier: logGroup.LogGroupName,
+			})
+			if err == nil {
+				details.DataProtectionPolicy = policy.PolicyDocument
+			}
+		}
+
+		attrs, err := adapterhelpers.ToAttributesWithExclude(details)
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "logs-log-group",
+			UniqueAttribute: "LogGroupName",
+			Scope:           scope,
+			Attributes:      attrs,
+		}
+
+		if logGroup.LogGroupArn != nil {
+			if tags, err := logsTagsForResource(ctx, client, *logGroup.LogGroupArn); err == nil {
+				item.Tags = tags
+			}
+		}
+
+		if logGroup.KmsKeyId != nil {
+			if a, err := adapterhelpers.ParseARN(*logGroup.KmsKeyId); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "kms-key",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *logGroup.KmsKeyId,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the key is disabled the logs can't be written or
+						// read
+						In: true,
+						// The log group can't affect the key
+						Out: false,
+					},
+				})
+			}
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries,
+			&sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-subscription-filter",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *logGroup.LogGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Subscription filters don't affect the group
+					In: false,
+					// Deleting the group deletes the filters
+					Out: true,
+				},
+			},
+			&sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-stream",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *logGroup.LogGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Streams don't affect the group
+					In: false,
+					// Deleting the group deletes the streams
+					Out: true,
+				},
+			},
+		)
+
+		if logGroup.MetricFilterCount != nil && *logGroup.MetricFilterCount > 0 {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-metric-filter",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *logGroup.LogGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Metric filters don't affect the group
+					In: false,
+					// Changes to the logs will affect the metrics
+					Out: true,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewLogsLogGroupAdapter(client *cloudwatchlogs.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeLogGroupsInput, *cloudwatchlogs.DescribeLogGroupsOutput, logsClient, *cloudwatchlogs.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeLogGroupsInput, *cloudwatchlogs.DescribeLogGroupsOutput, logsClient, *cloudwatchlogs.Options]{
+		ItemType:        "logs-log-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: logGroupAdapterMetadata,
+		// The API only supports searching by prefix, the exact match is
+		// filtered out afterwards. Since results are sorted by name this will
+		// always be on the first page
+		UseListForGet: true,
+		DescribeFunc: func(ctx context.Context, client logsClient, input *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+			return client.DescribeLogGroups(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*cloudwatchlogs.DescribeLogGroupsInput, error) {
+			return &cloudwatchlogs.DescribeLogGroupsInput{
+				LogGroupNamePrefix: &query,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*cloudwatchlogs.DescribeLogGroupsInput, error) {
+			return &cloudwatchlogs.DescribeLogGroupsInput{}, nil
+		},
+		InputMapperSearch: func(ctx context.Context, client logsClient, scope, query string) (*cloudwatchlogs.DescribeLogGroupsInput, error) {
+			logGroupName, err := logGroupNameFromQuery(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return &cloudwatchlogs.DescribeLogGroupsInput{
+				LogGroupNamePrefix: &logGroupName,
+			}, nil
+		},
+		PostSearchFilter: func(ctx context.Context, query string, items []*sdp.Item) ([]*sdp.Item, error) {
+			logGroupName, err := logGroupNameFromQuery(query)
+			if err != nil {
+				return nil, err
+			}
+
+			filtered := make([]*sdp.Item, 0)
+			for _, item := range items {
+				if item.UniqueAttributeValue() == logGroupName {
+					filtered = append(filtered, item)
+				}
+			}
+
+			return filtered, nil
+		},
+		PaginatorBuilder: func(client logsClient, params *cloudwatchlogs.DescribeLogGroupsInput) adapterhelpers.Paginator[*cloudwatchlogs.DescribeLogGroupsOutput, *cloudwatchlogs.Options] {
+			return cloudwatchlogs.NewDescribeLogGroupsPaginator(client, params)
+		},
+		OutputMapper: logGroupOutputMapper,
+	}
+}
+
+var logGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "logs-log-group",
+	DescriptiveName: "CloudWatch Log Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a log group by name",
+		ListDescription:   "List all log groups",
+		SearchDescription: "Search for a log group by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cloudwatch_log_group.name"},
+	},
+	PotentialLinks: []string{"kms-key", "logs-subscription-filter", "logs-log-stream", "logs-metric-filter"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
+
+var logGroupAdapterIAMActions = IAMActions.Register(logGroupAdapterMetadata,
+	"logs:DescribeLogGroups",
+	"logs:GetDataProtectionPolicy",
+	"logs:ListTagsForResource",
+)
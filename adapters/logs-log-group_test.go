@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestLogGroupOutputMapper(t *testing.T) {
+	output := &cloudwatchlogs.DescribeLogGroupsOutput{
+		LogGroups: []types.LogGroup{
+			{
+				Arn:                  adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/my-function:*"),
+				CreationTime:         adapterhelpers.PtrInt64(1700000000000),
+				DataProtectionStatus: types.DataProtectionStatusActivated,
+				KmsKeyId:             adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				LogGroupArn:          adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/my-function"),
+				LogGroupClass:        types.LogGroupClassStandard,
+				LogGroupName:         adapterhelpers.PtrString("/aws/lambda/my-function"),
+				MetricFilterCount:    adapterhelpers.PtrInt32(1),
+				RetentionInDays:      adapterhelpers.PtrInt32(30),
+				StoredBytes:          adapterhelpers.PtrInt64(1024),
+			},
+		},
+	}
+
+	items, err := logGroupOutputMapper(context.Background(), nil, "foo", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-subscription-filter",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "/aws/lambda/my-function",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "logs-log-stream",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "/aws/lambda/my-function",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "logs-metric-filter",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "/aws/lambda/my-function",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewLogsLogGroupAdapter(t *testing.T) {
+	client, account, region := logsGetAutoConfig(t)
+
+	adapter := NewLogsLogGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,144 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func logStreamOutputMapper(_ context.Context, _ logsClient, scope string, input *cloudwatchlogs.DescribeLogStreamsInput, output *cloudwatchlogs.DescribeLogStreamsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, stream := range output.LogStreams {
+		if stream.LogStreamName == nil || input.LogGroupName == nil {
+			continue
+		}
+
+		attrs, err := adapterhelpers.ToAttributesWithExclude(stream)
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		// The stream itself doesn't include the name of the group
+		err = attrs.Set("LogGroupName", *input.LogGroupName)
+		if err != nil {
+			return nil, err
+		}
+
+		err = attrs.Set("UniqueName", logsUniqueName(*input.LogGroupName, *stream.LogStreamName))
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "logs-log-stream",
+			UniqueAttribute: "UniqueName",
+			Scope:           scope,
+			Attributes:      attrs,
+			LinkedItemQueries: []*sdp.LinkedItemQuery{
+				logGroupLink(*input.LogGroupName, scope),
+			},
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+// NewLogsLogStreamAdapter Log groups can contain millions of streams, so this
+// adapter doesn't support List. Searching by log group returns the most
+// recently active streams rather than paging through all of them
+func NewLogsLogStreamAdapter(client *cloudwatchlogs.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeLogStreamsInput, *cloudwatchlogs.DescribeLogStreamsOutput, logsClient, *cloudwatchlogs.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeLogStreamsInput, *cloudwatchlogs.DescribeLogStreamsOutput, logsClient, *cloudwatchlogs.Options]{
+		ItemType:        "logs-log-stream",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: logStreamAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client logsClient, input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+			return client.DescribeLogStreams(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*cloudwatchlogs.DescribeLogStreamsInput, error) {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOTFOUND,
+				ErrorString: "get not supported for logs-log-stream, use search",
+			}
+		},
+		InputMapperSearch: func(ctx context.Context, client logsClient, scope, query string) (*cloudwatchlogs.DescribeLogStreamsInput, error) {
+			if a, err := adapterhelpers.ParseARN(query); err == nil {
+				logGroupName, logStreamName, err := logsARNNames(a)
+				if err != nil {
+					return nil, err
+				}
+
+				if logStreamName != "" {
+					// Results are sorted by name so the exact match will be
+					// first
+					return &cloudwatchlogs.DescribeLogStreamsInput{
+						LogGroupName:        &logGroupName,
+						LogStreamNamePrefix: &logStreamName,
+					}, nil
+				}
+
+				query = logGroupName
+			}
+
+			return &cloudwatchlogs.DescribeLogStreamsInput{
+				LogGroupName: &query,
+				OrderBy:      types.OrderByLastEventTime,
+				Descending:   adapterhelpers.PtrBool(true),
+			}, nil
+		},
+		PostSearchFilter: func(ctx context.Context, query string, items []*sdp.Item) ([]*sdp.Item, error) {
+			a, err := adapterhelpers.ParseARN(query)
+			if err != nil {
+				return items, nil //nolint:nilerr
+			}
+
+			logGroupName, logStreamName, err := logsARNNames(a)
+			if err != nil || logStreamName == "" {
+				return items, nil //nolint:nilerr
+			}
+
+			filtered := make([]*sdp.Item, 0)
+			for _, item := range items {
+				if item.UniqueAttributeValue() == logsUniqueName(logGroupName, logStreamName) {
+					filtered = append(filtered, item)
+				}
+			}
+
+			return filtered, nil
+		},
+		OutputMapper: logStreamOutputMapper,
+	}
+}
+
+var logStreamAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "logs-log-stream",
+	DescriptiveName: "CloudWatch Log Stream",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Search:            true,
+		SearchDescription: "Search for a log stream by ARN, or for the most recently active streams in a log group by log group name or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_cloudwatch_log_stream.arn",
+		},
+	},
+	PotentialLinks: []string{"logs-log-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
+
+var logStreamAdapterIAMActions = IAMActions.Register(logStreamAdapterMetadata,
+	"logs:DescribeLogStreams",
+)
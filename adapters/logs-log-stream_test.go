@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestLogStreamOutputMapper(t *testing.T) {
+	input := &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName: adapterhelpers.PtrString("RDSOSMetrics"),
+	}
+
+	output := &cloudwatchlogs.DescribeLogStreamsOutput{
+		LogStreams: []types.LogStream{
+			{
+				Arn:                 adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:RDSOSMetrics:log-stream:db-ABCDEFGHIJKLMNOP"),
+				CreationTime:        adapterhelpers.PtrInt64(1700000000000),
+				FirstEventTimestamp: adapterhelpers.PtrInt64(1700000000000),
+				LastEventTimestamp:  adapterhelpers.PtrInt64(1700000060000),
+				LastIngestionTime:   adapterhelpers.PtrInt64(1700000060000),
+				LogStreamName:       adapterhelpers.PtrString("db-ABCDEFGHIJKLMNOP"),
+			},
+		},
+	}
+
+	items, err := logStreamOutputMapper(context.Background(), nil, "foo", input, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "RDSOSMetrics:db-ABCDEFGHIJKLMNOP" {
+		t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "RDSOSMetrics",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
@@ -0,0 +1,144 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func metricFilterOutputMapper(_ context.Context, _ logsClient, scope string, _ *cloudwatchlogs.DescribeMetricFiltersInput, output *cloudwatchlogs.DescribeMetricFiltersOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, filter := range output.MetricFilters {
+		if filter.LogGroupName == nil || filter.FilterName == nil {
+			continue
+		}
+
+		attrs, err := adapterhelpers.ToAttributesWithExclude(filter)
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		err = attrs.Set("UniqueName", logsUniqueName(*filter.LogGroupName, *filter.FilterName))
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "logs-metric-filter",
+			UniqueAttribute: "UniqueName",
+			Scope:           scope,
+			Attributes:      attrs,
+			LinkedItemQueries: []*sdp.LinkedItemQuery{
+				logGroupLink(*filter.LogGroupName, scope),
+			},
+		}
+
+		for _, transformation := range filter.MetricTransformations {
+			if transformation.MetricName == nil || transformation.MetricNamespace == nil {
+				continue
+			}
+
+			// Find the alarms that are watching the metric that this filter
+			// produces
+			query, err := ToQueryString(&cloudwatch.DescribeAlarmsForMetricInput{
+				MetricName: transformation.MetricName,
+				Namespace:  transformation.MetricNamespace,
+			})
+			if err != nil {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "cloudwatch-alarm",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  query,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The alarms don't affect the filter
+					In: false,
+					// Changing the filter will change the metric that the
+					// alarms are watching
+					Out: true,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewLogsMetricFilterAdapter(client *cloudwatchlogs.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeMetricFiltersInput, *cloudwatchlogs.DescribeMetricFiltersOutput, logsClient, *cloudwatchlogs.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeMetricFiltersInput, *cloudwatchlogs.DescribeMetricFiltersOutput, logsClient, *cloudwatchlogs.Options]{
+		ItemType:        "logs-metric-filter",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: metricFilterAdapterMetadata,
+		// The API only supports filtering by prefix so the exact match is
+		// filtered out afterwards
+		UseListForGet: true,
+		DescribeFunc: func(ctx context.Context, client logsClient, input *cloudwatchlogs.DescribeMetricFiltersInput) (*cloudwatchlogs.DescribeMetricFiltersOutput, error) {
+			return client.DescribeMetricFilters(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*cloudwatchlogs.DescribeMetricFiltersInput, error) {
+			logGroupName, filterName, err := parseLogsUniqueName(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return &cloudwatchlogs.DescribeMetricFiltersInput{
+				LogGroupName:     &logGroupName,
+				FilterNamePrefix: &filterName,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*cloudwatchlogs.DescribeMetricFiltersInput, error) {
+			return &cloudwatchlogs.DescribeMetricFiltersInput{}, nil
+		},
+		InputMapperSearch: func(ctx context.Context, client logsClient, scope, query string) (*cloudwatchlogs.DescribeMetricFiltersInput, error) {
+			logGroupName, err := logGroupNameFromQuery(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return &cloudwatchlogs.DescribeMetricFiltersInput{
+				LogGroupName: &logGroupName,
+			}, nil
+		},
+		PaginatorBuilder: func(client logsClient, params *cloudwatchlogs.DescribeMetricFiltersInput) adapterhelpers.Paginator[*cloudwatchlogs.DescribeMetricFiltersOutput, *cloudwatchlogs.Options] {
+			return cloudwatchlogs.NewDescribeMetricFiltersPaginator(client, params)
+		},
+		OutputMapper: metricFilterOutputMapper,
+	}
+}
+
+var metricFilterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "logs-metric-filter",
+	DescriptiveName: "CloudWatch Logs Metric Filter",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a metric filter by {logGroupName}:{filterName}",
+		ListDescription:   "List all metric filters",
+		SearchDescription: "Search for metric filters by log group name or ARN",
+	},
+	PotentialLinks: []string{"logs-log-group", "cloudwatch-alarm"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
+
+var metricFilterAdapterIAMActions = IAMActions.Register(metricFilterAdapterMetadata,
+	"logs:DescribeMetricFilters",
+)
@@ -0,0 +1,78 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestMetricFilterOutputMapper(t *testing.T) {
+	output := &cloudwatchlogs.DescribeMetricFiltersOutput{
+		MetricFilters: []types.MetricFilter{
+			{
+				CreationTime:  adapterhelpers.PtrInt64(1700000000000),
+				FilterName:    adapterhelpers.PtrString("errors"),
+				FilterPattern: adapterhelpers.PtrString("ERROR"),
+				LogGroupName:  adapterhelpers.PtrString("/aws/lambda/my-function"),
+				MetricTransformations: []types.MetricTransformation{
+					{
+						MetricName:      adapterhelpers.PtrString("ErrorCount"),
+						MetricNamespace: adapterhelpers.PtrString("MyApp"),
+						MetricValue:     adapterhelpers.PtrString("1"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := metricFilterOutputMapper(context.Background(), nil, "foo", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/lambda/my-function",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "cloudwatch-alarm",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  `{"MetricName":"ErrorCount","Namespace":"MyApp","Dimensions":null,"ExtendedStatistic":null,"Period":null,"Statistic":"","Unit":""}`,
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewLogsMetricFilterAdapter(t *testing.T) {
+	client, account, region := logsGetAutoConfig(t)
+
+	adapter := NewLogsMetricFilterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,98 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func logsResourcePolicyOutputMapper(_ context.Context, _ logsClient, scope string, _ *cloudwatchlogs.DescribeResourcePoliciesInput, output *cloudwatchlogs.DescribeResourcePoliciesOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, resourcePolicy := range output.ResourcePolicies {
+		type parsedResourcePolicy struct {
+			types.ResourcePolicy
+			PolicyDocument *policy.Policy
+		}
+
+		parsed := parsedResourcePolicy{
+			ResourcePolicy: resourcePolicy,
+		}
+
+		if resourcePolicy.PolicyDocument != nil {
+			// If we can't parse the document we still want to return the
+			// policy
+			parsed.PolicyDocument, _ = ParsePolicyDocument(*resourcePolicy.PolicyDocument)
+		}
+
+		attrs, err := adapterhelpers.ToAttributesWithExclude(parsed)
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:              "logs-resource-policy",
+			UniqueAttribute:   "PolicyName",
+			Scope:             scope,
+			Attributes:        attrs,
+			LinkedItemQueries: LinksFromPolicy(parsed.PolicyDocument),
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+// NewLogsResourcePolicyAdapter There can only be 10 resource policies per
+// region so these all fit in a single page
+func NewLogsResourcePolicyAdapter(client *cloudwatchlogs.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeResourcePoliciesInput, *cloudwatchlogs.DescribeResourcePoliciesOutput, logsClient, *cloudwatchlogs.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeResourcePoliciesInput, *cloudwatchlogs.DescribeResourcePoliciesOutput, logsClient, *cloudwatchlogs.Options]{
+		ItemType:        "logs-resource-policy",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: logsResourcePolicyAdapterMetadata,
+		// The API doesn't support getting a single policy
+		UseListForGet: true,
+		DescribeFunc: func(ctx context.Context, client logsClient, input *cloudwatchlogs.DescribeResourcePoliciesInput) (*cloudwatchlogs.DescribeResourcePoliciesOutput, error) {
+			return client.DescribeResourcePolicies(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*cloudwatchlogs.DescribeResourcePoliciesInput, error) {
+			return &cloudwatchlogs.DescribeResourcePoliciesInput{}, nil
+		},
+		InputMapperList: func(scope string) (*cloudwatchlogs.DescribeResourcePoliciesInput, error) {
+			return &cloudwatchlogs.DescribeResourcePoliciesInput{}, nil
+		},
+		OutputMapper: logsResourcePolicyOutputMapper,
+	}
+}
+
+var logsResourcePolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "logs-resource-policy",
+	DescriptiveName: "CloudWatch Logs Resource Policy",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:             true,
+		List:            true,
+		GetDescription:  "Get a resource policy by name",
+		ListDescription: "List all resource policies",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cloudwatch_log_resource_policy.policy_name"},
+	},
+	PotentialLinks: []string{"logs-log-group", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var logsResourcePolicyAdapterIAMActions = IAMActions.Register(logsResourcePolicyAdapterMetadata,
+	"logs:DescribeResourcePolicies",
+)
@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestLogsResourcePolicyOutputMapper(t *testing.T) {
+	output := &cloudwatchlogs.DescribeResourcePoliciesOutput{
+		ResourcePolicies: []types.ResourcePolicy{
+			{
+				LastUpdatedTime: adapterhelpers.PtrInt64(1700000000000),
+				PolicyName:      adapterhelpers.PtrString("AWSLogDeliveryWrite"),
+				PolicyDocument:  adapterhelpers.PtrString(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"delivery.logs.amazonaws.com"},"Action":["logs:CreateLogStream","logs:PutLogEvents"],"Resource":"arn:aws:logs:eu-west-2:123456789012:log-group:my-group:log-stream:*"}]}`),
+			},
+		},
+	}
+
+	items, err := logsResourcePolicyOutputMapper(context.Background(), nil, "foo", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:123456789012:log-group:my-group:log-stream:*",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewLogsResourcePolicyAdapter(t *testing.T) {
+	client, account, region := logsGetAutoConfig(t)
+
+	adapter := NewLogsResourcePolicyAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func subscriptionFilterOutputMapper(_ context.Context, _ logsClient, scope string, _ *cloudwatchlogs.DescribeSubscriptionFiltersInput, output *cloudwatchlogs.DescribeSubscriptionFiltersOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, filter := range output.SubscriptionFilters {
+		if filter.LogGroupName == nil || filter.FilterName == nil {
+			continue
+		}
+
+		attrs, err := adapterhelpers.ToAttributesWithExclude(filter)
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		err = attrs.Set("UniqueName", logsUniqueName(*filter.LogGroupName, *filter.FilterName))
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "logs-subscription-filter",
+			UniqueAttribute: "UniqueName",
+			Scope:           scope,
+			Attributes:      attrs,
+			LinkedItemQueries: []*sdp.LinkedItemQuery{
+				logGroupLink(*filter.LogGroupName, scope),
+			},
+		}
+
+		if filter.DestinationArn != nil {
+			if a, err := adapterhelpers.ParseARN(*filter.DestinationArn); err == nil {
+				var destinationType string
+
+				switch a.Service {
+				case "lambda":
+					destinationType = "lambda-function"
+				case "kinesis":
+					destinationType = "kinesis-stream"
+				case "firehose":
+					destinationType = "firehose-delivery-stream"
+				}
+
+				if destinationType != "" {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   destinationType,
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  *filter.DestinationArn,
+							Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// If the destination is deleted, delivery will fail
+							In: true,
+							// The filter sends log events to the destination
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+
+		if filter.RoleArn != nil {
+			if a, err := adapterhelpers.ParseARN(*filter.RoleArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "iam-role",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *filter.RoleArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The role is used to deliver events to the destination
+						In: true,
+						// The filter can't affect the role
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewLogsSubscriptionFilterAdapter(client *cloudwatchlogs.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeSubscriptionFiltersInput, *cloudwatchlogs.DescribeSubscriptionFiltersOutput, logsClient, *cloudwatchlogs.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*cloudwatchlogs.DescribeSubscriptionFiltersInput, *cloudwatchlogs.DescribeSubscriptionFiltersOutput, logsClient, *cloudwatchlogs.Options]{
+		ItemType:        "logs-subscription-filter",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: subscriptionFilterAdapterMetadata,
+		// The API only supports filtering by prefix so the exact match is
+		// filtered out afterwards
+		UseListForGet: true,
+		DescribeFunc: func(ctx context.Context, client logsClient, input *cloudwatchlogs.DescribeSubscriptionFiltersInput) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
+			return client.DescribeSubscriptionFilters(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*cloudwatchlogs.DescribeSubscriptionFiltersInput, error) {
+			logGroupName, filterName, err := parseLogsUniqueName(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+				LogGroupName:     &logGroupName,
+				FilterNamePrefix: &filterName,
+			}, nil
+		},
+		InputMapperList: func(scope string) (*cloudwatchlogs.DescribeSubscriptionFiltersInput, error) {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOTFOUND,
+				ErrorString: "list not supported for logs-subscription-filter, use search",
+			}
+		},
+		InputMapperSearch: func(ctx context.Context, client logsClient, scope, query string) (*cloudwatchlogs.DescribeSubscriptionFiltersInput, error) {
+			logGroupName, err := logGroupNameFromQuery(query)
+			if err != nil {
+				return nil, err
+			}
+
+			return &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+				LogGroupName: &logGroupName,
+			}, nil
+		},
+		PaginatorBuilder: func(client logsClient, params *cloudwatchlogs.DescribeSubscriptionFiltersInput) adapterhelpers.Paginator[*cloudwatchlogs.DescribeSubscriptionFiltersOutput, *cloudwatchlogs.Options] {
+			return cloudwatchlogs.NewDescribeSubscriptionFiltersPaginator(client, params)
+		},
+		OutputMapper: subscriptionFilterOutputMapper,
+	}
+}
+
+var subscriptionFilterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "logs-subscription-filter",
+	DescriptiveName: "CloudWatch Logs Subscription Filter",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a subscription filter by {logGroupName}:{filterName}",
+		SearchDescription: "Search for subscription filters by log group name or ARN",
+	},
+	PotentialLinks: []string{"logs-log-group", "lambda-function", "kinesis-stream", "firehose-delivery-stream", "iam-role"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
+
+var subscriptionFilterAdapterIAMActions = IAMActions.Register(subscriptionFilterAdapterMetadata,
+	"logs:DescribeSubscriptionFilters",
+)
@@ -0,0 +1,88 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSubscriptionFilterOutputMapper(t *testing.T) {
+	output := &cloudwatchlogs.DescribeSubscriptionFiltersOutput{
+		SubscriptionFilters: []types.SubscriptionFilter{
+			{
+				CreationTime:   adapterhelpers.PtrInt64(1700000000000),
+				DestinationArn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:log-processor"),
+				Distribution:   types.DistributionByLogStream,
+				FilterName:     adapterhelpers.PtrString("to-lambda"),
+				FilterPattern:  adapterhelpers.PtrString("ERROR"),
+				LogGroupName:   adapterhelpers.PtrString("/aws/lambda/my-function"),
+			},
+			{
+				CreationTime:   adapterhelpers.PtrInt64(1700000000000),
+				DestinationArn: adapterhelpers.PtrString("arn:aws:kinesis:eu-west-2:123456789012:stream/log-stream"),
+				FilterName:     adapterhelpers.PtrString("to-kinesis"),
+				FilterPattern:  adapterhelpers.PtrString(""),
+				LogGroupName:   adapterhelpers.PtrString("/aws/lambda/my-function"),
+				RoleArn:        adapterhelpers.PtrString("arn:aws:iam::123456789012:role/CWLtoKinesisRole"),
+			},
+		},
+	}
+
+	items, err := subscriptionFilterOutputMapper(context.Background(), nil, "foo", nil, output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	for _, item := range items {
+		if err = item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if items[0].UniqueAttributeValue() != "/aws/lambda/my-function:to-lambda" {
+		t.Errorf("unexpected unique attribute value %v", items[0].UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/lambda/my-function",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:log-processor",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, items[0])
+
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kinesis-stream",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kinesis:eu-west-2:123456789012:stream/log-stream",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/CWLtoKinesisRole",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, items[1])
+}
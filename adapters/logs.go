@@ -0,0 +1,147 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type logsClient interface {
+	DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	DescribeLogStreams(ctx context.Context, params *cloudwatchlogs.DescribeLogStreamsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
+	DescribeMetricFilters(ctx context.Context, params *cloudwatchlogs.DescribeMetricFiltersInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeMetricFiltersOutput, error)
+	DescribeResourcePolicies(ctx context.Context, params *cloudwatchlogs.DescribeResourcePoliciesInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeResourcePoliciesOutput, error)
+	DescribeSubscriptionFilters(ctx context.Context, params *cloudwatchlogs.DescribeSubscriptionFiltersInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error)
+	GetDataProtectionPolicy(ctx context.Context, params *cloudwatchlogs.GetDataProtectionPolicyInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetDataProtectionPolicyOutput, error)
+	ListTagsForResource(ctx context.Context, params *cloudwatchlogs.ListTagsForResourceInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.ListTagsForResourceOutput, error)
+}
+
+// logDestinationLink Links to a CloudWatch Logs log group or Firehose delivery
+// stream that a resource sends its logs to. Returns nil for anything else
+func logDestinationLink(destinationARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(destinationARN)
+	if err != nil {
+		return nil
+	}
+
+	var queryType string
+
+	switch a.Service {
+	case "logs":
+		queryType = "logs-log-group"
+	case "firehose":
+		queryType = "firehose-delivery-stream"
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   queryType,
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  destinationARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the destination is deleted, logs will be lost
+			In: true,
+			// The resource writes its logs to the destination
+			Out: true,
+		},
+	}
+}
+
+// logsUniqueName Filters are only unique within a log group, so their unique
+// name is in the format `{logGroupName}:{filterName}`. Neither log group names
+// nor filter names can contain a colon
+func logsUniqueName(logGroupName string, name string) string {
+	return logGroupName + ":" + name
+}
+
+// parseLogsUniqueName Splits a unique name in the format
+// `{logGroupName}:{name}` into its parts
+func parseLogsUniqueName(query string) (string, string, error) {
+	logGroupName, name, found := strings.Cut(query, ":")
+
+	if !found || logGroupName == "" || name == "" {
+		return "", "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("query must be in the format {logGroupName}:{name}, got %v", query),
+		}
+	}
+
+	return logGroupName, name, nil
+}
+
+// logsARNNames Extracts the log group and log stream names from a CloudWatch
+// Logs ARN. These come in a few formats:
+//
+// * arn:aws:logs:region:account:log-group:name
+// * arn:aws:logs:region:account:log-group:name:*
+// * arn:aws:logs:region:account:log-group:name:log-stream:stream
+//
+// The stream name will be empty if the ARN is for a log group
+func logsARNNames(a *adapterhelpers.ARN) (string, string, error) {
+	sections := strings.Split(a.Resource, ":")
+
+	if len(sections) < 2 || sections[0] != "log-group" || sections[1] == "" {
+		return "", "", fmt.Errorf("ARN %v is not a log group or log stream", a.String())
+	}
+
+	if len(sections) >= 4 && sections[2] == "log-stream" {
+		return sections[1], sections[3], nil
+	}
+
+	return sections[1], "", nil
+}
+
+// logGroupNameFromQuery Returns the log group name from a search query, which
+// can either be a log group name or a log group ARN
+func logGroupNameFromQuery(query string) (string, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		logGroupName, _, err := logsARNNames(a)
+		return logGroupName, err
+	}
+
+	return query, nil
+}
+
+// logsTagsForResource Gets the tags for a CloudWatch Logs resource. The ARN
+// must not have a trailing `:*`
+func logsTagsForResource(ctx context.Context, client logsClient, resourceARN string) (map[string]string, error) {
+	if client == nil {
+		return nil, nil
+	}
+
+	out, err := client.ListTagsForResource(ctx, &cloudwatchlogs.ListTagsForResourceInput{
+		ResourceArn: &resourceARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Tags, nil
+}
+
+// logGroupLink Returns a link to a log group in the same scope
+func logGroupLink(logGroupName string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "logs-log-group",
+			Method: sdp.QueryMethod_GET,
+			Query:  logGroupName,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the log group will delete everything in it
+			In: true,
+			// Changes to things within the group don't affect the group
+			Out: false,
+		},
+	}
+}
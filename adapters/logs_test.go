@@ -0,0 +1,91 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func logsGetAutoConfig(t *testing.T) (*cloudwatchlogs.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := cloudwatchlogs.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestParseLogsUniqueName(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		logGroupName, name, err := parseLogsUniqueName("/aws/lambda/my-function:my-filter")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if logGroupName != "/aws/lambda/my-function" {
+			t.Errorf("expected log group name /aws/lambda/my-function, got %v", logGroupName)
+		}
+
+		if name != "my-filter" {
+			t.Errorf("expected name my-filter, got %v", name)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, query := range []string{"my-group", "my-group:", ":my-filter"} {
+			if _, _, err := parseLogsUniqueName(query); err == nil {
+				t.Errorf("expected error for %v", query)
+			}
+		}
+	})
+}
+
+func TestLogsARNNames(t *testing.T) {
+	tests := []struct {
+		ARN           string
+		LogGroupName  string
+		LogStreamName string
+	}{
+		{
+			ARN:          "arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/my-function",
+			LogGroupName: "/aws/lambda/my-function",
+		},
+		{
+			ARN:          "arn:aws:logs:eu-west-2:123456789012:log-group:/aws/lambda/my-function:*",
+			LogGroupName: "/aws/lambda/my-function",
+		},
+		{
+			ARN:           "arn:aws:logs:eu-west-2:123456789012:log-group:RDSOSMetrics:log-stream:db-ABCDEFGHIJKLMNOP",
+			LogGroupName:  "RDSOSMetrics",
+			LogStreamName: "db-ABCDEFGHIJKLMNOP",
+		},
+	}
+
+	for _, test := range tests {
+		a, err := adapterhelpers.ParseARN(test.ARN)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		logGroupName, logStreamName, err := logsARNNames(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if logGroupName != test.LogGroupName {
+			t.Errorf("expected log group name %v, got %v", test.LogGroupName, logGroupName)
+		}
+
+		if logStreamName != test.LogStreamName {
+			t.Errorf("expected log stream name %v, got %v", test.LogStreamName, logStreamName)
+		}
+	}
+
+	a, err := adapterhelpers.ParseARN("arn:aws:logs:eu-west-2:123456789012:destination:my-destination")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := logsARNNames(a); err == nil {
+		t.Error("expected error for destination ARN")
+	}
+}
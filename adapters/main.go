@@ -1,7 +1,82 @@
 package adapters
 
 import (
+	"slices"
+	"sort"
+
 	"github.com/overmindtech/sdp-go"
 )
 
 var Metadata = sdp.AdapterMetadataList{}
+
+// IAMActions The IAM actions that each adapter calls, keyed by item type.
+// Adapters declare these alongside their metadata so that the IAM policy the
+// source needs can be generated from the code
+var IAMActions = AdapterIAMActions{}
+
+// AdapterIAMActions A registry of the IAM actions that adapters call
+type AdapterIAMActions struct {
+	actions map[string][]string
+}
+
+// Register Declares the IAM actions that the adapter described by `metadata`
+// calls. Returns the actions so that they can be assigned to a package-level
+// variable next to the metadata
+func (a *AdapterIAMActions) Register(metadata *sdp.AdapterMetadata, actions ...string) []string {
+	if a.actions == nil {
+		a.actions = make(map[string][]string)
+	}
+
+	a.actions[metadata.GetType()] = actions
+
+	return actions
+}
+
+// ForType Returns the IAM actions that the adapter for a given item type
+// calls, or nil if it hasn't declared any
+func (a *AdapterIAMActions) ForType(itemType string) []string {
+	return a.actions[itemType]
+}
+
+// IAMPolicyStatement A single statement within an IAM policy document
+type IAMPolicyStatement struct {
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource string   `json:"Resource"`
+}
+
+// IAMPolicy An IAM policy document
+type IAMPolicy struct {
+	Version   string               `json:"Version"`
+	Statement []IAMPolicyStatement `json:"Statement"`
+}
+
+// Policy Generates the minimal IAM policy that allows the adapters for the
+// given item types to work. If no types are given then the policy covers all
+// adapters that have declared their actions
+func (a *AdapterIAMActions) Policy(itemTypes ...string) IAMPolicy {
+	if len(itemTypes) == 0 {
+		for itemType := range a.actions {
+			itemTypes = append(itemTypes, itemType)
+		}
+	}
+
+	actions := make([]string, 0)
+	for _, itemType := range itemTypes {
+		actions = append(actions, a.actions[itemType]...)
+	}
+
+	sort.Strings(actions)
+	actions = slices.Compact(actions)
+
+	return IAMPolicy{
+		Version: "2012-10-17",
+		Statement: []IAMPolicyStatement{
+			{
+				Effect:   "Allow",
+				Action:   actions,
+				Resource: "*",
+			},
+		},
+	}
+}
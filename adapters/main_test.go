@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestIAMActionsDeclared(t *testing.T) {
+	for _, metadata := range Metadata.AllAdapterMetadata() {
+		if len(IAMActions.ForType(metadata.GetType())) == 0 {
+			t.Errorf("adapter %v has not declared the IAM actions that it calls", metadata.GetType())
+		}
+	}
+}
+
+func TestIAMActionsPolicy(t *testing.T) {
+	policy := IAMActions.Policy("ec2-instance", "ec2-vpc", "ec2-instance")
+
+	if len(policy.Statement) != 1 {
+		t.Fatalf("expected 1 statement, got %v", len(policy.Statement))
+	}
+
+	expected := []string{"ec2:DescribeInstances", "ec2:DescribeVpcs"}
+	if !slices.Equal(policy.Statement[0].Action, expected) {
+		t.Errorf("expected actions %v, got %v", expected, policy.Statement[0].Action)
+	}
+}
+
+// TestREADMEPolicy Makes sure that the policy in the README matches what the
+// adapters actually need. If this fails, regenerate the policy with `go run
+// main.go metadata --format iam-policy`
+func TestREADMEPolicy(t *testing.T) {
+	readme, err := os.ReadFile("../README.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, after, found := strings.Cut(string(readme), "```json\n")
+	if !found {
+		t.Fatal("could not find IAM policy in README")
+	}
+
+	policyJSON, _, found := strings.Cut(after, "```")
+	if !found {
+		t.Fatal("could not find end of IAM policy in README")
+	}
+
+	var readmePolicy IAMPolicy
+	err = json.Unmarshal([]byte(policyJSON), &readmePolicy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated := IAMActions.Policy()
+
+	if len(readmePolicy.Statement) != 1 {
+		t.Fatalf("expected 1 statement in README policy, got %v", len(readmePolicy.Statement))
+	}
+
+	if !slices.Equal(readmePolicy.Statement[0].Action, generated.Statement[0].Action) {
+		t.Errorf("README policy is out of date, expected actions:\n%v", strings.Join(generated.Statement[0].Action, "\n"))
+	}
+}
@@ -162,3 +162,8 @@ var ruleGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"kms-key", "sns-topic", "network-firewall-rule-group"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
 })
+
+var ruleGroupAdapterIAMActions = IAMActions.Register(ruleGroupAdapterMetadata,
+	"network-firewall:DescribeRuleGroup",
+	"network-firewall:ListRuleGroups",
+)
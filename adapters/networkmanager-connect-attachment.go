@@ -112,3 +112,7 @@ var connectAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var connectAttachmentAdapterIAMActions = IAMActions.Register(connectAttachmentAdapterMetadata,
+	"networkmanager:GetConnectAttachment",
+)
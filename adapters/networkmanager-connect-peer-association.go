@@ -174,3 +174,7 @@ var connectPeerAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetada
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-connect-peer", "networkmanager-device", "networkmanager-link"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var connectPeerAssociationAdapterIAMActions = IAMActions.Register(connectPeerAssociationAdapterMetadata,
+	"networkmanager:GetConnectPeerAssociations",
+)
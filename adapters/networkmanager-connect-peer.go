@@ -247,3 +247,8 @@ var connectPeerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network", "networkmanager-connect-attachment", "ip", "rdap-asn", "ec2-subnet"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var connectPeerAdapterIAMActions = IAMActions.Register(connectPeerAdapterMetadata,
+	"networkmanager:GetConnectPeer",
+	"networkmanager:ListConnectPeers",
+)
@@ -212,3 +212,7 @@ var networkmanagerConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMeta
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-link", "networkmanager-device"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var networkmanagerConnectionAdapterIAMActions = IAMActions.Register(networkmanagerConnectionAdapterMetadata,
+	"networkmanager:GetConnections",
+)
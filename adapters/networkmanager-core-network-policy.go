@@ -89,3 +89,7 @@ var coreNetworkPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var coreNetworkPolicyAdapterIAMActions = IAMActions.Register(coreNetworkPolicyAdapterMetadata,
+	"networkmanager:GetCoreNetworkPolicy",
+)
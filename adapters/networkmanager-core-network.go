@@ -154,3 +154,8 @@ var coreNetworkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network-policy", "networkmanager-connect-peer"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var coreNetworkAdapterIAMActions = IAMActions.Register(coreNetworkAdapterMetadata,
+	"networkmanager:GetCoreNetwork",
+	"networkmanager:ListCoreNetworks",
+)
@@ -207,3 +207,7 @@ var networkmanagerDeviceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-site", "networkmanager-link-association", "networkmanager-connection", "networkmanager-network-resource-relationship"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var networkmanagerDeviceAdapterIAMActions = IAMActions.Register(networkmanagerDeviceAdapterMetadata,
+	"networkmanager:GetDevices",
+)
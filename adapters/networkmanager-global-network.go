@@ -205,6 +205,10 @@ var globalNetworkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
 
+var globalNetworkAdapterIAMActions = IAMActions.Register(globalNetworkAdapterMetadata,
+	"networkmanager:DescribeGlobalNetworks",
+)
+
 // idWithGlobalNetwork makes custom ID of given entity with global network ID and this entity ID/ARN
 func idWithGlobalNetwork(gn, idOrArn string) string {
 	return fmt.Sprintf("%s|%s", gn, idOrArn)
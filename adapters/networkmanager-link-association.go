@@ -190,3 +190,7 @@ var linkAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-link", "networkmanager-device"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var linkAssociationAdapterIAMActions = IAMActions.Register(linkAssociationAdapterMetadata,
+	"networkmanager:GetLinkAssociations",
+)
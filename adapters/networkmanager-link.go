@@ -191,3 +191,7 @@ var linkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-link-association", "networkmanager-site", "networkmanager-network-resource-relationship"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var linkAdapterIAMActions = IAMActions.Register(linkAdapterMetadata,
+	"networkmanager:GetLinks",
+)
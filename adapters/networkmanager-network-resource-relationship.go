@@ -286,3 +286,7 @@ var networkResourceRelationshipAdapterMetadata = Metadata.Register(&sdp.AdapterM
 	PotentialLinks: []string{"networkmanager-connection", "networkmanager-device", "networkmanager-link", "networkmanager-site", "directconnect-connection", "directconnect-direct-connect-gateway", "directconnect-virtual-interface", "ec2-customer"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var networkResourceRelationshipAdapterIAMActions = IAMActions.Register(networkResourceRelationshipAdapterMetadata,
+	"networkmanager:GetNetworkResourceRelationships",
+)
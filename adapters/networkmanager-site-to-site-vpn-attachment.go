@@ -121,3 +121,7 @@ var siteToSiteVpnAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetad
 	PotentialLinks: []string{"networkmanager-core-network", "ec2-vpn-connection"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var siteToSiteVpnAttachmentAdapterIAMActions = IAMActions.Register(siteToSiteVpnAttachmentAdapterMetadata,
+	"networkmanager:GetSiteToSiteVpnAttachment",
+)
@@ -159,3 +159,7 @@ var siteAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-link", "networkmanager-device"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var siteAdapterIAMActions = IAMActions.Register(siteAdapterMetadata,
+	"networkmanager:GetSites",
+)
@@ -159,3 +159,7 @@ var transitGatewayConnectPeerAssociationAdapterMetadata = Metadata.Register(&sdp
 	PotentialLinks: []string{"networkmanager-global-network", "networkmanager-device", "networkmanager-link"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var transitGatewayConnectPeerAssociationAdapterIAMActions = IAMActions.Register(transitGatewayConnectPeerAssociationAdapterMetadata,
+	"networkmanager:GetTransitGatewayConnectPeerAssociations",
+)
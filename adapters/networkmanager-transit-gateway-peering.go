@@ -137,3 +137,7 @@ var transitGatewayPeeringAdapterMetadata = Metadata.Register(&sdp.AdapterMetadat
 	PotentialLinks: []string{"networkmanager-core-network", "ec2-transit-gateway-peering-attachment", "ec2-transit-gateway"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var transitGatewayPeeringAdapterIAMActions = IAMActions.Register(transitGatewayPeeringAdapterMetadata,
+	"networkmanager:GetTransitGatewayPeering",
+)
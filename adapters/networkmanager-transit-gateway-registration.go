@@ -137,3 +137,7 @@ var transitGatewayRegistrationAdapterMetadata = Metadata.Register(&sdp.AdapterMe
 	PotentialLinks: []string{"networkmanager-global-network", "ec2-transit-gateway"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var transitGatewayRegistrationAdapterIAMActions = IAMActions.Register(transitGatewayRegistrationAdapterMetadata,
+	"networkmanager:GetTransitGatewayRegistrations",
+)
@@ -125,3 +125,7 @@ var transitGatewayRouteTableAttachmentAdapterMetadata = Metadata.Register(&sdp.A
 	PotentialLinks: []string{"networkmanager-core-network", "networkmanager-transit-gateway-peering", "ec2-transit-gateway-route-table"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var transitGatewayRouteTableAttachmentAdapterIAMActions = IAMActions.Register(transitGatewayRouteTableAttachmentAdapterMetadata,
+	"networkmanager:GetTransitGatewayRouteTableAttachment",
+)
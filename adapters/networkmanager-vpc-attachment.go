@@ -93,3 +93,7 @@ var vpcAttachmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"networkmanager-core-network"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var vpcAttachmentAdapterIAMActions = IAMActions.Register(vpcAttachmentAdapterMetadata,
+	"networkmanager:GetVpcAttachment",
+)
@@ -0,0 +1,371 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// DescribeDomains accepts at most this many domain names per request
+const opensearchDescribeDomainsBatchSize = 5
+
+type opensearchDomain struct {
+	*types.DomainStatus
+	AccessPolicy *policy.Policy
+}
+
+func newOpenSearchDomain(status *types.DomainStatus) *opensearchDomain {
+	domain := opensearchDomain{
+		DomainStatus: status,
+	}
+
+	// We still want to return the domain if the policy can't be parsed
+	if status.AccessPolicies != nil && *status.AccessPolicies != "" {
+		domain.AccessPolicy, _ = ParsePolicyDocument(*status.AccessPolicies)
+	}
+
+	return &domain
+}
+
+func opensearchDomainGetFunc(ctx context.Context, client *opensearch.Client, scope string, query string) (*opensearchDomain, error) {
+	out, err := client.DescribeDomain(ctx, &opensearch.DescribeDomainInput{
+		DomainName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.DomainStatus == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "domain " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return newOpenSearchDomain(out.DomainStatus), nil
+}
+
+func opensearchDomainListFunc(ctx context.Context, client *opensearch.Client, scope string) ([]*opensearchDomain, error) {
+	namesOut, err := client.ListDomainNames(ctx, &opensearch.ListDomainNamesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(namesOut.DomainNames))
+
+	for _, info := range namesOut.DomainNames {
+		if info.DomainName != nil {
+			names = append(names, *info.DomainName)
+		}
+	}
+
+	domains := make([]*opensearchDomain, 0, len(names))
+
+	for start := 0; start < len(names); start += opensearchDescribeDomainsBatchSize {
+		end := min(start+opensearchDescribeDomainsBatchSize, len(names))
+
+		out, err := client.DescribeDomains(ctx, &opensearch.DescribeDomainsInput{
+			DomainNames: names[start:end],
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, status := range out.DomainStatusList {
+			domains = append(domains, newOpenSearchDomain(&status))
+		}
+	}
+
+	return domains, nil
+}
+
+func opensearchDomainListTagsFunc(ctx context.Context, domain *opensearchDomain, client *opensearch.Client) (map[string]string, error) {
+	out, err := client.ListTags(ctx, &opensearch.ListTagsInput{
+		ARN: domain.ARN,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+
+	for _, tag := range out.TagList {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func opensearchDomainItemMapper(_, scope string, domain *opensearchDomain) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(domain, "AccessPolicies")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "opensearch-domain",
+		UniqueAttribute: "DomainName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch domain.DomainProcessingStatus {
+	case types.DomainProcessingStatusTypeActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.DomainProcessingStatusTypeCreating,
+		types.DomainProcessingStatusTypeModifying,
+		types.DomainProcessingStatusTypeUpgrading,
+		types.DomainProcessingStatusTypeUpdating,
+		types.DomainProcessingStatusTypeDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.DomainProcessingStatusTypeIsolated:
+		// Isolated domains have been suspended and can't be used until
+		// the cause, e.g. an unpaid bill, is resolved
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	// A configuration change that can't be applied leaves the domain running
+	// with its old configuration
+	if domain.ChangeProgressDetails != nil {
+		switch domain.ChangeProgressDetails.ConfigChangeStatus {
+		case types.ConfigChangeStatusValidationFailed, types.ConfigChangeStatusPendingUserInput:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+	}
+
+	// Public domains have endpoint and endpoint v2 (dual stack), VPC domains
+	// have their endpoints in a map
+	endpoints := make([]string, 0)
+	if domain.Endpoint != nil {
+		endpoints = append(endpoints, *domain.Endpoint)
+	}
+	if domain.EndpointV2 != nil {
+		endpoints = append(endpoints, *domain.EndpointV2)
+	}
+	for _, endpoint := range domain.Endpoints {
+		endpoints = append(endpoints, endpoint)
+	}
+
+	if domain.DomainEndpointOptions != nil {
+		if domain.DomainEndpointOptions.CustomEndpointEnabled != nil && *domain.DomainEndpointOptions.CustomEndpointEnabled && domain.DomainEndpointOptions.CustomEndpoint != nil {
+			endpoints = append(endpoints, *domain.DomainEndpointOptions.CustomEndpoint)
+		}
+
+		if certificateARN := domain.DomainEndpointOptions.CustomEndpointCertificateArn; certificateARN != nil {
+			if a, err := adapterhelpers.ParseARN(*certificateARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "acm-certificate",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *certificateARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the certificate expires or is deleted, clients
+						// can't connect to the custom endpoint
+						In: true,
+						// The domain won't affect the certificate
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	for _, endpoint := range endpoints {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  endpoint,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if domain.VPCOptions != nil {
+		if domain.VPCOptions.VPCId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *domain.VPCOptions.VPCId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the VPC can affect the domain
+					In: true,
+					// The domain won't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		for _, subnetID := range domain.VPCOptions.SubnetIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnetID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the subnet is deleted, the domain can't be reached
+					In: true,
+					// The domain won't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, securityGroupID := range domain.VPCOptions.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  securityGroupID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the rules can block access to the domain
+					In: true,
+					// The domain won't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if domain.EncryptionAtRestOptions != nil && domain.EncryptionAtRestOptions.KmsKeyId != nil {
+		if link := kmsKeyLink(*domain.EncryptionAtRestOptions.KmsKeyId, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// Cognito is used to authenticate OpenSearch Dashboards users
+	if domain.CognitoOptions != nil && domain.CognitoOptions.Enabled != nil && *domain.CognitoOptions.Enabled {
+		if domain.CognitoOptions.UserPoolId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "cognito-idp-user-pool",
+					Method: sdp.QueryMethod_GET,
+					Query:  *domain.CognitoOptions.UserPoolId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the user pool changes who can log in
+					In: true,
+					// The domain won't affect the user pool
+					Out: false,
+				},
+			})
+		}
+
+		if domain.CognitoOptions.IdentityPoolId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "cognito-identity-pool",
+					Method: sdp.QueryMethod_GET,
+					Query:  *domain.CognitoOptions.IdentityPoolId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The identity pool grants logged in users their
+					// permissions
+					In: true,
+					// The domain won't affect the identity pool
+					Out: false,
+				},
+			})
+		}
+
+		if domain.CognitoOptions.RoleArn != nil {
+			if link := iamRoleLink(*domain.CognitoOptions.RoleArn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	for _, option := range domain.LogPublishingOptions {
+		if option.CloudWatchLogsLogGroupArn == nil {
+			continue
+		}
+
+		if a, err := adapterhelpers.ParseARN(*option.CloudWatchLogsLogGroupArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *option.CloudWatchLogsLogGroupArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the log group is deleted, logs won't be published
+					// but the domain will still work
+					In: false,
+					// The domain publishes logs to the log group
+					Out: true,
+				},
+			})
+		}
+	}
+
+	// The access policy controls which principals can use the domain
+	item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(domain.AccessPolicy)...)
+
+	return &item, nil
+}
+
+func NewOpenSearchDomainAdapter(client *opensearch.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*opensearchDomain, *opensearch.Client, *opensearch.Options] {
+	return &adapterhelpers.GetListAdapter[*opensearchDomain, *opensearch.Client, *opensearch.Options]{
+		ItemType:        "opensearch-domain",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: opensearchDomainAdapterMetadata,
+		GetFunc:         opensearchDomainGetFunc,
+		ListFunc:        opensearchDomainListFunc,
+		ListTagsFunc:    opensearchDomainListTagsFunc,
+		ItemMapper:      opensearchDomainItemMapper,
+	}
+}
+
+var opensearchDomainAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "opensearch-domain",
+	DescriptiveName: "OpenSearch Domain",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a domain by name",
+		ListDescription:   "List all domains",
+		SearchDescription: "Search for a domain by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_opensearch_domain.domain_name"},
+		{TerraformQueryMap: "aws_opensearch_domain_policy.domain_name"},
+		{TerraformQueryMap: "aws_elasticsearch_domain.domain_name"},
+	},
+	PotentialLinks: []string{"dns", "acm-certificate", "ec2-vpc", "ec2-subnet", "ec2-security-group", "kms-key", "cognito-idp-user-pool", "cognito-identity-pool", "iam-role", "iam-user", "logs-log-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var opensearchDomainAdapterIAMActions = IAMActions.Register(opensearchDomainAdapterMetadata,
+	"es:DescribeDomain",
+	"es:DescribeDomains",
+	"es:ListDomainNames",
+	"es:ListTags",
+)
@@ -0,0 +1,189 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/opensearch"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestOpenSearchDomainItemMapper(t *testing.T) {
+	domain := newOpenSearchDomain(&types.DomainStatus{
+		ARN:                    adapterhelpers.PtrString("arn:aws:es:eu-west-2:052392120703:domain/search"),
+		DomainId:               adapterhelpers.PtrString("052392120703/search"),
+		DomainName:             adapterhelpers.PtrString("search"),
+		EngineVersion:          adapterhelpers.PtrString("OpenSearch_2.13"),
+		DomainProcessingStatus: types.DomainProcessingStatusTypeActive,
+		Created:                adapterhelpers.PtrBool(true),
+		Deleted:                adapterhelpers.PtrBool(false),
+		Processing:             adapterhelpers.PtrBool(false),
+		ClusterConfig: &types.ClusterConfig{
+			InstanceType:  types.OpenSearchPartitionInstanceTypeR6gLargeSearch,
+			InstanceCount: adapterhelpers.PtrInt32(3),
+		},
+		Endpoints: map[string]string{
+			"vpc": "vpc-search-abcdefghijklmnop.eu-west-2.es.amazonaws.com", // link
+		},
+		AccessPolicies: adapterhelpers.PtrString(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::052392120703:role/search-writer"},"Action":"es:ESHttp*","Resource":"arn:aws:es:eu-west-2:052392120703:domain/search/*"}]}`),
+		VPCOptions: &types.VPCDerivedInfo{
+			VPCId:             adapterhelpers.PtrString("vpc-0d7892e00e573e701"), // link
+			SubnetIds:         []string{"subnet-0450a637af9984235"},              // link
+			SecurityGroupIds:  []string{"sg-0b8d4b5b5e1b5e1b5"},                  // link
+			AvailabilityZones: []string{"eu-west-2a"},
+		},
+		EncryptionAtRestOptions: &types.EncryptionAtRestOptions{
+			Enabled:  adapterhelpers.PtrBool(true),
+			KmsKeyId: adapterhelpers.PtrString("arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab"), // link
+		},
+		CognitoOptions: &types.CognitoOptions{
+			Enabled:        adapterhelpers.PtrBool(true),
+			UserPoolId:     adapterhelpers.PtrString("eu-west-2_AbCdEfGhI"),                                       // link
+			IdentityPoolId: adapterhelpers.PtrString("eu-west-2:12345678-1234-1234-1234-123456789012"),            // link
+			RoleArn:        adapterhelpers.PtrString("arn:aws:iam::052392120703:role/CognitoAccessForOpenSearch"), // link
+		},
+		DomainEndpointOptions: &types.DomainEndpointOptions{
+			EnforceHTTPS:                 adapterhelpers.PtrBool(true),
+			CustomEndpointEnabled:        adapterhelpers.PtrBool(true),
+			CustomEndpoint:               adapterhelpers.PtrString("search.example.com"),                                                                  // link
+			CustomEndpointCertificateArn: adapterhelpers.PtrString("arn:aws:acm:eu-west-2:052392120703:certificate/1b4c8d2e-1234-5678-9abc-def012345678"), // link
+		},
+		LogPublishingOptions: map[string]types.LogPublishingOption{
+			"SEARCH_SLOW_LOGS": {
+				Enabled:                   adapterhelpers.PtrBool(true),
+				CloudWatchLogsLogGroupArn: adapterhelpers.PtrString("arn:aws:logs:eu-west-2:052392120703:log-group:/aws/opensearch/search/slow:*"), // link
+			},
+		},
+	})
+
+	item, err := opensearchDomainItemMapper("", "052392120703.eu-west-2", domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if domain.AccessPolicy == nil {
+		t.Error("expected access policy to be parsed")
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "vpc-search-abcdefghijklmnop.eu-west-2.es.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "search.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:052392120703:certificate/1b4c8d2e-1234-5678-9abc-def012345678",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b8d4b5b5e1b5e1b5",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eu-west-2_AbCdEfGhI",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "cognito-identity-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eu-west-2:12345678-1234-1234-1234-123456789012",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::052392120703:role/CognitoAccessForOpenSearch",
+			ExpectedScope:  "052392120703",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:052392120703:log-group:/aws/opensearch/search/slow:*",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::052392120703:role/search-writer",
+			ExpectedScope:  "052392120703",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestOpenSearchDomainHealth(t *testing.T) {
+	domain := newOpenSearchDomain(&types.DomainStatus{
+		ARN:                    adapterhelpers.PtrString("arn:aws:es:eu-west-2:052392120703:domain/search"),
+		DomainName:             adapterhelpers.PtrString("search"),
+		DomainProcessingStatus: types.DomainProcessingStatusTypeActive,
+		ChangeProgressDetails: &types.ChangeProgressDetails{
+			ConfigChangeStatus: types.ConfigChangeStatusValidationFailed,
+		},
+	})
+
+	item, err := opensearchDomainItemMapper("", "052392120703.eu-west-2", domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+}
+
+func TestNewOpenSearchDomainAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := opensearch.NewFromConfig(config)
+
+	adapter := NewOpenSearchDomainAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,245 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func pipeGetFunc(ctx context.Context, client *pipes.Client, scope string, query string) (*pipes.DescribePipeOutput, error) {
+	return client.DescribePipe(ctx, &pipes.DescribePipeInput{
+		Name: &query,
+	})
+}
+
+// pipeListFunc The list doesn't include the parameters or log configuration,
+// so each pipe is described individually
+func pipeListFunc(ctx context.Context, client *pipes.Client, scope string) ([]*pipes.DescribePipeOutput, error) {
+	out := make([]*pipes.DescribePipeOutput, 0)
+
+	paginator := pipes.NewListPipesPaginator(client, &pipes.ListPipesInput{})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range page.Pipes {
+			if summary.Name == nil {
+				continue
+			}
+
+			pipe, err := pipeGetFunc(ctx, client, scope, *summary.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, pipe)
+		}
+	}
+
+	return out, nil
+}
+
+// pipeSourceLink Links to the source of a pipe. Most sources are handled by
+// eventsTargetLink, but DynamoDB sources are the ARN of the table's stream
+func pipeSourceLink(sourceARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(sourceARN)
+	if err != nil {
+		return nil
+	}
+
+	if a.Service == "dynamodb" {
+		// e.g. table/my-table/stream/2024-01-01T00:00:00.000
+		sections := strings.Split(a.Resource, "/")
+		if len(sections) < 2 {
+			return nil
+		}
+
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dynamodb-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  sections[1],
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the table are what the pipe reads
+				In: true,
+				// The pipe only reads the stream
+				Out: false,
+			},
+		}
+	}
+
+	return eventsTargetLink(sourceARN)
+}
+
+func pipeItemMapper(_, scope string, pipe *pipes.DescribePipeOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(pipe, "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "pipes-pipe",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            pipe.Tags,
+	}
+
+	switch pipe.CurrentState {
+	case types.PipeStateRunning:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.PipeStateStopped:
+		// A pipe that has been deliberately stopped is fine, but one that
+		// should be running isn't
+		if pipe.DesiredState == types.RequestedPipeStateDescribeResponseRunning {
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+	case types.PipeStateCreating, types.PipeStateUpdating, types.PipeStateDeleting, types.PipeStateStarting, types.PipeStateStopping:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.PipeStateCreateFailed, types.PipeStateUpdateFailed, types.PipeStateStartFailed, types.PipeStateStopFailed, types.PipeStateDeleteFailed, types.PipeStateCreateRollbackFailed, types.PipeStateDeleteRollbackFailed, types.PipeStateUpdateRollbackFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if pipe.Source != nil {
+		if link := pipeSourceLink(*pipe.Source); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if pipe.Enrichment != nil {
+		if link := eventsTargetLink(*pipe.Enrichment); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if pipe.Target != nil {
+		if link := eventsTargetLink(*pipe.Target); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if pipe.TargetParameters != nil && pipe.TargetParameters.EcsTaskParameters != nil && pipe.TargetParameters.EcsTaskParameters.TaskDefinitionArn != nil {
+		taskDefinitionARN := *pipe.TargetParameters.EcsTaskParameters.TaskDefinitionArn
+
+		if a, err := adapterhelpers.ParseARN(taskDefinitionARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ecs-task-definition",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  taskDefinitionARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the task definition changes what the pipe runs
+					In: true,
+					// The pipe runs tasks using the definition
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if pipe.RoleArn != nil {
+		if link := iamRoleLink(*pipe.RoleArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if pipe.KmsKeyIdentifier != nil {
+		if link := kmsKeyLink(*pipe.KmsKeyIdentifier, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if logs := pipe.LogConfiguration; logs != nil {
+		if logs.CloudwatchLogsLogDestination != nil && logs.CloudwatchLogsLogDestination.LogGroupArn != nil {
+			if link := logDestinationLink(*logs.CloudwatchLogsLogDestination.LogGroupArn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if logs.FirehoseLogDestination != nil && logs.FirehoseLogDestination.DeliveryStreamArn != nil {
+			if link := logDestinationLink(*logs.FirehoseLogDestination.DeliveryStreamArn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if logs.S3LogDestination != nil && logs.S3LogDestination.BucketName != nil {
+			var accountID string
+
+			if logs.S3LogDestination.BucketOwner != nil {
+				accountID = *logs.S3LogDestination.BucketOwner
+			} else if scopeAccountID, _, err := adapterhelpers.ParseScope(scope); err == nil {
+				accountID = scopeAccountID
+			}
+
+			if accountID != "" {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "s3-bucket",
+						Method: sdp.QueryMethod_GET,
+						Query:  *logs.S3LogDestination.BucketName,
+						Scope:  adapterhelpers.FormatScope(accountID, ""),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the bucket is deleted, logs will be lost
+						In: true,
+						// The pipe writes logs to the bucket
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewPipesPipeAdapter(client *pipes.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*pipes.DescribePipeOutput, *pipes.Client, *pipes.Options] {
+	return &adapterhelpers.GetListAdapter[*pipes.DescribePipeOutput, *pipes.Client, *pipes.Options]{
+		ItemType:        "pipes-pipe",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: pipeAdapterMetadata,
+		GetFunc:         pipeGetFunc,
+		ListFunc:        pipeListFunc,
+		ItemMapper:      pipeItemMapper,
+	}
+}
+
+var pipeAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "pipes-pipe",
+	DescriptiveName: "EventBridge Pipe",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a pipe by name",
+		ListDescription:   "List all pipes",
+		SearchDescription: "Search for a pipe by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_pipes_pipe.arn",
+		},
+	},
+	PotentialLinks: []string{"sqs-queue", "kinesis-stream", "dynamodb-table", "lambda-function", "sfn-state-machine", "sns-topic", "ecs-cluster", "ecs-task-definition", "firehose-delivery-stream", "logs-log-group", "events-event-bus", "iam-role", "kms-key", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var pipeAdapterIAMActions = IAMActions.Register(pipeAdapterMetadata,
+	"pipes:DescribePipe",
+	"pipes:ListPipes",
+)
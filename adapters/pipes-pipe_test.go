@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/pipes"
+	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestPipeItemMapper(t *testing.T) {
+	pipe := &pipes.DescribePipeOutput{
+		Arn:          adapterhelpers.PtrString("arn:aws:pipes:eu-west-2:123456789012:pipe/orders"),
+		CreationTime: adapterhelpers.PtrTime(time.Now()),
+		CurrentState: types.PipeStateStopped,
+		DesiredState: types.RequestedPipeStateDescribeResponseRunning,
+		Enrichment:   adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:enrich-order"),
+		Name:         adapterhelpers.PtrString("orders"),
+		RoleArn:      adapterhelpers.PtrString("arn:aws:iam::123456789012:role/orders-pipe"),
+		Source:       adapterhelpers.PtrString("arn:aws:dynamodb:eu-west-2:123456789012:table/orders/stream/2024-01-01T00:00:00.000"),
+		Target:       adapterhelpers.PtrString("arn:aws:events:eu-west-2:123456789012:event-bus/orders"),
+		LogConfiguration: &types.PipeLogConfiguration{
+			Level: types.LogLevelError,
+			CloudwatchLogsLogDestination: &types.CloudwatchLogsLogDestination{
+				LogGroupArn: adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:/aws/vendedlogs/pipes/orders"),
+			},
+			S3LogDestination: &types.S3LogDestination{
+				BucketName: adapterhelpers.PtrString("pipe-logs"),
+			},
+		},
+		Tags: map[string]string{
+			"Name": "orders",
+		},
+	}
+
+	item, err := pipeItemMapper("", "123456789012.eu-west-2", pipe)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Stopped but should be running
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["Name"] != "orders" {
+		t.Errorf("expected tag Name to be orders, got %v", item.GetTags()["Name"])
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:enrich-order",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "events-event-bus",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:events:eu-west-2:123456789012:event-bus/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/orders-pipe",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:123456789012:log-group:/aws/vendedlogs/pipes/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "pipe-logs",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewPipesPipeAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := pipes.NewFromConfig(config)
+
+	adapter := NewPipesPipeAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
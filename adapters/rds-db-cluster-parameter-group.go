@@ -127,3 +127,9 @@ var dbClusterParameterGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetad
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
+
+var dbClusterParameterGroupAdapterIAMActions = IAMActions.Register(dbClusterParameterGroupAdapterMetadata,
+	"rds:DescribeDBClusterParameterGroups",
+	"rds:DescribeDBClusterParameters",
+	"rds:ListTagsForResource",
+)
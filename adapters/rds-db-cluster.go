@@ -364,3 +364,8 @@ var dbClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"rds-db-subnet-group", "dns", "rds-db-cluster", "ec2-security-group", "route53-hosted-zone", "kms-key", "kinesis-stream", "rds-option-group", "secretsmanager-secret", "iam-role"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
+
+var dbClusterAdapterIAMActions = IAMActions.Register(dbClusterAdapterMetadata,
+	"rds:DescribeDBClusters",
+	"rds:ListTagsForResource",
+)
@@ -519,3 +519,8 @@ var dbInstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"dns", "route53-hosted-zone", "ec2-security-group", "rds-db-parameter-group", "rds-db-subnet-group", "rds-db-cluster", "kms-key", "logs-log-stream", "iam-role", "kinesis-stream", "backup-recovery-point", "iam-instance-profile", "rds-db-instance-automated-backup", "secretsmanager-secret"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
+
+var dbInstanceAdapterIAMActions = IAMActions.Register(dbInstanceAdapterMetadata,
+	"rds:DescribeDBInstances",
+	"rds:ListTagsForResource",
+)
@@ -122,3 +122,9 @@ var dbParameterGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
+
+var dbParameterGroupAdapterIAMActions = IAMActions.Register(dbParameterGroupAdapterMetadata,
+	"rds:DescribeDBParameterGroups",
+	"rds:DescribeDBParameters",
+	"rds:ListTagsForResource",
+)
@@ -150,3 +150,8 @@ var dbSubnetGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "outposts-outpost"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var dbSubnetGroupAdapterIAMActions = IAMActions.Register(dbSubnetGroupAdapterMetadata,
+	"rds:DescribeDBSubnetGroups",
+	"rds:ListTagsForResource",
+)
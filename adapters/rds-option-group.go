@@ -90,3 +90,8 @@ var optionGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
 })
+
+var optionGroupAdapterIAMActions = IAMActions.Register(optionGroupAdapterMetadata,
+	"rds:DescribeOptionGroups",
+	"rds:ListTagsForResource",
+)
@@ -0,0 +1,134 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type redshiftClusterParameterGroup struct {
+	*types.ClusterParameterGroup
+	Parameters []types.Parameter
+}
+
+// redshiftClusterParameterGroupEnrich Adds the parameters to the group
+func redshiftClusterParameterGroupEnrich(ctx context.Context, client *redshift.Client, group *types.ClusterParameterGroup) (*redshiftClusterParameterGroup, error) {
+	pg := redshiftClusterParameterGroup{
+		ClusterParameterGroup: group,
+		Parameters:            make([]types.Parameter, 0),
+	}
+
+	paginator := redshift.NewDescribeClusterParametersPaginator(client, &redshift.DescribeClusterParametersInput{
+		ParameterGroupName: group.ParameterGroupName,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		pg.Parameters = append(pg.Parameters, out.Parameters...)
+	}
+
+	return &pg, nil
+}
+
+func redshiftClusterParameterGroupGetFunc(ctx context.Context, client *redshift.Client, scope string, query string) (*redshiftClusterParameterGroup, error) {
+	out, err := client.DescribeClusterParameterGroups(ctx, &redshift.DescribeClusterParameterGroupsInput{
+		ParameterGroupName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.ParameterGroups) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "cluster parameter group " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return redshiftClusterParameterGroupEnrich(ctx, client, &out.ParameterGroups[0])
+}
+
+func redshiftClusterParameterGroupListFunc(ctx context.Context, client *redshift.Client, scope string) ([]*redshiftClusterParameterGroup, error) {
+	groups := make([]*redshiftClusterParameterGroup, 0)
+
+	paginator := redshift.NewDescribeClusterParameterGroupsPaginator(client, &redshift.DescribeClusterParameterGroupsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range out.ParameterGroups {
+			pg, err := redshiftClusterParameterGroupEnrich(ctx, client, &group)
+			if err != nil {
+				return nil, err
+			}
+
+			groups = append(groups, pg)
+		}
+	}
+
+	return groups, nil
+}
+
+func redshiftClusterParameterGroupItemMapper(_, scope string, group *redshiftClusterParameterGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(group, "Tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "redshift-cluster-parameter-group",
+		UniqueAttribute: "ParameterGroupName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            redshiftTagsToMap(group.Tags),
+	}
+
+	return &item, nil
+}
+
+func NewRedshiftClusterParameterGroupAdapter(client *redshift.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*redshiftClusterParameterGroup, *redshift.Client, *redshift.Options] {
+	return &adapterhelpers.GetListAdapter[*redshiftClusterParameterGroup, *redshift.Client, *redshift.Options]{
+		ItemType:        "redshift-cluster-parameter-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: redshiftClusterParameterGroupAdapterMetadata,
+		GetFunc:         redshiftClusterParameterGroupGetFunc,
+		ListFunc:        redshiftClusterParameterGroupListFunc,
+		ItemMapper:      redshiftClusterParameterGroupItemMapper,
+	}
+}
+
+var redshiftClusterParameterGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "redshift-cluster-parameter-group",
+	DescriptiveName: "Redshift Cluster Parameter Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a cluster parameter group by name",
+		ListDescription:   "List all cluster parameter groups",
+		SearchDescription: "Search for a cluster parameter group by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_redshift_parameter_group.name"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var redshiftClusterParameterGroupAdapterIAMActions = IAMActions.Register(redshiftClusterParameterGroupAdapterMetadata,
+	"redshift:DescribeClusterParameterGroups",
+	"redshift:DescribeClusterParameters",
+)
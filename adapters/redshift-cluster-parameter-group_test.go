@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func TestRedshiftClusterParameterGroupItemMapper(t *testing.T) {
+	group := redshiftClusterParameterGroup{
+		ClusterParameterGroup: &types.ClusterParameterGroup{
+			ParameterGroupName:   adapterhelpers.PtrString("warehouse-params"),
+			ParameterGroupFamily: adapterhelpers.PtrString("redshift-1.0"),
+			Description:          adapterhelpers.PtrString("Warehouse parameters"),
+			Tags: []types.Tag{
+				{
+					Key:   adapterhelpers.PtrString("team"),
+					Value: adapterhelpers.PtrString("data"),
+				},
+			},
+		},
+		Parameters: []types.Parameter{
+			{
+				ParameterName:  adapterhelpers.PtrString("require_ssl"),
+				ParameterValue: adapterhelpers.PtrString("true"),
+				Source:         adapterhelpers.PtrString("user"),
+			},
+		},
+	}
+
+	item, err := redshiftClusterParameterGroupItemMapper("", "052392120703.eu-west-2", &group)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["team"] != "data" {
+		t.Errorf("expected team tag to be data, got %v", item.GetTags()["team"])
+	}
+}
+
+func TestNewRedshiftClusterParameterGroupAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := redshift.NewFromConfig(config)
+
+	adapter := NewRedshiftClusterParameterGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func redshiftClusterSubnetGroupGetFunc(ctx context.Context, client *redshift.Client, scope string, query string) (*types.ClusterSubnetGroup, error) {
+	out, err := client.DescribeClusterSubnetGroups(ctx, &redshift.DescribeClusterSubnetGroupsInput{
+		ClusterSubnetGroupName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.ClusterSubnetGroups) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "cluster subnet group " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return &out.ClusterSubnetGroups[0], nil
+}
+
+func redshiftClusterSubnetGroupListFunc(ctx context.Context, client *redshift.Client, scope string) ([]*types.ClusterSubnetGroup, error) {
+	groups := make([]*types.ClusterSubnetGroup, 0)
+
+	paginator := redshift.NewDescribeClusterSubnetGroupsPaginator(client, &redshift.DescribeClusterSubnetGroupsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range out.ClusterSubnetGroups {
+			groups = append(groups, &group)
+		}
+	}
+
+	return groups, nil
+}
+
+func redshiftClusterSubnetGroupItemMapper(_, scope string, group *types.ClusterSubnetGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(group, "Tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "redshift-cluster-subnet-group",
+		UniqueAttribute: "ClusterSubnetGroupName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            redshiftTagsToMap(group.Tags),
+	}
+
+	if group.VpcId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  *group.VpcId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the VPC can affect the subnet group
+				In: true,
+				// The subnet group won't affect the VPC
+				Out: false,
+			},
+		})
+	}
+
+	for _, subnet := range group.Subnets {
+		if subnet.SubnetIdentifier != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  *subnet.SubnetIdentifier,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the subnet can affect the subnet group
+					In: true,
+					// The subnet group won't affect the subnet
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewRedshiftClusterSubnetGroupAdapter(client *redshift.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ClusterSubnetGroup, *redshift.Client, *redshift.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ClusterSubnetGroup, *redshift.Client, *redshift.Options]{
+		ItemType:        "redshift-cluster-subnet-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: redshiftClusterSubnetGroupAdapterMetadata,
+		GetFunc:         redshiftClusterSubnetGroupGetFunc,
+		ListFunc:        redshiftClusterSubnetGroupListFunc,
+		ItemMapper:      redshiftClusterSubnetGroupItemMapper,
+	}
+}
+
+var redshiftClusterSubnetGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "redshift-cluster-subnet-group",
+	DescriptiveName: "Redshift Cluster Subnet Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a cluster subnet group by name",
+		ListDescription:   "List all cluster subnet groups",
+		SearchDescription: "Search for a cluster subnet group by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_redshift_subnet_group.name"},
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
+
+var redshiftClusterSubnetGroupAdapterIAMActions = IAMActions.Register(redshiftClusterSubnetGroupAdapterMetadata,
+	"redshift:DescribeClusterSubnetGroups",
+)
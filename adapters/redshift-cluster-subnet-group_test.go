@@ -0,0 +1,70 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestRedshiftClusterSubnetGroupItemMapper(t *testing.T) {
+	group := types.ClusterSubnetGroup{
+		ClusterSubnetGroupName: adapterhelpers.PtrString("warehouse-subnets"),
+		Description:            adapterhelpers.PtrString("Private subnets"),
+		SubnetGroupStatus:      adapterhelpers.PtrString("Complete"),
+		VpcId:                  adapterhelpers.PtrString("vpc-0d7892e00e573e701"), // link
+		Subnets: []types.Subnet{
+			{
+				SubnetIdentifier: adapterhelpers.PtrString("subnet-0450a637af9984235"), // link
+				SubnetAvailabilityZone: &types.AvailabilityZone{
+					Name: adapterhelpers.PtrString("eu-west-2c"),
+				},
+				SubnetStatus: adapterhelpers.PtrString("Active"),
+			},
+		},
+	}
+
+	item, err := redshiftClusterSubnetGroupItemMapper("", "052392120703.eu-west-2", &group)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRedshiftClusterSubnetGroupAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := redshift.NewFromConfig(config)
+
+	adapter := NewRedshiftClusterSubnetGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,357 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// redshiftLoggingStatus Where audit logs for a cluster are sent
+type redshiftLoggingStatus struct {
+	LoggingEnabled     *bool
+	LogDestinationType types.LogDestinationType
+	LogExports         []string
+	BucketName         *string
+	S3KeyPrefix        *string
+	LastFailureMessage *string
+}
+
+type redshiftCluster struct {
+	*types.Cluster
+	LoggingStatus *redshiftLoggingStatus
+}
+
+// redshiftClusterEnrich Adds the logging status to the cluster. We still want
+// to return the cluster if we can't get it
+func redshiftClusterEnrich(ctx context.Context, client *redshift.Client, cluster *types.Cluster) *redshiftCluster {
+	c := redshiftCluster{
+		Cluster: cluster,
+	}
+
+	out, err := client.DescribeLoggingStatus(ctx, &redshift.DescribeLoggingStatusInput{
+		ClusterIdentifier: cluster.ClusterIdentifier,
+	})
+	if err == nil {
+		c.LoggingStatus = &redshiftLoggingStatus{
+			LoggingEnabled:     out.LoggingEnabled,
+			LogDestinationType: out.LogDestinationType,
+			LogExports:         out.LogExports,
+			BucketName:         out.BucketName,
+			S3KeyPrefix:        out.S3KeyPrefix,
+			LastFailureMessage: out.LastFailureMessage,
+		}
+	}
+
+	return &c
+}
+
+func redshiftClusterGetFunc(ctx context.Context, client *redshift.Client, scope string, query string) (*redshiftCluster, error) {
+	out, err := client.DescribeClusters(ctx, &redshift.DescribeClustersInput{
+		ClusterIdentifier: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Clusters) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "cluster " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return redshiftClusterEnrich(ctx, client, &out.Clusters[0]), nil
+}
+
+func redshiftClusterListFunc(ctx context.Context, client *redshift.Client, scope string) ([]*redshiftCluster, error) {
+	clusters := make([]*redshiftCluster, 0)
+
+	paginator := redshift.NewDescribeClustersPaginator(client, &redshift.DescribeClustersInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range out.Clusters {
+			clusters = append(clusters, redshiftClusterEnrich(ctx, client, &cluster))
+		}
+	}
+
+	return clusters, nil
+}
+
+func redshiftClusterItemMapper(_, scope string, cluster *redshiftCluster) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(cluster, "Tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "redshift-cluster",
+		UniqueAttribute: "ClusterIdentifier",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            redshiftTagsToMap(cluster.Tags),
+	}
+
+	if cluster.ClusterStatus != nil {
+		switch status := *cluster.ClusterStatus; {
+		case strings.HasPrefix(status, "available"):
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case status == "hardware-failure", status == "storage-full", strings.HasPrefix(status, "incompatible-"):
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		case status == "paused":
+			// Paused clusters can't be queried until they are resumed
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		default:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+	}
+
+	if cluster.Endpoint != nil {
+		if cluster.Endpoint.Address != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *cluster.Endpoint.Address,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS always links
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		for _, endpoint := range cluster.Endpoint.VpcEndpoints {
+			if endpoint.VpcEndpointId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-vpc-endpoint",
+						Method: sdp.QueryMethod_GET,
+						Query:  *endpoint.VpcEndpointId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Clients connect to the cluster through the endpoint
+						In: true,
+						// If the cluster is deleted, the endpoint won't work
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if cluster.CustomDomainName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *cluster.CustomDomainName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if cluster.CustomDomainCertificateArn != nil {
+		if a, err := adapterhelpers.ParseARN(*cluster.CustomDomainCertificateArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-certificate",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *cluster.CustomDomainCertificateArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the certificate expires, clients can't connect using
+					// the custom domain
+					In: true,
+					// The cluster won't affect the certificate
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if cluster.VpcId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  *cluster.VpcId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the VPC can affect the cluster
+				In: true,
+				// The cluster won't affect the VPC
+				Out: false,
+			},
+		})
+	}
+
+	for _, sg := range cluster.VpcSecurityGroups {
+		if sg.VpcSecurityGroupId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *sg.VpcSecurityGroupId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the rules can block access to the cluster
+					In: true,
+					// The cluster won't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if cluster.ClusterSubnetGroupName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "redshift-cluster-subnet-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *cluster.ClusterSubnetGroupName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The subnet group controls where the nodes are placed
+				In: true,
+				// The cluster won't affect the subnet group
+				Out: false,
+			},
+		})
+	}
+
+	for _, pg := range cluster.ClusterParameterGroups {
+		if pg.ParameterGroupName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "redshift-cluster-parameter-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *pg.ParameterGroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the parameters changes how the database
+					// behaves
+					In: true,
+					// The cluster won't affect the parameter group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	// The default role is also in the list of roles
+	for _, role := range cluster.IamRoles {
+		if role.IamRoleArn != nil {
+			if link := iamRoleLink(*role.IamRoleArn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	if cluster.KmsKeyId != nil {
+		if link := kmsKeyLink(*cluster.KmsKeyId, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if cluster.MasterPasswordSecretArn != nil {
+		if a, err := adapterhelpers.ParseARN(*cluster.MasterPasswordSecretArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "secretsmanager-secret",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *cluster.MasterPasswordSecretArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the secret changes the admin password
+					In: true,
+					// The cluster won't affect the secret
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if cluster.LoggingStatus != nil && cluster.LoggingStatus.BucketName != nil {
+		if accountID, _, err := adapterhelpers.ParseScope(scope); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.LoggingStatus.BucketName,
+					Scope:  adapterhelpers.FormatScope(accountID, ""),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the bucket is deleted or its policy changes, audit
+					// logs can't be delivered
+					In: true,
+					// The cluster writes audit logs to the bucket
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewRedshiftClusterAdapter(client *redshift.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*redshiftCluster, *redshift.Client, *redshift.Options] {
+	return &adapterhelpers.GetListAdapter[*redshiftCluster, *redshift.Client, *redshift.Options]{
+		ItemType:        "redshift-cluster",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: redshiftClusterAdapterMetadata,
+		GetFunc:         redshiftClusterGetFunc,
+		ListFunc:        redshiftClusterListFunc,
+		ItemMapper:      redshiftClusterItemMapper,
+	}
+}
+
+var redshiftClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "redshift-cluster",
+	DescriptiveName: "Redshift Cluster",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a cluster by identifier",
+		ListDescription:   "List all clusters",
+		SearchDescription: "Search for a cluster by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_redshift_cluster.cluster_identifier"},
+		{TerraformQueryMap: "aws_redshift_logging.cluster_identifier"},
+	},
+	PotentialLinks: []string{"dns", "ec2-vpc-endpoint", "acm-certificate", "ec2-vpc", "ec2-security-group", "redshift-cluster-subnet-group", "redshift-cluster-parameter-group", "iam-role", "kms-key", "secretsmanager-secret", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var redshiftClusterAdapterIAMActions = IAMActions.Register(redshiftClusterAdapterMetadata,
+	"redshift:DescribeClusters",
+	"redshift:DescribeLoggingStatus",
+)
@@ -0,0 +1,196 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestRedshiftClusterItemMapper(t *testing.T) {
+	cluster := redshiftCluster{
+		Cluster: &types.Cluster{
+			ClusterIdentifier:         adapterhelpers.PtrString("warehouse"),
+			ClusterNamespaceArn:       adapterhelpers.PtrString("arn:aws:redshift:eu-west-2:052392120703:namespace:1234abcd-12ab-34cd-56ef-1234567890ab"),
+			ClusterStatus:             adapterhelpers.PtrString("available"),
+			ClusterAvailabilityStatus: adapterhelpers.PtrString("Available"),
+			NodeType:                  adapterhelpers.PtrString("ra3.xlplus"),
+			NumberOfNodes:             adapterhelpers.PtrInt32(2),
+			DBName:                    adapterhelpers.PtrString("dev"),
+			MasterUsername:            adapterhelpers.PtrString("admin"),
+			Encrypted:                 adapterhelpers.PtrBool(true),
+			Endpoint: &types.Endpoint{
+				Address: adapterhelpers.PtrString("warehouse.abcdefghijkl.eu-west-2.redshift.amazonaws.com"), // link
+				Port:    adapterhelpers.PtrInt32(5439),
+				VpcEndpoints: []types.VpcEndpoint{
+					{
+						VpcEndpointId: adapterhelpers.PtrString("vpce-0123456789abcdef0"), // link
+						VpcId:         adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+					},
+				},
+			},
+			VpcId: adapterhelpers.PtrString("vpc-0d7892e00e573e701"), // link
+			VpcSecurityGroups: []types.VpcSecurityGroupMembership{
+				{
+					VpcSecurityGroupId: adapterhelpers.PtrString("sg-0b8d4b5b5e1b5e1b5"), // link
+					Status:             adapterhelpers.PtrString("active"),
+				},
+			},
+			ClusterSubnetGroupName: adapterhelpers.PtrString("warehouse-subnets"), // link
+			ClusterParameterGroups: []types.ClusterParameterGroupStatus{
+				{
+					ParameterGroupName:   adapterhelpers.PtrString("warehouse-params"), // link
+					ParameterApplyStatus: adapterhelpers.PtrString("in-sync"),
+				},
+			},
+			IamRoles: []types.ClusterIamRole{
+				{
+					IamRoleArn:  adapterhelpers.PtrString("arn:aws:iam::052392120703:role/redshift-s3"), // link
+					ApplyStatus: adapterhelpers.PtrString("in-sync"),
+				},
+			},
+			DefaultIamRoleArn:       adapterhelpers.PtrString("arn:aws:iam::052392120703:role/redshift-s3"),
+			KmsKeyId:                adapterhelpers.PtrString("arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab"),          // link
+			MasterPasswordSecretArn: adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-2:052392120703:secret:redshift!warehouse-admin-AbCdEf"), // link
+			Tags: []types.Tag{
+				{
+					Key:   adapterhelpers.PtrString("team"),
+					Value: adapterhelpers.PtrString("data"),
+				},
+			},
+		},
+		LoggingStatus: &redshiftLoggingStatus{
+			LoggingEnabled:     adapterhelpers.PtrBool(true),
+			LogDestinationType: types.LogDestinationTypeS3,
+			BucketName:         adapterhelpers.PtrString("warehouse-audit-logs"), // link
+			S3KeyPrefix:        adapterhelpers.PtrString("audit/"),
+		},
+	}
+
+	item, err := redshiftClusterItemMapper("", "052392120703.eu-west-2", &cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["team"] != "data" {
+		t.Errorf("expected team tag to be data, got %v", item.GetTags()["team"])
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "warehouse.abcdefghijkl.eu-west-2.redshift.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-vpc-endpoint",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpce-0123456789abcdef0",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b8d4b5b5e1b5e1b5",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "redshift-cluster-subnet-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "warehouse-subnets",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "redshift-cluster-parameter-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "warehouse-params",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::052392120703:role/redshift-s3",
+			ExpectedScope:  "052392120703",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:secretsmanager:eu-west-2:052392120703:secret:redshift!warehouse-admin-AbCdEf",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "warehouse-audit-logs",
+			ExpectedScope:  "052392120703",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestRedshiftClusterHealth(t *testing.T) {
+	tests := map[string]sdp.Health{
+		"available":                  sdp.Health_HEALTH_OK,
+		"available, prep-for-resize": sdp.Health_HEALTH_OK,
+		"resizing":                   sdp.Health_HEALTH_PENDING,
+		"incompatible-network":       sdp.Health_HEALTH_ERROR,
+		"storage-full":               sdp.Health_HEALTH_ERROR,
+		"paused":                     sdp.Health_HEALTH_WARNING,
+	}
+
+	for status, expected := range tests {
+		item, err := redshiftClusterItemMapper("", "052392120703.eu-west-2", &redshiftCluster{
+			Cluster: &types.Cluster{
+				ClusterIdentifier: adapterhelpers.PtrString("warehouse"),
+				ClusterStatus:     adapterhelpers.PtrString(status),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if item.GetHealth() != expected {
+			t.Errorf("expected health for %v to be %v, got %v", status, expected, item.GetHealth())
+		}
+	}
+}
+
+func TestNewRedshiftClusterAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := redshift.NewFromConfig(config)
+
+	adapter := NewRedshiftClusterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
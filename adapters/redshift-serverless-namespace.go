@@ -0,0 +1,206 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func redshiftServerlessNamespaceGetFunc(ctx context.Context, client *redshiftserverless.Client, scope string, query string) (*types.Namespace, error) {
+	out, err := client.GetNamespace(ctx, &redshiftserverless.GetNamespaceInput{
+		NamespaceName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Namespace == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "namespace " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return out.Namespace, nil
+}
+
+func redshiftServerlessNamespaceListFunc(ctx context.Context, client *redshiftserverless.Client, scope string) ([]*types.Namespace, error) {
+	namespaces := make([]*types.Namespace, 0)
+
+	paginator := redshiftserverless.NewListNamespacesPaginator(client, &redshiftserverless.ListNamespacesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, namespace := range out.Namespaces {
+			namespaces = append(namespaces, &namespace)
+		}
+	}
+
+	return namespaces, nil
+}
+
+// Namespace ARNs contain the namespace ID rather than the name, so we can't
+// get the namespace from the ARN and have to filter the full list instead
+func redshiftServerlessNamespaceSearchFunc(ctx context.Context, client *redshiftserverless.Client, scope string, query string) ([]*types.Namespace, error) {
+	namespaces, err := redshiftServerlessNamespaceListFunc(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*types.Namespace, 0)
+
+	for _, namespace := range namespaces {
+		if namespace.NamespaceArn != nil && *namespace.NamespaceArn == query {
+			matches = append(matches, namespace)
+		}
+	}
+
+	return matches, nil
+}
+
+func redshiftServerlessListTags(ctx context.Context, client *redshiftserverless.Client, arn *string) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &redshiftserverless.ListTagsForResourceInput{
+		ResourceArn: arn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+func redshiftServerlessNamespaceItemMapper(_, scope string, namespace *types.Namespace) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "redshift-serverless-namespace",
+		UniqueAttribute: "NamespaceName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch namespace.Status {
+	case types.NamespaceStatusAvailable:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.NamespaceStatusModifying, types.NamespaceStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if namespace.NamespaceName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "redshift-serverless-workgroup",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *namespace.NamespaceName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The workgroups provide compute for the namespace, but
+				// changing them doesn't affect the stored data
+				In: false,
+				// Deleting the namespace deletes the data the workgroups
+				// query
+				Out: true,
+			},
+		})
+	}
+
+	// The default role is also in the list of roles
+	for _, role := range namespace.IamRoles {
+		if roleARN := redshiftIAMRoleARN(role); roleARN != "" {
+			if link := iamRoleLink(roleARN); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	// Namespaces that don't use a customer managed key have a KMS key ID of
+	// AWS_OWNED_KMS_KEY
+	if namespace.KmsKeyId != nil && *namespace.KmsKeyId != "AWS_OWNED_KMS_KEY" {
+		if link := kmsKeyLink(*namespace.KmsKeyId, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if namespace.AdminPasswordSecretArn != nil {
+		if a, err := adapterhelpers.ParseARN(*namespace.AdminPasswordSecretArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "secretsmanager-secret",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *namespace.AdminPasswordSecretArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the secret changes the admin password
+					In: true,
+					// The namespace won't affect the secret
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewRedshiftServerlessNamespaceAdapter(client *redshiftserverless.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Namespace, *redshiftserverless.Client, *redshiftserverless.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Namespace, *redshiftserverless.Client, *redshiftserverless.Options]{
+		ItemType:        "redshift-serverless-namespace",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: redshiftServerlessNamespaceAdapterMetadata,
+		GetFunc:         redshiftServerlessNamespaceGetFunc,
+		ListFunc:        redshiftServerlessNamespaceListFunc,
+		SearchFunc:      redshiftServerlessNamespaceSearchFunc,
+		ListTagsFunc: func(ctx context.Context, namespace *types.Namespace, client *redshiftserverless.Client) (map[string]string, error) {
+			return redshiftServerlessListTags(ctx, client, namespace.NamespaceArn)
+		},
+		ItemMapper: redshiftServerlessNamespaceItemMapper,
+	}
+}
+
+var redshiftServerlessNamespaceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "redshift-serverless-namespace",
+	DescriptiveName: "Redshift Serverless Namespace",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a namespace by name",
+		ListDescription:   "List all namespaces",
+		SearchDescription: "Search for a namespace by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_redshiftserverless_namespace.namespace_name"},
+	},
+	PotentialLinks: []string{"redshift-serverless-workgroup", "iam-role", "kms-key", "secretsmanager-secret"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var redshiftServerlessNamespaceAdapterIAMActions = IAMActions.Register(redshiftServerlessNamespaceAdapterMetadata,
+	"redshift-serverless:GetNamespace",
+	"redshift-serverless:ListNamespaces",
+	"redshift-serverless:ListTagsForResource",
+)
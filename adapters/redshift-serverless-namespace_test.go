@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestRedshiftServerlessNamespaceItemMapper(t *testing.T) {
+	namespace := types.Namespace{
+		NamespaceArn:      adapterhelpers.PtrString("arn:aws:redshift-serverless:eu-west-2:052392120703:namespace/1234abcd-12ab-34cd-56ef-1234567890ab"),
+		NamespaceId:       adapterhelpers.PtrString("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		NamespaceName:     adapterhelpers.PtrString("analytics"), // link
+		DbName:            adapterhelpers.PtrString("dev"),
+		AdminUsername:     adapterhelpers.PtrString("admin"),
+		Status:            types.NamespaceStatusAvailable,
+		DefaultIamRoleArn: adapterhelpers.PtrString("arn:aws:iam::052392120703:role/redshift-s3"),
+		IamRoles: []string{
+			"IamRole(applyStatus=in-sync, iamRoleArn=arn:aws:iam::052392120703:role/redshift-s3)", // link
+		},
+		KmsKeyId:               adapterhelpers.PtrString("1234abcd-12ab-34cd-56ef-1234567890ab"),                                                 // link
+		AdminPasswordSecretArn: adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-2:052392120703:secret:redshift!analytics-admin-AbCdEf"), // link
+	}
+
+	item, err := redshiftServerlessNamespaceItemMapper("", "052392120703.eu-west-2", &namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "redshift-serverless-workgroup",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "analytics",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::052392120703:role/redshift-s3",
+			ExpectedScope:  "052392120703",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:secretsmanager:eu-west-2:052392120703:secret:redshift!analytics-admin-AbCdEf",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRedshiftServerlessNamespaceAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := redshiftserverless.NewFromConfig(config)
+
+	adapter := NewRedshiftServerlessNamespaceAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
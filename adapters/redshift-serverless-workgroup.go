@@ -0,0 +1,264 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func redshiftServerlessWorkgroupGetFunc(ctx context.Context, client *redshiftserverless.Client, scope string, query string) (*types.Workgroup, error) {
+	out, err := client.GetWorkgroup(ctx, &redshiftserverless.GetWorkgroupInput{
+		WorkgroupName: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Workgroup == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "workgroup " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return out.Workgroup, nil
+}
+
+func redshiftServerlessWorkgroupListFunc(ctx context.Context, client *redshiftserverless.Client, scope string) ([]*types.Workgroup, error) {
+	workgroups := make([]*types.Workgroup, 0)
+
+	paginator := redshiftserverless.NewListWorkgroupsPaginator(client, &redshiftserverless.ListWorkgroupsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, workgroup := range out.Workgroups {
+			workgroups = append(workgroups, &workgroup)
+		}
+	}
+
+	return workgroups, nil
+}
+
+// Workgroup ARNs contain the workgroup ID rather than the name, so we can't
+// get the workgroup from the ARN and have to filter the full list instead.
+// Workgroups can also be searched for by the name of their namespace
+func redshiftServerlessWorkgroupSearchFunc(ctx context.Context, client *redshiftserverless.Client, scope string, query string) ([]*types.Workgroup, error) {
+	workgroups, err := redshiftServerlessWorkgroupListFunc(ctx, client, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*types.Workgroup, 0)
+
+	for _, workgroup := range workgroups {
+		if strings.HasPrefix(query, "arn:") {
+			if workgroup.WorkgroupArn != nil && *workgroup.WorkgroupArn == query {
+				matches = append(matches, workgroup)
+			}
+		} else if workgroup.NamespaceName != nil && *workgroup.NamespaceName == query {
+			matches = append(matches, workgroup)
+		}
+	}
+
+	return matches, nil
+}
+
+func redshiftServerlessWorkgroupItemMapper(_, scope string, workgroup *types.Workgroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(workgroup)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "redshift-serverless-workgroup",
+		UniqueAttribute: "WorkgroupName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch workgroup.Status {
+	case types.WorkgroupStatusAvailable:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.WorkgroupStatusCreating, types.WorkgroupStatusModifying, types.WorkgroupStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if workgroup.NamespaceName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "redshift-serverless-namespace",
+				Method: sdp.QueryMethod_GET,
+				Query:  *workgroup.NamespaceName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The namespace holds the data that the workgroup queries
+				In: true,
+				// The workgroup doesn't affect the stored data
+				Out: false,
+			},
+		})
+	}
+
+	if workgroup.Endpoint != nil {
+		if workgroup.Endpoint.Address != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *workgroup.Endpoint.Address,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS always links
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		for _, endpoint := range workgroup.Endpoint.VpcEndpoints {
+			if endpoint.VpcEndpointId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-vpc-endpoint",
+						Method: sdp.QueryMethod_GET,
+						Query:  *endpoint.VpcEndpointId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Clients connect to the workgroup through the
+						// endpoint
+						In: true,
+						// If the workgroup is deleted, the endpoint won't
+						// work
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if workgroup.CustomDomainName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *workgroup.CustomDomainName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if workgroup.CustomDomainCertificateArn != nil {
+		if a, err := adapterhelpers.ParseARN(*workgroup.CustomDomainCertificateArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-certificate",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *workgroup.CustomDomainCertificateArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the certificate expires, clients can't connect using
+					// the custom domain
+					In: true,
+					// The workgroup won't affect the certificate
+					Out: false,
+				},
+			})
+		}
+	}
+
+	for _, subnetID := range workgroup.SubnetIds {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnetID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the subnet is deleted, the workgroup can't be reached
+				In: true,
+				// The workgroup won't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	for _, securityGroupID := range workgroup.SecurityGroupIds {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  securityGroupID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the rules can block access to the workgroup
+				In: true,
+				// The workgroup won't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewRedshiftServerlessWorkgroupAdapter(client *redshiftserverless.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Workgroup, *redshiftserverless.Client, *redshiftserverless.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Workgroup, *redshiftserverless.Client, *redshiftserverless.Options]{
+		ItemType:        "redshift-serverless-workgroup",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: redshiftServerlessWorkgroupAdapterMetadata,
+		GetFunc:         redshiftServerlessWorkgroupGetFunc,
+		ListFunc:        redshiftServerlessWorkgroupListFunc,
+		SearchFunc:      redshiftServerlessWorkgroupSearchFunc,
+		ListTagsFunc: func(ctx context.Context, workgroup *types.Workgroup, client *redshiftserverless.Client) (map[string]string, error) {
+			return redshiftServerlessListTags(ctx, client, workgroup.WorkgroupArn)
+		},
+		ItemMapper: redshiftServerlessWorkgroupItemMapper,
+	}
+}
+
+var redshiftServerlessWorkgroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "redshift-serverless-workgroup",
+	DescriptiveName: "Redshift Serverless Workgroup",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a workgroup by name",
+		ListDescription:   "List all workgroups",
+		SearchDescription: "Search for a workgroup by ARN, or for the workgroups of a namespace by namespace name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_redshiftserverless_workgroup.workgroup_name"},
+	},
+	PotentialLinks: []string{"redshift-serverless-namespace", "dns", "ec2-vpc-endpoint", "acm-certificate", "ec2-subnet", "ec2-security-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
+
+var redshiftServerlessWorkgroupAdapterIAMActions = IAMActions.Register(redshiftServerlessWorkgroupAdapterMetadata,
+	"redshift-serverless:GetWorkgroup",
+	"redshift-serverless:ListTagsForResource",
+	"redshift-serverless:ListWorkgroups",
+)
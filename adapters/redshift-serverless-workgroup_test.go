@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestRedshiftServerlessWorkgroupItemMapper(t *testing.T) {
+	workgroup := types.Workgroup{
+		WorkgroupArn:  adapterhelpers.PtrString("arn:aws:redshift-serverless:eu-west-2:052392120703:workgroup/abcd1234-12ab-34cd-56ef-1234567890ab"),
+		WorkgroupId:   adapterhelpers.PtrString("abcd1234-12ab-34cd-56ef-1234567890ab"),
+		WorkgroupName: adapterhelpers.PtrString("analytics"),
+		NamespaceName: adapterhelpers.PtrString("analytics"), // link
+		BaseCapacity:  adapterhelpers.PtrInt32(8),
+		Status:        types.WorkgroupStatusModifying,
+		Endpoint: &types.Endpoint{
+			Address: adapterhelpers.PtrString("analytics.052392120703.eu-west-2.redshift-serverless.amazonaws.com"), // link
+			Port:    adapterhelpers.PtrInt32(5439),
+			VpcEndpoints: []types.VpcEndpoint{
+				{
+					VpcEndpointId: adapterhelpers.PtrString("vpce-0123456789abcdef0"), // link
+					VpcId:         adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+				},
+			},
+		},
+		CustomDomainName:           adapterhelpers.PtrString("analytics.example.com"),                                                               // link
+		CustomDomainCertificateArn: adapterhelpers.PtrString("arn:aws:acm:eu-west-2:052392120703:certificate/1b4c8d2e-1234-5678-9abc-def012345678"), // link
+		SubnetIds: []string{
+			"subnet-0450a637af9984235", // link
+		},
+		SecurityGroupIds: []string{
+			"sg-0b8d4b5b5e1b5e1b5", // link
+		},
+	}
+
+	item, err := redshiftServerlessWorkgroupItemMapper("", "052392120703.eu-west-2", &workgroup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "redshift-serverless-namespace",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "analytics",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "analytics.052392120703.eu-west-2.redshift-serverless.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-vpc-endpoint",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpce-0123456789abcdef0",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "analytics.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:052392120703:certificate/1b4c8d2e-1234-5678-9abc-def012345678",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0450a637af9984235",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b8d4b5b5e1b5e1b5",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRedshiftServerlessWorkgroupAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := redshiftserverless.NewFromConfig(config)
+
+	adapter := NewRedshiftServerlessWorkgroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
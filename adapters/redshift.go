@@ -0,0 +1,41 @@
+package adapters
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+)
+
+func redshiftTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+// redshiftIAMRoleARN Extracts the role ARN from the IAM roles of a Redshift
+// Serverless namespace. The API returns these as strings in the format
+// "IamRole(applyStatus=in-sync, iamRoleArn=arn:aws:iam::123456789012:role/name)"
+// rather than just the ARN, so we handle both
+func redshiftIAMRoleARN(role string) string {
+	if strings.HasPrefix(role, "arn:") {
+		return role
+	}
+
+	_, after, found := strings.Cut(role, "iamRoleArn=")
+	if !found {
+		return ""
+	}
+
+	end := strings.IndexAny(after, ",)")
+	if end == -1 {
+		return strings.TrimSpace(after)
+	}
+
+	return strings.TrimSpace(after[:end])
+}
@@ -0,0 +1,18 @@
+package adapters
+
+import "testing"
+
+func TestRedshiftIAMRoleARN(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:iam::052392120703:role/redshift-s3":                                          "arn:aws:iam::052392120703:role/redshift-s3",
+		"IamRole(applyStatus=in-sync, iamRoleArn=arn:aws:iam::052392120703:role/redshift-s3)": "arn:aws:iam::052392120703:role/redshift-s3",
+		"IamRole(iamRoleArn=arn:aws:iam::052392120703:role/redshift-s3, applyStatus=in-sync)": "arn:aws:iam::052392120703:role/redshift-s3",
+		"IamRole(applyStatus=in-sync)":                                                        "",
+	}
+
+	for input, expected := range tests {
+		if got := redshiftIAMRoleARN(input); got != expected {
+			t.Errorf("expected %v for %v, got %v", expected, input, got)
+		}
+	}
+}
@@ -179,3 +179,10 @@ var healthCheckAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
 })
+
+var healthCheckAdapterIAMActions = IAMActions.Register(healthCheckAdapterMetadata,
+	"route53:GetHealthCheck",
+	"route53:GetHealthCheckStatus",
+	"route53:ListHealthChecks",
+	"route53:ListTagsForResource",
+)
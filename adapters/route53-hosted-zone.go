@@ -123,3 +123,9 @@ var hostedZoneAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
+
+var hostedZoneAdapterIAMActions = IAMActions.Register(hostedZoneAdapterMetadata,
+	"route53:GetHostedZone",
+	"route53:ListHostedZones",
+	"route53:ListTagsForResource",
+)
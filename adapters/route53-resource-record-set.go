@@ -192,3 +192,8 @@ var resourceRecordSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_route53_record.id", TerraformMethod: sdp.QueryMethod_SEARCH},
 	},
 })
+
+var resourceRecordSetAdapterIAMActions = IAMActions.Register(resourceRecordSetAdapterMetadata,
+	"route53:GetHostedZone",
+	"route53:ListResourceRecordSets",
+)
@@ -12,6 +12,7 @@ import (
 	"github.com/getsentry/sentry-go"
 
 	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/aws-source/metrics"
 	"github.com/overmindtech/sdp-go"
 	"github.com/overmindtech/sdpcache"
 )
@@ -66,6 +67,29 @@ var s3Metadata = Metadata.Register(&sdp.AdapterMetadata{
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
 
+var s3IAMActions = IAMActions.Register(s3Metadata,
+	"s3:GetAnalyticsConfiguration",
+	"s3:GetBucketAcl",
+	"s3:GetBucketCORS",
+	"s3:GetBucketLocation",
+	"s3:GetBucketLogging",
+	"s3:GetBucketNotification",
+	"s3:GetBucketOwnershipControls",
+	"s3:GetBucketPolicy",
+	"s3:GetBucketPolicyStatus",
+	"s3:GetBucketRequestPayment",
+	"s3:GetBucketTagging",
+	"s3:GetBucketVersioning",
+	"s3:GetBucketWebsite",
+	"s3:GetEncryptionConfiguration",
+	"s3:GetIntelligentTieringConfiguration",
+	"s3:GetInventoryConfiguration",
+	"s3:GetLifecycleConfiguration",
+	"s3:GetMetricsConfiguration",
+	"s3:GetReplicationConfiguration",
+	"s3:ListAllMyBuckets",
+)
+
 type S3Source struct {
 	// AWS Config including region and credentials
 	config aws.Config
@@ -203,11 +227,14 @@ func (s *S3Source) Get(ctx context.Context, scope string, query string, ignoreCa
 	}
 
 	s.ensureCache()
-	return getImpl(ctx, s.cache, s.Client(), scope, query, ignoreCache)
+	return adapterhelpers.ObserveGet(ctx, s.Type(), scope, func(ctx context.Context) (*sdp.Item, error) {
+		return getImpl(ctx, s.cache, s.Client(), scope, query, ignoreCache)
+	})
 }
 
 func getImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
 	cacheHit, ck, cachedItems, qErr := cache.Lookup(ctx, "aws-s3-adapter", sdp.QueryMethod_GET, scope, "s3-bucket", query, ignoreCache)
+	metrics.ObserveCacheLookup("s3-bucket", scope, cacheHit)
 	if qErr != nil {
 		return nil, qErr
 	}
@@ -603,11 +630,14 @@ func (s *S3Source) List(ctx context.Context, scope string, ignoreCache bool) ([]
 	}
 
 	s.ensureCache()
-	return listImpl(ctx, s.cache, s.Client(), scope, ignoreCache)
+	return adapterhelpers.ObserveItems(ctx, s.Type(), scope, sdp.QueryMethod_LIST, func(ctx context.Context) ([]*sdp.Item, error) {
+		return listImpl(ctx, s.cache, s.Client(), scope, ignoreCache)
+	})
 }
 
 func listImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope string, ignoreCache bool) ([]*sdp.Item, error) {
 	cacheHit, ck, cachedItems, qErr := cache.Lookup(ctx, "aws-s3-adapter", sdp.QueryMethod_LIST, scope, "s3-bucket", "", ignoreCache)
+	metrics.ObserveCacheLookup("s3-bucket", scope, cacheHit)
 	if qErr != nil {
 		return nil, qErr
 	}
@@ -656,7 +686,9 @@ func (s *S3Source) Search(ctx context.Context, scope string, query string, ignor
 	}
 
 	s.ensureCache()
-	return searchImpl(ctx, s.cache, s.Client(), scope, query, ignoreCache)
+	return adapterhelpers.ObserveItems(ctx, s.Type(), scope, sdp.QueryMethod_SEARCH, func(ctx context.Context) ([]*sdp.Item, error) {
+		return searchImpl(ctx, s.cache, s.Client(), scope, query, ignoreCache)
+	})
 }
 
 func searchImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope string, query string, ignoreCache bool) ([]*sdp.Item, error) {
@@ -0,0 +1,223 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// scheduleUniqueName Returns {groupName}/{scheduleName}, which is the same as
+// the resource ID from the schedule's ARN
+func scheduleUniqueName(groupName string, scheduleName string) string {
+	if groupName == "" {
+		groupName = "default"
+	}
+
+	return groupName + "/" + scheduleName
+}
+
+func scheduleGetFunc(ctx context.Context, client *scheduler.Client, scope string, query string) (*scheduler.GetScheduleOutput, error) {
+	groupName, scheduleName, found := strings.Cut(query, "/")
+	if !found {
+		// Schedules without a group are in the default group
+		groupName = "default"
+		scheduleName = query
+	}
+
+	return client.GetSchedule(ctx, &scheduler.GetScheduleInput{
+		Name:      &scheduleName,
+		GroupName: &groupName,
+	})
+}
+
+// schedulesList The list doesn't include the full target, so each schedule is
+// fetched individually
+func schedulesList(ctx context.Context, client *scheduler.Client, scope string, input *scheduler.ListSchedulesInput) ([]*scheduler.GetScheduleOutput, error) {
+	schedules := make([]*scheduler.GetScheduleOutput, 0)
+
+	paginator := scheduler.NewListSchedulesPaginator(client, input)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.Schedules {
+			if summary.Name == nil || summary.GroupName == nil {
+				continue
+			}
+
+			schedule, err := scheduleGetFunc(ctx, client, scope, scheduleUniqueName(*summary.GroupName, *summary.Name))
+			if err != nil {
+				return nil, err
+			}
+
+			schedules = append(schedules, schedule)
+		}
+	}
+
+	return schedules, nil
+}
+
+func scheduleListFunc(ctx context.Context, client *scheduler.Client, scope string) ([]*scheduler.GetScheduleOutput, error) {
+	return schedulesList(ctx, client, scope, &scheduler.ListSchedulesInput{})
+}
+
+// scheduleSearchFunc Supports searching by the ARN of a schedule, or by the
+// name or ARN of a schedule group to return all schedules in that group
+func scheduleSearchFunc(ctx context.Context, client *scheduler.Client, scope string, query string) ([]*scheduler.GetScheduleOutput, error) {
+	groupName := query
+
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		switch a.Type() {
+		case "schedule":
+			schedule, err := scheduleGetFunc(ctx, client, scope, a.ResourceID())
+			if err != nil {
+				return nil, err
+			}
+
+			return []*scheduler.GetScheduleOutput{schedule}, nil
+		case "schedule-group":
+			groupName = a.ResourceID()
+		default:
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOTFOUND,
+				ErrorString: "unsupported ARN type for scheduler-schedule search: " + a.Type(),
+				Scope:       scope,
+			}
+		}
+	}
+
+	return schedulesList(ctx, client, scope, &scheduler.ListSchedulesInput{
+		GroupName: &groupName,
+	})
+}
+
+func scheduleItemMapper(_, scope string, schedule *scheduler.GetScheduleOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupName, scheduleName string
+	if schedule.GroupName != nil {
+		groupName = *schedule.GroupName
+	}
+	if schedule.Name != nil {
+		scheduleName = *schedule.Name
+	}
+
+	err = attributes.Set("UniqueName", scheduleUniqueName(groupName, scheduleName))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "scheduler-schedule",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// Disabling a schedule is deliberate, so disabled schedules don't report
+	// a health
+	if schedule.State == types.ScheduleStateEnabled {
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	if target := schedule.Target; target != nil {
+		if target.Arn != nil {
+			// Universal targets (arn:aws:scheduler:::aws-sdk:...) call an
+			// API rather than a resource so won't be linked
+			if link := eventsTargetLink(*target.Arn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if target.RoleArn != nil {
+			if link := iamRoleLink(*target.RoleArn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if target.DeadLetterConfig != nil && target.DeadLetterConfig.Arn != nil {
+			if link := eventsDeadLetterQueueLink(*target.DeadLetterConfig.Arn); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if target.EcsParameters != nil && target.EcsParameters.TaskDefinitionArn != nil {
+			if a, err := adapterhelpers.ParseARN(*target.EcsParameters.TaskDefinitionArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ecs-task-definition",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *target.EcsParameters.TaskDefinitionArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the task definition changes what the
+						// schedule runs
+						In: true,
+						// The schedule runs tasks using the definition
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if schedule.KmsKeyArn != nil {
+		if link := kmsKeyLink(*schedule.KmsKeyArn, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSchedulerScheduleAdapter(client *scheduler.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*scheduler.GetScheduleOutput, *scheduler.Client, *scheduler.Options] {
+	return &adapterhelpers.GetListAdapter[*scheduler.GetScheduleOutput, *scheduler.Client, *scheduler.Options]{
+		ItemType:        "scheduler-schedule",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: scheduleAdapterMetadata,
+		GetFunc:         scheduleGetFunc,
+		ListFunc:        scheduleListFunc,
+		SearchFunc:      scheduleSearchFunc,
+		ItemMapper:      scheduleItemMapper,
+	}
+}
+
+var scheduleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "scheduler-schedule",
+	DescriptiveName: "EventBridge Scheduler Schedule",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a schedule by {groupName}/{scheduleName}, or by name for schedules in the default group",
+		ListDescription:   "List all schedules",
+		SearchDescription: "Search for a schedule by ARN, or for all schedules in a group by the group name or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_scheduler_schedule.arn",
+		},
+	},
+	PotentialLinks: []string{"lambda-function", "sqs-queue", "sns-topic", "sfn-state-machine", "ecs-cluster", "ecs-task-definition", "kinesis-stream", "firehose-delivery-stream", "events-event-bus", "iam-role", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
+
+var scheduleAdapterIAMActions = IAMActions.Register(scheduleAdapterMetadata,
+	"scheduler:GetSchedule",
+	"scheduler:ListSchedules",
+)
@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestScheduleItemMapper(t *testing.T) {
+	schedule := &scheduler.GetScheduleOutput{
+		Arn:                adapterhelpers.PtrString("arn:aws:scheduler:eu-west-2:123456789012:schedule/reports/nightly"),
+		CreationDate:       adapterhelpers.PtrTime(time.Now()),
+		GroupName:          adapterhelpers.PtrString("reports"),
+		KmsKeyArn:          adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/12345678-1234-1234-1234-123456789012"),
+		Name:               adapterhelpers.PtrString("nightly"),
+		ScheduleExpression: adapterhelpers.PtrString("cron(0 2 * * ? *)"),
+		State:              types.ScheduleStateEnabled,
+		FlexibleTimeWindow: &types.FlexibleTimeWindow{
+			Mode: types.FlexibleTimeWindowModeOff,
+		},
+		Target: &types.Target{
+			Arn:     adapterhelpers.PtrString("arn:aws:states:eu-west-2:123456789012:stateMachine:nightly-report"),
+			RoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/scheduler"),
+			DeadLetterConfig: &types.DeadLetterConfig{
+				Arn: adapterhelpers.PtrString("arn:aws:sqs:eu-west-2:123456789012:scheduler-dlq"),
+			},
+		},
+	}
+
+	item, err := scheduleItemMapper("", "123456789012.eu-west-2", schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.UniqueAttributeValue() != "reports/nightly" {
+		t.Errorf("expected unique attribute value to be reports/nightly, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "sfn-state-machine",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:states:eu-west-2:123456789012:stateMachine:nightly-report",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/scheduler",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:scheduler-dlq",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSchedulerScheduleAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := scheduler.NewFromConfig(config)
+
+	adapter := NewSchedulerScheduleAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
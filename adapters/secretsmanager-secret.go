@@ -0,0 +1,300 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// secretRotationMaxAge How long a secret can go without being rotated before
+// it is flagged as unhealthy, when it doesn't have a rotation schedule of its
+// own
+const secretRotationMaxAge = 90 * 24 * time.Hour
+
+// secretRotationGracePeriod How long after a rotation is due before we
+// consider it overdue. Rotations run within a window so aren't exact
+const secretRotationGracePeriod = 24 * time.Hour
+
+type secretClient interface {
+	DescribeSecret(ctx context.Context, params *secretsmanager.DescribeSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DescribeSecretOutput, error)
+	GetResourcePolicy(ctx context.Context, params *secretsmanager.GetResourcePolicyInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetResourcePolicyOutput, error)
+	ListSecrets(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error)
+}
+
+// secretARNFromQuery Strips the extra fields that ECS adds to secret ARNs
+// when referencing a single key within a secret, e.g.
+// `arn:aws:secretsmanager:region:account:secret:name-AbCdEf:key:stage:version`
+func secretARNFromQuery(query string) string {
+	sections := strings.Split(query, ":")
+
+	if len(sections) > 7 {
+		return strings.Join(sections[:7], ":")
+	}
+
+	return query
+}
+
+// secretRotationOverdue Returns true if the secret hasn't been rotated as
+// recently as it should have been
+func secretRotationOverdue(secret *secretsmanager.DescribeSecretOutput, now time.Time) bool {
+	rotationEnabled := secret.RotationEnabled != nil && *secret.RotationEnabled
+
+	if rotationEnabled && secret.NextRotationDate != nil {
+		return now.After(secret.NextRotationDate.Add(secretRotationGracePeriod))
+	}
+
+	lastRotated := secret.LastRotatedDate
+	if lastRotated == nil {
+		// If the secret has never been rotated then its age is the time since
+		// it was created
+		lastRotated = secret.CreatedDate
+	}
+
+	if lastRotated == nil {
+		return false
+	}
+
+	maxAge := secretRotationMaxAge
+	if rotationEnabled && secret.RotationRules != nil && secret.RotationRules.AutomaticallyAfterDays != nil {
+		maxAge = time.Duration(*secret.RotationRules.AutomaticallyAfterDays)*24*time.Hour + secretRotationGracePeriod
+	}
+
+	return now.Sub(*lastRotated) > maxAge
+}
+
+func secretsManagerTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+func getSecretFunc(ctx context.Context, client secretClient, scope string, input *secretsmanager.DescribeSecretInput) (*sdp.Item, error) {
+	// Note that we never call GetSecretValue, we only ever want the metadata
+	secret, err := client.DescribeSecret(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret.Name == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "describe secret response was nil",
+		}
+	}
+
+	type secretWithPolicy struct {
+		*secretsmanager.DescribeSecretOutput
+		ResourcePolicy *policy.Policy
+	}
+
+	details := secretWithPolicy{
+		DescribeSecretOutput: secret,
+	}
+
+	// If we can't get the policy we still want to return the secret
+	policyOut, err := client.GetResourcePolicy(ctx, &secretsmanager.GetResourcePolicyInput{
+		SecretId: input.SecretId,
+	})
+	if err == nil && policyOut.ResourcePolicy != nil {
+		details.ResourcePolicy, _ = ParsePolicyDocument(*policyOut.ResourcePolicy)
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(details, "resultMetadata", "tags")
+	if err != nil {
+		return nil, err
+	}
+
+	item := &sdp.Item{
+		Type:            "secretsmanager-secret",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            secretsManagerTagsToMap(secret.Tags),
+	}
+
+	switch {
+	case secret.DeletedDate != nil:
+		// The secret is scheduled for deletion and its value can't be
+		// retrieved in the meantime
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case secretRotationOverdue(secret, time.Now()):
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	default:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	if details.ResourcePolicy != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(details.ResourcePolicy)...)
+	}
+
+	if secret.KmsKeyId != nil {
+		if link := kmsKeyLink(*secret.KmsKeyId, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if secret.RotationLambdaARN != nil {
+		if a, err := adapterhelpers.ParseARN(*secret.RotationLambdaARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *secret.RotationLambdaARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the rotation function breaks, the secret won't be
+					// rotated
+					In: true,
+					// The function is invoked with the secret, so changes to
+					// the secret could break it
+					Out: true,
+				},
+			})
+		}
+	}
+
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replica := range secret.ReplicationStatus {
+		if replica.Status == types.StatusTypeFailed {
+			// The replica isn't in sync with the primary
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		if replica.Region == nil {
+			continue
+		}
+
+		replicaScope := adapterhelpers.FormatScope(accountID, *replica.Region)
+
+		// Replicas have the same name as the primary secret
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "secretsmanager-secret",
+				Method: sdp.QueryMethod_GET,
+				Query:  *secret.Name,
+				Scope:  replicaScope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Replicas don't affect the primary
+				In: false,
+				// Changes to the primary are replicated
+				Out: true,
+			},
+		})
+
+		if replica.KmsKeyId != nil {
+			if link := kmsKeyLink(*replica.KmsKeyId, replicaScope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	if secret.PrimaryRegion != nil && secret.ReplicationStatus == nil {
+		// This is a replica, so link back to the primary
+		primaryScope := adapterhelpers.FormatScope(accountID, *secret.PrimaryRegion)
+
+		if primaryScope != scope {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "secretsmanager-secret",
+					Method: sdp.QueryMethod_GET,
+					Query:  *secret.Name,
+					Scope:  primaryScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the primary are replicated
+					In: true,
+					// Replicas don't affect the primary
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return item, nil
+}
+
+func NewSecretsManagerSecretAdapter(client secretClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*secretsmanager.ListSecretsInput, *secretsmanager.ListSecretsOutput, *secretsmanager.DescribeSecretInput, *secretsmanager.DescribeSecretOutput, secretClient, *secretsmanager.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*secretsmanager.ListSecretsInput, *secretsmanager.ListSecretsOutput, *secretsmanager.DescribeSecretInput, *secretsmanager.DescribeSecretOutput, secretClient, *secretsmanager.Options]{
+		ItemType:        "secretsmanager-secret",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		ListInput:       &secretsmanager.ListSecretsInput{},
+		AdapterMetadata: secretsManagerSecretAdapterMetadata,
+		GetInputMapper: func(scope, query string) *secretsmanager.DescribeSecretInput {
+			return &secretsmanager.DescribeSecretInput{
+				SecretId: &query,
+			}
+		},
+		SearchGetInputMapper: func(scope, query string) (*secretsmanager.DescribeSecretInput, error) {
+			// The ARN itself isn't a valid name so we need to look the secret
+			// up using the full ARN
+			if _, err := adapterhelpers.ParseARN(query); err != nil {
+				return nil, err
+			}
+
+			return &secretsmanager.DescribeSecretInput{
+				SecretId: adapterhelpers.PtrString(secretARNFromQuery(query)),
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client secretClient, input *secretsmanager.ListSecretsInput) adapterhelpers.Paginator[*secretsmanager.ListSecretsOutput, *secretsmanager.Options] {
+			return secretsmanager.NewListSecretsPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *secretsmanager.ListSecretsOutput, input *secretsmanager.ListSecretsInput) ([]*secretsmanager.DescribeSecretInput, error) {
+			var inputs []*secretsmanager.DescribeSecretInput
+			for _, secret := range output.SecretList {
+				inputs = append(inputs, &secretsmanager.DescribeSecretInput{
+					SecretId: secret.Name,
+				})
+			}
+			return inputs, nil
+		},
+		GetFunc: getSecretFunc,
+	}
+}
+
+var secretsManagerSecretAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "secretsmanager-secret",
+	DescriptiveName: "Secrets Manager Secret",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a secret by name or ARN",
+		ListDescription:   "List all secrets",
+		SearchDescription: "Search for a secret by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_secretsmanager_secret.arn",
+		},
+	},
+	PotentialLinks: []string{"kms-key", "lambda-function", "secretsmanager-secret", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var secretsManagerSecretAdapterIAMActions = IAMActions.Register(secretsManagerSecretAdapterMetadata,
+	"secretsmanager:DescribeSecret",
+	"secretsmanager:GetResourcePolicy",
+	"secretsmanager:ListSecrets",
+)
@@ -0,0 +1,190 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type testSecretClient struct{}
+
+func (t testSecretClient) DescribeSecret(ctx context.Context, params *secretsmanager.DescribeSecretInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.DescribeSecretOutput, error) {
+	return &secretsmanager.DescribeSecretOutput{
+		ARN:               adapterhelpers.PtrString("arn:aws:secretsmanager:us-east-1:123456789012:secret:my-secret-AbCdEf"),
+		Name:              adapterhelpers.PtrString("my-secret"),
+		Description:       adapterhelpers.PtrString("My secret"),
+		KmsKeyId:          adapterhelpers.PtrString("arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+		CreatedDate:       adapterhelpers.PtrTime(time.Now().Add(-200 * 24 * time.Hour)),
+		LastChangedDate:   adapterhelpers.PtrTime(time.Now().Add(-10 * 24 * time.Hour)),
+		LastRotatedDate:   adapterhelpers.PtrTime(time.Now().Add(-10 * 24 * time.Hour)),
+		NextRotationDate:  adapterhelpers.PtrTime(time.Now().Add(20 * 24 * time.Hour)),
+		RotationEnabled:   adapterhelpers.PtrBool(true),
+		RotationLambdaARN: adapterhelpers.PtrString("arn:aws:lambda:us-east-1:123456789012:function:rotate-my-secret"),
+		RotationRules: &types.RotationRulesType{
+			AutomaticallyAfterDays: adapterhelpers.PtrInt64(30),
+		},
+		PrimaryRegion: adapterhelpers.PtrString("us-east-1"),
+		ReplicationStatus: []types.ReplicationStatusType{
+			{
+				Region:   adapterhelpers.PtrString("eu-west-1"),
+				KmsKeyId: adapterhelpers.PtrString("arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				Status:   types.StatusTypeInSync,
+			},
+		},
+		Tags: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("Name"),
+				Value: adapterhelpers.PtrString("my-secret"),
+			},
+		},
+	}, nil
+}
+
+func (t testSecretClient) GetResourcePolicy(ctx context.Context, params *secretsmanager.GetResourcePolicyInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetResourcePolicyOutput, error) {
+	return &secretsmanager.GetResourcePolicyOutput{
+		ARN:            adapterhelpers.PtrString("arn:aws:secretsmanager:us-east-1:123456789012:secret:my-secret-AbCdEf"),
+		Name:           adapterhelpers.PtrString("my-secret"),
+		ResourcePolicy: adapterhelpers.PtrString(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:role/my-app"},"Action":"secretsmanager:GetSecretValue","Resource":"*"}]}`),
+	}, nil
+}
+
+func (t testSecretClient) ListSecrets(ctx context.Context, params *secretsmanager.ListSecretsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
+	return &secretsmanager.ListSecretsOutput{
+		SecretList: []types.SecretListEntry{
+			{
+				Name: adapterhelpers.PtrString("my-secret"),
+			},
+		},
+	}, nil
+}
+
+func TestGetSecretFunc(t *testing.T) {
+	item, err := getSecretFunc(context.Background(), testSecretClient{}, "123456789012.us-east-1", &secretsmanager.DescribeSecretInput{
+		SecretId: adapterhelpers.PtrString("my-secret"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:us-east-1:123456789012:function:rotate-my-secret",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "my-secret",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/my-app",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSecretRotationOverdue(t *testing.T) {
+	now := time.Now()
+
+	t.Run("rotation due in the future", func(t *testing.T) {
+		secret := &secretsmanager.DescribeSecretOutput{
+			RotationEnabled:  adapterhelpers.PtrBool(true),
+			NextRotationDate: adapterhelpers.PtrTime(now.Add(24 * time.Hour)),
+		}
+
+		if secretRotationOverdue(secret, now) {
+			t.Error("expected rotation not to be overdue")
+		}
+	})
+
+	t.Run("rotation missed", func(t *testing.T) {
+		secret := &secretsmanager.DescribeSecretOutput{
+			RotationEnabled:  adapterhelpers.PtrBool(true),
+			NextRotationDate: adapterhelpers.PtrTime(now.Add(-72 * time.Hour)),
+		}
+
+		if !secretRotationOverdue(secret, now) {
+			t.Error("expected rotation to be overdue")
+		}
+	})
+
+	t.Run("rotation disabled and never rotated", func(t *testing.T) {
+		secret := &secretsmanager.DescribeSecretOutput{
+			CreatedDate: adapterhelpers.PtrTime(now.Add(-100 * 24 * time.Hour)),
+		}
+
+		if !secretRotationOverdue(secret, now) {
+			t.Error("expected rotation to be overdue")
+		}
+	})
+
+	t.Run("rotation disabled and recently created", func(t *testing.T) {
+		secret := &secretsmanager.DescribeSecretOutput{
+			CreatedDate: adapterhelpers.PtrTime(now.Add(-10 * 24 * time.Hour)),
+		}
+
+		if secretRotationOverdue(secret, now) {
+			t.Error("expected rotation not to be overdue")
+		}
+	})
+}
+
+func TestSecretARNFromQuery(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:secretsmanager:us-east-1:123456789012:secret:my-secret-AbCdEf":                      "arn:aws:secretsmanager:us-east-1:123456789012:secret:my-secret-AbCdEf",
+		"arn:aws:secretsmanager:us-east-1:123456789012:secret:my-secret-AbCdEf:username::":           "arn:aws:secretsmanager:us-east-1:123456789012:secret:my-secret-AbCdEf",
+		"arn:aws:secretsmanager:us-east-1:123456789012:secret:my-secret-AbCdEf:password:AWSCURRENT:": "arn:aws:secretsmanager:us-east-1:123456789012:secret:my-secret-AbCdEf",
+	}
+
+	for query, expected := range tests {
+		if actual := secretARNFromQuery(query); actual != expected {
+			t.Errorf("expected %v, got %v", expected, actual)
+		}
+	}
+}
+
+func TestNewSecretsManagerSecretAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := secretsmanager.NewFromConfig(config)
+
+	adapter := NewSecretsManagerSecretAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func sfnActivityGetFunc(ctx context.Context, client *sfn.Client, scope string, query string) (*sfn.DescribeActivityOutput, error) {
+	return client.DescribeActivity(ctx, &sfn.DescribeActivityInput{
+		ActivityArn: &query,
+	})
+}
+
+func sfnActivityListFunc(ctx context.Context, client *sfn.Client, scope string) ([]*sfn.DescribeActivityOutput, error) {
+	activities := make([]*sfn.DescribeActivityOutput, 0)
+
+	paginator := sfn.NewListActivitiesPaginator(client, &sfn.ListActivitiesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, activity := range out.Activities {
+			if activity.ActivityArn == nil {
+				continue
+			}
+
+			described, err := sfnActivityGetFunc(ctx, client, scope, *activity.ActivityArn)
+			if err != nil {
+				return nil, err
+			}
+
+			activities = append(activities, described)
+		}
+	}
+
+	return activities, nil
+}
+
+// sfnActivitySearchFunc Activities are already queried by ARN, so searching by
+// ARN returns the activity directly
+func sfnActivitySearchFunc(ctx context.Context, client *sfn.Client, scope string, query string) ([]*sfn.DescribeActivityOutput, error) {
+	activity, err := sfnActivityGetFunc(ctx, client, scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*sfn.DescribeActivityOutput{activity}, nil
+}
+
+func sfnActivityItemMapper(_, scope string, activity *sfn.DescribeActivityOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(activity, "ResultMetadata")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "sfn-activity",
+		UniqueAttribute: "ActivityArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if activity.EncryptionConfiguration != nil && activity.EncryptionConfiguration.KmsKeyId != nil {
+		if link := kmsKeyLink(*activity.EncryptionConfiguration.KmsKeyId, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSFNActivityAdapter(client *sfn.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*sfn.DescribeActivityOutput, *sfn.Client, *sfn.Options] {
+	return &adapterhelpers.GetListAdapter[*sfn.DescribeActivityOutput, *sfn.Client, *sfn.Options]{
+		ItemType:        "sfn-activity",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sfnActivityAdapterMetadata,
+		GetFunc:         sfnActivityGetFunc,
+		ListFunc:        sfnActivityListFunc,
+		SearchFunc:      sfnActivitySearchFunc,
+		ListTagsFunc: func(ctx context.Context, activity *sfn.DescribeActivityOutput, client *sfn.Client) (map[string]string, error) {
+			return sfnTags(ctx, client, activity.ActivityArn)
+		},
+		ItemMapper: sfnActivityItemMapper,
+	}
+}
+
+var sfnActivityAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "sfn-activity",
+	DescriptiveName: "Step Functions Activity",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an activity by ARN",
+		ListDescription:   "List all activities",
+		SearchDescription: "Search for an activity by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_sfn_activity.id",
+		},
+	},
+	PotentialLinks: []string{"kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var sfnActivityAdapterIAMActions = IAMActions.Register(sfnActivityAdapterMetadata,
+	"states:DescribeActivity",
+	"states:ListActivities",
+	"states:ListTagsForResource",
+)
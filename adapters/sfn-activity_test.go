@@ -0,0 +1,58 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSFNActivityItemMapper(t *testing.T) {
+	activity := sfn.DescribeActivityOutput{
+		ActivityArn:  adapterhelpers.PtrString("arn:aws:states:eu-west-2:052392120703:activity:approve"),
+		Name:         adapterhelpers.PtrString("approve"),
+		CreationDate: adapterhelpers.PtrTime(time.Now()),
+		EncryptionConfiguration: &types.EncryptionConfiguration{
+			Type:     types.EncryptionTypeCustomerManagedKmsKey,
+			KmsKeyId: adapterhelpers.PtrString("1234abcd-12ab-34cd-56ef-1234567890ab"), // link
+		},
+	}
+
+	item, err := sfnActivityItemMapper("", "052392120703.eu-west-2", &activity)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSFNActivityAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := sfn.NewFromConfig(config)
+
+	adapter := NewSFNActivityAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
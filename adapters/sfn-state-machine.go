@@ -0,0 +1,174 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func sfnStateMachineGetFunc(ctx context.Context, client *sfn.Client, scope string, query string) (*sfn.DescribeStateMachineOutput, error) {
+	return client.DescribeStateMachine(ctx, &sfn.DescribeStateMachineInput{
+		StateMachineArn: &query,
+	})
+}
+
+func sfnStateMachineListFunc(ctx context.Context, client *sfn.Client, scope string) ([]*sfn.DescribeStateMachineOutput, error) {
+	stateMachines := make([]*sfn.DescribeStateMachineOutput, 0)
+
+	paginator := sfn.NewListStateMachinesPaginator(client, &sfn.ListStateMachinesInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stateMachine := range out.StateMachines {
+			if stateMachine.StateMachineArn == nil {
+				continue
+			}
+
+			described, err := sfnStateMachineGetFunc(ctx, client, scope, *stateMachine.StateMachineArn)
+			if err != nil {
+				return nil, err
+			}
+
+			stateMachines = append(stateMachines, described)
+		}
+	}
+
+	return stateMachines, nil
+}
+
+// sfnStateMachineSearchFunc State machines are already queried by ARN, so
+// searching by ARN returns the state machine directly. This allows other
+// adapters to link to state machines using a SEARCH
+func sfnStateMachineSearchFunc(ctx context.Context, client *sfn.Client, scope string, query string) ([]*sfn.DescribeStateMachineOutput, error) {
+	stateMachine, err := sfnStateMachineGetFunc(ctx, client, scope, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*sfn.DescribeStateMachineOutput{stateMachine}, nil
+}
+
+func sfnStateMachineItemMapper(_, scope string, stateMachine *sfn.DescribeStateMachineOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(stateMachine, "ResultMetadata")
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "sfn-state-machine",
+		UniqueAttribute: "StateMachineArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch stateMachine.Status {
+	case types.StateMachineStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.StateMachineStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if stateMachine.RoleArn != nil {
+		if link := iamRoleLink(*stateMachine.RoleArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if stateMachine.LoggingConfiguration != nil {
+		for _, destination := range stateMachine.LoggingConfiguration.Destinations {
+			if destination.CloudWatchLogsLogGroup == nil || destination.CloudWatchLogsLogGroup.LogGroupArn == nil {
+				continue
+			}
+
+			logGroupARN := *destination.CloudWatchLogsLogGroup.LogGroupArn
+
+			if a, err := adapterhelpers.ParseARN(logGroupARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "logs-log-group",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  logGroupARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// If the log group is deleted, executions won't be
+						// logged but will still run
+						In: false,
+						// The state machine writes logs to the log group
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if stateMachine.EncryptionConfiguration != nil && stateMachine.EncryptionConfiguration.KmsKeyId != nil {
+		if link := kmsKeyLink(*stateMachine.EncryptionConfiguration.KmsKeyId, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if stateMachine.Definition != nil {
+		// The definition has already been validated by AWS, but if we can't
+		// parse it we still want to return the state machine
+		if definition, err := parseSFNDefinition(*stateMachine.Definition); err == nil {
+			for _, state := range definition.TaskStates() {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, sfnTaskLinks(state, scope)...)
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSFNStateMachineAdapter(client *sfn.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*sfn.DescribeStateMachineOutput, *sfn.Client, *sfn.Options] {
+	return &adapterhelpers.GetListAdapter[*sfn.DescribeStateMachineOutput, *sfn.Client, *sfn.Options]{
+		ItemType:        "sfn-state-machine",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sfnStateMachineAdapterMetadata,
+		GetFunc:         sfnStateMachineGetFunc,
+		ListFunc:        sfnStateMachineListFunc,
+		SearchFunc:      sfnStateMachineSearchFunc,
+		ListTagsFunc: func(ctx context.Context, stateMachine *sfn.DescribeStateMachineOutput, client *sfn.Client) (map[string]string, error) {
+			return sfnTags(ctx, client, stateMachine.StateMachineArn)
+		},
+		ItemMapper: sfnStateMachineItemMapper,
+	}
+}
+
+var sfnStateMachineAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "sfn-state-machine",
+	DescriptiveName: "Step Functions State Machine",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a state machine by ARN",
+		ListDescription:   "List all state machines",
+		SearchDescription: "Search for a state machine by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_sfn_state_machine.arn",
+		},
+	},
+	PotentialLinks: []string{"iam-role", "logs-log-group", "kms-key", "lambda-function", "sfn-activity", "sqs-queue", "sns-topic", "dynamodb-table", "ecs-task-definition", "ecs-cluster", "sfn-state-machine", "events-event-bus"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
+
+var sfnStateMachineAdapterIAMActions = IAMActions.Register(sfnStateMachineAdapterMetadata,
+	"states:DescribeStateMachine",
+	"states:ListStateMachines",
+	"states:ListTagsForResource",
+)
@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSFNStateMachineItemMapper(t *testing.T) {
+	stateMachine := sfn.DescribeStateMachineOutput{
+		StateMachineArn: adapterhelpers.PtrString("arn:aws:states:eu-west-2:052392120703:stateMachine:order-processing"),
+		Name:            adapterhelpers.PtrString("order-processing"),
+		Type:            types.StateMachineTypeStandard,
+		Status:          types.StateMachineStatusActive,
+		CreationDate:    adapterhelpers.PtrTime(time.Now()),
+		Definition:      adapterhelpers.PtrString(sfnTestDefinition),                                 // link
+		RoleArn:         adapterhelpers.PtrString("arn:aws:iam::052392120703:role/order-processing"), // link
+		LoggingConfiguration: &types.LoggingConfiguration{
+			Level: types.LogLevelError,
+			Destinations: []types.LogDestination{
+				{
+					CloudWatchLogsLogGroup: &types.CloudWatchLogsLogGroup{
+						LogGroupArn: adapterhelpers.PtrString("arn:aws:logs:eu-west-2:052392120703:log-group:/aws/vendedlogs/states/order-processing:*"), // link
+					},
+				},
+			},
+		},
+		EncryptionConfiguration: &types.EncryptionConfiguration{
+			Type:     types.EncryptionTypeCustomerManagedKmsKey,
+			KmsKeyId: adapterhelpers.PtrString("arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab"), // link
+		},
+		TracingConfiguration: &types.TracingConfiguration{
+			Enabled: false,
+		},
+	}
+
+	item, err := sfnStateMachineItemMapper("", "052392120703.eu-west-2", &stateMachine)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::052392120703:role/order-processing",
+			ExpectedScope:  "052392120703",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:052392120703:log-group:/aws/vendedlogs/states/order-processing:*",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:052392120703:function:validate-order",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:052392120703:orders",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://sqs.eu-west-2.amazonaws.com/052392120703/items",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSFNStateMachineAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := sfn.NewFromConfig(config)
+
+	adapter := NewSFNStateMachineAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
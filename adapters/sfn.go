@@ -0,0 +1,324 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// sfnDefinition The parts of an Amazon States Language definition that are
+// used to find the resources that a state machine calls
+//
+// https://states-language.net/spec.html
+type sfnDefinition struct {
+	StartAt string
+	States  map[string]sfnState
+}
+
+type sfnState struct {
+	Type     string
+	Resource string
+	// Parameters are used with JSONPath and Arguments with JSONata, both are
+	// passed to the resource
+	Parameters json.RawMessage
+	Arguments  json.RawMessage
+	// Parallel states contain branches, and Map states contain either an
+	// ItemProcessor or the deprecated Iterator
+	Branches      []sfnDefinition
+	ItemProcessor *sfnDefinition
+	Iterator      *sfnDefinition
+}
+
+// parseSFNDefinition Parses an Amazon States Language definition
+func parseSFNDefinition(definition string) (*sfnDefinition, error) {
+	var parsed sfnDefinition
+
+	err := json.Unmarshal([]byte(definition), &parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+// TaskStates Returns all Task states in the definition, including those
+// nested inside Parallel and Map states
+func (d *sfnDefinition) TaskStates() []sfnState {
+	tasks := make([]sfnState, 0)
+
+	if d == nil {
+		return tasks
+	}
+
+	for _, state := range d.States {
+		switch state.Type {
+		case "Task":
+			tasks = append(tasks, state)
+		case "Parallel":
+			for _, branch := range state.Branches {
+				tasks = append(tasks, branch.TaskStates()...)
+			}
+		case "Map":
+			tasks = append(tasks, state.ItemProcessor.TaskStates()...)
+			tasks = append(tasks, state.Iterator.TaskStates()...)
+		}
+	}
+
+	return tasks
+}
+
+// StaticParameters Returns the parameters that are passed to the resource,
+// excluding any that are only known at runtime. These are JSONPath parameters
+// whose name ends with ".$" and JSONata expressions, which are wrapped in {%
+// %}. If the parameters can't be parsed an empty map is returned
+func (s *sfnState) StaticParameters() map[string]interface{} {
+	params := make(map[string]interface{})
+
+	raw := s.Parameters
+	if len(raw) == 0 {
+		raw = s.Arguments
+	}
+
+	var parsed map[string]interface{}
+	if len(raw) == 0 || json.Unmarshal(raw, &parsed) != nil {
+		return params
+	}
+
+	for key, value := range parsed {
+		if strings.HasSuffix(key, ".$") {
+			continue
+		}
+
+		if str, ok := value.(string); ok && strings.HasPrefix(strings.TrimSpace(str), "{%") {
+			continue
+		}
+
+		params[key] = value
+	}
+
+	return params
+}
+
+// sfnTaskLinkBlastPropagation Task states call the resource and pass its
+// output to the next state, so changes in either direction affect each other
+func sfnTaskLinkBlastPropagation() *sdp.BlastPropagation {
+	return &sdp.BlastPropagation{
+		// If the resource changes or is deleted, the task will fail or
+		// return different results
+		In: true,
+		// Changing the state machine changes what is sent to the resource
+		Out: true,
+	}
+}
+
+// sfnNameOrARNLink Links to a resource that can be referenced by name or ARN.
+// Names are resolved with a GET in the state machine's scope, and ARNs with a
+// SEARCH in the ARN's scope
+func sfnNameOrARNLink(itemType string, nameOrARN string, scope string) *sdp.LinkedItemQuery {
+	query := &sdp.Query{
+		Type:   itemType,
+		Method: sdp.QueryMethod_GET,
+		Query:  nameOrARN,
+		Scope:  scope,
+	}
+
+	if a, err := adapterhelpers.ParseARN(nameOrARN); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query:            query,
+		BlastPropagation: sfnTaskLinkBlastPropagation(),
+	}
+}
+
+// sfnTaskLinks Links to the resources that a Task state calls. This handles
+// Lambda functions and activities that are called directly by ARN, as well as
+// the optimised service integrations which have a resource in the format
+// arn:aws:states:::{service}:{action}[.{pattern}] and take the target in the
+// parameters
+//
+// https://docs.aws.amazon.com/step-functions/latest/dg/integrate-optimized.html
+func sfnTaskLinks(state sfnState, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	a, err := adapterhelpers.ParseARN(state.Resource)
+	if err != nil {
+		return links
+	}
+
+	switch a.Service {
+	case "lambda":
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "lambda-function",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  state.Resource,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: sfnTaskLinkBlastPropagation(),
+		})
+
+		return links
+	case "states":
+		// Handled below
+	default:
+		return links
+	}
+
+	if a.Type() == "activity" {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "sfn-activity",
+				Method: sdp.QueryMethod_GET,
+				Query:  state.Resource,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the activity is deleted, the task will fail
+				In: true,
+				// Workers poll the activity for tasks
+				Out: true,
+			},
+		})
+
+		return links
+	}
+
+	// Optimised integrations don't have an account or region, and the
+	// resource is {service}:{action}
+	service, _, _ := strings.Cut(a.Resource, ":")
+	params := state.StaticParameters()
+
+	stringParam := func(name string) string {
+		if value, ok := params[name].(string); ok {
+			return value
+		}
+
+		return ""
+	}
+
+	switch service {
+	case "lambda":
+		if functionName := stringParam("FunctionName"); functionName != "" {
+			if _, err := adapterhelpers.ParseARN(functionName); err != nil {
+				// Names can include a version or alias after a colon
+				functionName, _, _ = strings.Cut(functionName, ":")
+			}
+
+			links = append(links, sfnNameOrARNLink("lambda-function", functionName, scope))
+		}
+	case "sqs":
+		if queueURL := stringParam("QueueUrl"); queueURL != "" {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sqs-queue",
+					Method: sdp.QueryMethod_GET,
+					Query:  queueURL,
+					Scope:  scope,
+				},
+				BlastPropagation: sfnTaskLinkBlastPropagation(),
+			})
+		}
+	case "sns":
+		if topicARN := stringParam("TopicArn"); topicARN != "" {
+			if a, err := adapterhelpers.ParseARN(topicARN); err == nil {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "sns-topic",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  topicARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: sfnTaskLinkBlastPropagation(),
+				})
+			}
+		}
+	case "dynamodb":
+		if tableName := stringParam("TableName"); tableName != "" {
+			links = append(links, sfnNameOrARNLink("dynamodb-table", tableName, scope))
+		}
+	case "ecs":
+		if taskDefinition := stringParam("TaskDefinition"); taskDefinition != "" {
+			links = append(links, sfnNameOrARNLink("ecs-task-definition", taskDefinition, scope))
+		}
+
+		if cluster := stringParam("Cluster"); cluster != "" {
+			links = append(links, sfnNameOrARNLink("ecs-cluster", cluster, scope))
+		}
+	case "states":
+		if stateMachineARN := stringParam("StateMachineArn"); stateMachineARN != "" {
+			if a, err := adapterhelpers.ParseARN(stateMachineARN); err == nil {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "sfn-state-machine",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  stateMachineARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: sfnTaskLinkBlastPropagation(),
+				})
+			}
+		}
+	case "events":
+		// Events are put onto the default bus unless a bus is specified
+		entries, _ := params["Entries"].([]interface{})
+		var defaultLinked bool
+
+		for _, entry := range entries {
+			entryMap, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			// The bus is only known at runtime
+			if _, ok := entryMap["EventBusName.$"]; ok {
+				continue
+			}
+
+			busName, _ := entryMap["EventBusName"].(string)
+
+			switch {
+			case strings.HasPrefix(strings.TrimSpace(busName), "{%"):
+				continue
+			case busName == "" || busName == "default":
+				if defaultLinked {
+					continue
+				}
+
+				defaultLinked = true
+				links = append(links, sfnNameOrARNLink("events-event-bus", "default", scope))
+			default:
+				links = append(links, sfnNameOrARNLink("events-event-bus", busName, scope))
+			}
+		}
+	}
+
+	return links
+}
+
+// sfnTags Gets the tags for a Step Functions resource
+func sfnTags(ctx context.Context, client *sfn.Client, arn *string) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &sfn.ListTagsForResourceInput{
+		ResourceArn: arn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,288 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+const sfnTestDefinition = `{
+  "Comment": "Processes orders",
+  "StartAt": "Validate",
+  "QueryLanguage": "JSONPath",
+  "States": {
+    "Validate": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:eu-west-2:052392120703:function:validate-order",
+      "Next": "Fan out"
+    },
+    "Fan out": {
+      "Type": "Parallel",
+      "Branches": [
+        {
+          "StartAt": "Store",
+          "States": {
+            "Store": {
+              "Type": "Task",
+              "Resource": "arn:aws:states:::dynamodb:putItem",
+              "Parameters": {
+                "TableName": "orders",
+                "Item": {
+                  "id": {"S.$": "$.id"}
+                }
+              },
+              "End": true
+            }
+          }
+        },
+        {
+          "StartAt": "Notify",
+          "States": {
+            "Notify": {
+              "Type": "Task",
+              "Resource": "arn:aws:states:::sns:publish",
+              "Parameters": {
+                "TopicArn": "arn:aws:sns:eu-west-2:052392120703:orders",
+                "Message.$": "$"
+              },
+              "End": true
+            }
+          }
+        }
+      ],
+      "Next": "Items"
+    },
+    "Items": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "ItemProcessor": {
+        "StartAt": "Enqueue",
+        "States": {
+          "Enqueue": {
+            "Type": "Task",
+            "Resource": "arn:aws:states:::sqs:sendMessage",
+            "Parameters": {
+              "QueueUrl": "https://sqs.eu-west-2.amazonaws.com/052392120703/items",
+              "MessageBody.$": "$"
+            },
+            "End": true
+          }
+        }
+      },
+      "Next": "Done"
+    },
+    "Done": {
+      "Type": "Succeed"
+    }
+  }
+}`
+
+func TestParseSFNDefinition(t *testing.T) {
+	definition, err := parseSFNDefinition(sfnTestDefinition)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if definition.StartAt != "Validate" {
+		t.Errorf("expected StartAt to be Validate, got %v", definition.StartAt)
+	}
+
+	tasks := definition.TaskStates()
+
+	if len(tasks) != 4 {
+		t.Fatalf("expected 4 task states, got %v", len(tasks))
+	}
+
+	resources := make(map[string]bool)
+
+	for _, task := range tasks {
+		resources[task.Resource] = true
+	}
+
+	for _, expected := range []string{
+		"arn:aws:lambda:eu-west-2:052392120703:function:validate-order",
+		"arn:aws:states:::dynamodb:putItem",
+		"arn:aws:states:::sns:publish",
+		"arn:aws:states:::sqs:sendMessage",
+	} {
+		if !resources[expected] {
+			t.Errorf("expected task with resource %v", expected)
+		}
+	}
+
+	if _, err := parseSFNDefinition("not json"); err == nil {
+		t.Error("expected error parsing invalid definition")
+	}
+}
+
+func TestSFNTaskLinks(t *testing.T) {
+	scope := "052392120703.eu-west-2"
+
+	t.Run("direct lambda", func(t *testing.T) {
+		links := sfnTaskLinks(sfnState{
+			Type:     "Task",
+			Resource: "arn:aws:lambda:eu-west-2:052392120703:function:validate-order",
+		}, scope)
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "lambda-function",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:lambda:eu-west-2:052392120703:function:validate-order",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, &sdp.Item{LinkedItemQueries: links})
+	})
+
+	t.Run("activity", func(t *testing.T) {
+		links := sfnTaskLinks(sfnState{
+			Type:     "Task",
+			Resource: "arn:aws:states:eu-west-2:052392120703:activity:approve",
+		}, scope)
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "sfn-activity",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "arn:aws:states:eu-west-2:052392120703:activity:approve",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, &sdp.Item{LinkedItemQueries: links})
+	})
+
+	t.Run("lambda integration", func(t *testing.T) {
+		links := sfnTaskLinks(sfnState{
+			Type:       "Task",
+			Resource:   "arn:aws:states:::lambda:invoke",
+			Parameters: []byte(`{"FunctionName": "validate-order:$LATEST", "Payload.$": "$"}`),
+		}, scope)
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "lambda-function",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "validate-order",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, &sdp.Item{LinkedItemQueries: links})
+	})
+
+	t.Run("ecs integration", func(t *testing.T) {
+		links := sfnTaskLinks(sfnState{
+			Type:     "Task",
+			Resource: "arn:aws:states:::ecs:runTask.sync",
+			Parameters: []byte(`{
+				"Cluster": "arn:aws:ecs:eu-west-2:052392120703:cluster/default",
+				"TaskDefinition": "report:3",
+				"LaunchType": "FARGATE"
+			}`),
+		}, scope)
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "ecs-task-definition",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "report:3",
+				ExpectedScope:  scope,
+			},
+			{
+				ExpectedType:   "ecs-cluster",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:ecs:eu-west-2:052392120703:cluster/default",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, &sdp.Item{LinkedItemQueries: links})
+	})
+
+	t.Run("nested state machine", func(t *testing.T) {
+		links := sfnTaskLinks(sfnState{
+			Type:       "Task",
+			Resource:   "arn:aws:states:::states:startExecution.sync:2",
+			Parameters: []byte(`{"StateMachineArn": "arn:aws:states:eu-west-2:052392120703:stateMachine:child"}`),
+		}, scope)
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "sfn-state-machine",
+				ExpectedMethod: sdp.QueryMethod_SEARCH,
+				ExpectedQuery:  "arn:aws:states:eu-west-2:052392120703:stateMachine:child",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, &sdp.Item{LinkedItemQueries: links})
+	})
+
+	t.Run("eventbridge integration", func(t *testing.T) {
+		links := sfnTaskLinks(sfnState{
+			Type:      "Task",
+			Resource:  "arn:aws:states:::events:putEvents",
+			Arguments: []byte(`{"Entries": [{"EventBusName": "orders", "Detail": "{% $states.input %}"}]}`),
+		}, scope)
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "events-event-bus",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "orders",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, &sdp.Item{LinkedItemQueries: links})
+	})
+
+	t.Run("eventbridge default bus", func(t *testing.T) {
+		links := sfnTaskLinks(sfnState{
+			Type:       "Task",
+			Resource:   "arn:aws:states:::events:putEvents",
+			Parameters: []byte(`{"Entries": [{"Source": "orders", "Detail.$": "$"}, {"Source": "payments", "Detail.$": "$"}, {"EventBusName.$": "$.bus"}]}`),
+		}, scope)
+
+		tests := adapterhelpers.QueryTests{
+			{
+				ExpectedType:   "events-event-bus",
+				ExpectedMethod: sdp.QueryMethod_GET,
+				ExpectedQuery:  "default",
+				ExpectedScope:  scope,
+			},
+		}
+
+		tests.Execute(t, &sdp.Item{LinkedItemQueries: links})
+
+		if len(links) != 1 {
+			t.Errorf("expected 1 link to the default bus, got %v", len(links))
+		}
+	})
+
+	t.Run("dynamic parameters", func(t *testing.T) {
+		links := sfnTaskLinks(sfnState{
+			Type:       "Task",
+			Resource:   "arn:aws:states:::dynamodb:getItem",
+			Parameters: []byte(`{"TableName.$": "$.table"}`),
+		}, scope)
+
+		if len(links) != 0 {
+			t.Errorf("expected no links for a dynamic table name, got %v", len(links))
+		}
+
+		links = sfnTaskLinks(sfnState{
+			Type:      "Task",
+			Resource:  "arn:aws:states:::dynamodb:getItem",
+			Arguments: []byte(`{"TableName": "{% $states.input.table %}"}`),
+		}, scope)
+
+		if len(links) != 0 {
+			t.Errorf("expected no links for a JSONata table name, got %v", len(links))
+		}
+	})
+}
@@ -95,3 +95,7 @@ var dataProtectionPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata
 	PotentialLinks: []string{"sns-topic"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var dataProtectionPolicyAdapterIAMActions = IAMActions.Register(dataProtectionPolicyAdapterMetadata,
+	"sns:GetDataProtectionPolicy",
+)
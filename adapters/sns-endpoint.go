@@ -97,3 +97,9 @@ var snsEndpointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var snsEndpointAdapterIAMActions = IAMActions.Register(snsEndpointAdapterMetadata,
+	"sns:GetEndpointAttributes",
+	"sns:ListEndpointsByPlatformApplication",
+	"sns:ListTagsForResource",
+)
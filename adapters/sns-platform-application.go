@@ -113,3 +113,9 @@ var platformApplicationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"sns-endpoint"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var platformApplicationAdapterIAMActions = IAMActions.Register(platformApplicationAdapterMetadata,
+	"sns:GetPlatformApplicationAttributes",
+	"sns:ListPlatformApplications",
+	"sns:ListTagsForResource",
+)
@@ -130,3 +130,9 @@ var snsSubscriptionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"sns-topic", "iam-role"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var snsSubscriptionAdapterIAMActions = IAMActions.Register(snsSubscriptionAdapterMetadata,
+	"sns:GetSubscriptionAttributes",
+	"sns:ListSubscriptions",
+	"sns:ListTagsForResource",
+)
@@ -111,3 +111,9 @@ var snsTopicAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	PotentialLinks: []string{"kms-key"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
 })
+
+var snsTopicAdapterIAMActions = IAMActions.Register(snsTopicAdapterMetadata,
+	"sns:GetTopicAttributes",
+	"sns:ListTagsForResource",
+	"sns:ListTopics",
+)
@@ -2,16 +2,21 @@ package adapters
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 
 	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/aws-source/metrics"
+	"github.com/overmindtech/discovery"
 	"github.com/overmindtech/sdp-go"
 )
 
 type sqsClient interface {
+	GetQueueUrl(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error)
 	GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error)
+	ListDeadLetterSourceQueues(ctx context.Context, params *sqs.ListDeadLetterSourceQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error)
 	ListQueueTags(ctx context.Context, params *sqs.ListQueueTagsInput, optFns ...func(*sqs.Options)) (*sqs.ListQueueTagsOutput, error)
 	ListQueues(context.Context, *sqs.ListQueuesInput, ...func(*sqs.Options)) (*sqs.ListQueuesOutput, error)
 }
@@ -47,7 +52,7 @@ func getFunc(ctx context.Context, client sqsClient, scope string, input *sqs.Get
 		}
 	}
 
-	return &sdp.Item{
+	item := &sdp.Item{
 		Type:            "sqs-queue",
 		UniqueAttribute: "QueueURL",
 		Attributes:      attributes,
@@ -67,11 +72,186 @@ func getFunc(ctx context.Context, client sqsClient, scope string, input *sqs.Get
 				},
 			},
 		},
-	}, nil
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, sqsRedriveLinks(output.Attributes)...)
+
+	// If this is a dead-letter queue, link to all of the queues that use it.
+	// Unlike the RedriveAllowPolicy this includes queues that are allowed
+	// implicitly, so the policy is only used if we can't list them
+	sourceQueues := sqs.NewListDeadLetterSourceQueuesPaginator(client, &sqs.ListDeadLetterSourceQueuesInput{
+		QueueUrl: input.QueueUrl,
+	})
+
+	for sourceQueues.HasMorePages() {
+		sourceOut, err := sourceQueues.NextPage(ctx)
+		if err != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, sqsRedriveAllowLinks(output.Attributes)...)
+			break
+		}
+
+		for _, sourceQueueURL := range sourceOut.QueueUrls {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sqs-queue",
+					Method: sdp.QueryMethod_GET,
+					Query:  sourceQueueURL,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The source queue moves messages to this queue
+					In: true,
+					// Changing this queue changes where the source queue's
+					// failed messages go
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if queuePolicy, ok := output.Attributes["Policy"]; ok {
+		if document, err := ParsePolicyDocument(queuePolicy); err == nil {
+			for _, link := range LinksFromPolicy(document) {
+				// The resource in a queue policy is the queue itself, which
+				// we don't want to link to
+				if link.GetQuery().GetQuery() == output.Attributes["QueueArn"] {
+					continue
+				}
+
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, sqsPolicySourceLinks(document)...)
+		}
+	}
+
+	if kmsMasterKeyID, ok := output.Attributes["KmsMasterKeyId"]; ok {
+		if link := kmsKeyLink(kmsMasterKeyID, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return item, nil
 }
 
-func NewSQSQueueAdapter(client sqsClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*sqs.ListQueuesInput, *sqs.ListQueuesOutput, *sqs.GetQueueAttributesInput, *sqs.GetQueueAttributesOutput, sqsClient, *sqs.Options] {
-	return &adapterhelpers.AlwaysGetAdapter[*sqs.ListQueuesInput, *sqs.ListQueuesOutput, *sqs.GetQueueAttributesInput, *sqs.GetQueueAttributesOutput, sqsClient, *sqs.Options]{
+// sqsQueueAdapter Queues are queried by URL, which can't be derived from the
+// ARN since it depends on the partition and on when the queue was created. This
+// wraps the generic adapter so that searching by ARN can look up the URL using
+// `GetQueueUrl`, and also return the queues that use it as a dead-letter queue
+type sqsQueueAdapter struct {
+	*adapterhelpers.AlwaysGetAdapter[*sqs.ListQueuesInput, *sqs.ListQueuesOutput, *sqs.GetQueueAttributesInput, *sqs.GetQueueAttributesOutput, sqsClient, *sqs.Options]
+}
+
+// SearchStream Searches for a queue by ARN. This returns the queue itself,
+// followed by any source queues that use it as a dead-letter queue
+func (s *sqsQueueAdapter) SearchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	adapterhelpers.ObserveStream(ctx, s.ItemType, scope, sdp.QueryMethod_SEARCH, stream, func(ctx context.Context, stream *discovery.QueryResultStream) {
+		s.searchStream(ctx, scope, query, ignoreCache, stream)
+	})
+}
+
+func (s *sqsQueueAdapter) searchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	if scope != s.Scopes()[0] {
+		stream.SendError(&sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("requested scope %v does not match adapter scope %v", scope, s.Scopes()[0]),
+		})
+		return
+	}
+
+	a, err := adapterhelpers.ParseARN(query)
+	if err != nil {
+		stream.SendError(adapterhelpers.WrapAWSError(err))
+		return
+	}
+
+	if a.ContainsWildcard() {
+		stream.SendError(&sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: fmt.Sprintf("wildcards are not supported by adapter %v", s.Name()),
+			Scope:       scope,
+		})
+		return
+	}
+
+	if arnScope := adapterhelpers.FormatScope(a.AccountID, a.Region); arnScope != scope {
+		stream.SendError(&sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOSCOPE,
+			ErrorString: fmt.Sprintf("ARN scope %v does not match request scope %v", arnScope, scope),
+			Scope:       scope,
+		})
+		return
+	}
+
+	cache := s.Cache()
+	cacheHit, ck, cachedItems, qErr := cache.Lookup(ctx, s.Name(), sdp.QueryMethod_SEARCH, scope, s.ItemType, query, ignoreCache)
+	metrics.ObserveCacheLookup(s.ItemType, scope, cacheHit)
+	if qErr != nil {
+		stream.SendError(qErr)
+		return
+	}
+	if cacheHit {
+		for _, item := range cachedItems {
+			stream.SendItem(item)
+		}
+		return
+	}
+
+	// The resource of a queue ARN is the queue name
+	urlOut, err := s.Client.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName:              &a.Resource,
+		QueueOwnerAWSAccountId: &a.AccountID,
+	})
+	if err != nil {
+		err := adapterhelpers.WrapAWSError(err)
+		if !adapterhelpers.CanRetry(err) {
+			cache.StoreError(err, adapterhelpers.DefaultCacheDuration, ck)
+		}
+		stream.SendError(err)
+		return
+	}
+
+	item, err := s.GetFunc(ctx, s.Client, scope, s.GetInputMapper(scope, *urlOut.QueueUrl))
+	if err != nil {
+		err := adapterhelpers.WrapAWSError(err)
+		if !adapterhelpers.CanRetry(err) {
+			cache.StoreError(err, adapterhelpers.DefaultCacheDuration, ck)
+		}
+		stream.SendError(err)
+		return
+	}
+
+	cache.StoreItem(item, adapterhelpers.DefaultCacheDuration, ck)
+	stream.SendItem(item)
+
+	sourceQueues := sqs.NewListDeadLetterSourceQueuesPaginator(s.Client, &sqs.ListDeadLetterSourceQueuesInput{
+		QueueUrl: urlOut.QueueUrl,
+	})
+
+	for sourceQueues.HasMorePages() {
+		sourceOut, err := sourceQueues.NextPage(ctx)
+		if err != nil {
+			stream.SendError(adapterhelpers.WrapAWSError(err))
+			// The paginator doesn't advance on error, so we have to stop
+			// here rather than retrying the same page forever
+			break
+		}
+
+		for _, sourceQueueURL := range sourceOut.QueueUrls {
+			sourceItem, err := s.GetFunc(ctx, s.Client, scope, s.GetInputMapper(scope, sourceQueueURL))
+			if err != nil {
+				stream.SendError(adapterhelpers.WrapAWSError(err))
+				continue
+			}
+
+			cache.StoreItem(sourceItem, adapterhelpers.DefaultCacheDuration, ck)
+			stream.SendItem(sourceItem)
+		}
+	}
+}
+
+func NewSQSQueueAdapter(client sqsClient, accountID string, region string) *sqsQueueAdapter {
+	return &sqsQueueAdapter{&adapterhelpers.AlwaysGetAdapter[*sqs.ListQueuesInput, *sqs.ListQueuesOutput, *sqs.GetQueueAttributesInput, *sqs.GetQueueAttributesOutput, sqsClient, *sqs.Options]{
 		ItemType:        "sqs-queue",
 		Client:          client,
 		AccountID:       accountID,
@@ -99,7 +279,7 @@ func NewSQSQueueAdapter(client sqsClient, accountID string, region string) *adap
 			return inputs, nil
 		},
 		GetFunc: getFunc,
-	}
+	}}
 }
 
 var sqsQueueAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
@@ -111,10 +291,19 @@ var sqsQueueAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		Search:            true,
 		GetDescription:    "Get an SQS queue attributes by its URL",
 		ListDescription:   "List all SQS queue URLs",
-		SearchDescription: "Search SQS queue by ARN",
+		SearchDescription: "Search for an SQS queue by ARN. This also returns any queues that use it as a dead-letter queue",
 	},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_sqs_queue.id"},
 	},
-	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	PotentialLinks: []string{"http", "sqs-queue", "iam-role", "iam-user", "sns-topic", "events-rule", "s3-bucket", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
+
+var sqsQueueAdapterIAMActions = IAMActions.Register(sqsQueueAdapterMetadata,
+	"sqs:GetQueueAttributes",
+	"sqs:GetQueueUrl",
+	"sqs:ListDeadLetterSourceQueues",
+	"sqs:ListQueueTags",
+	"sqs:ListQueues",
+)
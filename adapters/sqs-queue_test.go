@@ -2,15 +2,24 @@ package adapters
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/discovery"
+	"github.com/overmindtech/sdp-go"
 )
 
 type testClient struct{}
 
+func (t testClient) GetQueueUrl(ctx context.Context, params *sqs.GetQueueUrlInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+	return &sqs.GetQueueUrlOutput{
+		QueueUrl: adapterhelpers.PtrString(fmt.Sprintf("https://sqs.us-west-2.amazonaws.com/%v/%v", *params.QueueOwnerAWSAccountId, *params.QueueName)),
+	}, nil
+}
+
 func (t testClient) GetQueueAttributes(ctx context.Context, params *sqs.GetQueueAttributesInput, optFns ...func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
 	return &sqs.GetQueueAttributesOutput{
 		Attributes: map[string]string{
@@ -26,6 +35,17 @@ func (t testClient) GetQueueAttributes(ctx context.Context, params *sqs.GetQueue
 			"ReceiveMessageWaitTimeSeconds":         "0",
 			"VisibilityTimeout":                     "30",
 			"RedrivePolicy":                         "{\"deadLetterTargetArn\":\"arn:aws:sqs:us-east-1:80398EXAMPLE:MyDeadLetterQueue\",\"maxReceiveCount\":1000}",
+			"RedriveAllowPolicy":                    "{\"redrivePermission\":\"byQueue\",\"sourceQueueArns\":[\"arn:aws:sqs:us-west-2:123456789012:MySourceQueue\"]}",
+			"KmsMasterKeyId":                        "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			"Policy":                                "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\":\"sns.amazonaws.com\"},\"Action\":\"sqs:SendMessage\",\"Resource\":\"arn:aws:sqs:us-west-2:123456789012:MyQueue\",\"Condition\":{\"ArnEquals\":{\"aws:SourceArn\":\"arn:aws:sns:us-west-2:123456789012:MyTopic\"}}},{\"Effect\":\"Allow\",\"Principal\":{\"AWS\":\"arn:aws:iam::123456789012:role/consumer\"},\"Action\":\"sqs:ReceiveMessage\",\"Resource\":\"arn:aws:sqs:us-west-2:123456789012:MyQueue\"}]}",
+		},
+	}, nil
+}
+
+func (t testClient) ListDeadLetterSourceQueues(ctx context.Context, params *sqs.ListDeadLetterSourceQueuesInput, optFns ...func(*sqs.Options)) (*sqs.ListDeadLetterSourceQueuesOutput, error) {
+	return &sqs.ListDeadLetterSourceQueuesOutput{
+		QueueUrls: []string{
+			"https://sqs.us-west-2.amazonaws.com/123456789012/MySourceQueue",
 		},
 	}, nil
 }
@@ -52,7 +72,7 @@ func TestGetFunc(t *testing.T) {
 	ctx := context.Background()
 	cli := testClient{}
 
-	item, err := getFunc(ctx, cli, "scope", &sqs.GetQueueAttributesInput{
+	item, err := getFunc(ctx, cli, "123456789012.us-west-2", &sqs.GetQueueAttributesInput{
 		QueueUrl: adapterhelpers.PtrString("https://sqs.us-west-2.amazonaws.com/123456789012/MyQueue"),
 	})
 	if err != nil {
@@ -62,6 +82,95 @@ func TestGetFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://sqs.us-west-2.amazonaws.com/123456789012/MyQueue",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:us-east-1:80398EXAMPLE:MyDeadLetterQueue",
+			ExpectedScope:  "80398EXAMPLE.us-east-1",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://sqs.us-west-2.amazonaws.com/123456789012/MySourceQueue",
+			ExpectedScope:  "123456789012.us-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:us-west-2:123456789012:MyTopic",
+			ExpectedScope:  "123456789012.us-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/consumer",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.us-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	for _, link := range item.GetLinkedItemQueries() {
+		if link.GetQuery().GetQuery() == "arn:aws:sqs:us-west-2:123456789012:MyQueue" {
+			t.Errorf("expected the queue not to link to itself, got %v", link.GetQuery().GetType())
+		}
+
+		// The source queue is already linked by URL from
+		// ListDeadLetterSourceQueues
+		if link.GetQuery().GetQuery() == "arn:aws:sqs:us-west-2:123456789012:MySourceQueue" {
+			t.Error("expected the source queue from the RedriveAllowPolicy not to be linked twice")
+		}
+	}
+}
+
+func TestSQSQueueSearch(t *testing.T) {
+	adapter := NewSQSQueueAdapter(testClient{}, "123456789012", "us-west-2")
+
+	items := make([]*sdp.Item, 0)
+	errs := make([]error, 0)
+	stream := discovery.NewQueryResultStream(
+		func(item *sdp.Item) {
+			items = append(items, item)
+		},
+		func(err error) {
+			errs = append(errs, err)
+		},
+	)
+
+	adapter.SearchStream(context.Background(), "123456789012.us-west-2", "arn:aws:sqs:us-west-2:123456789012:MyQueue", false, stream)
+	stream.Close()
+
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+
+	// The queue itself, followed by the queues that use it as a dead-letter
+	// queue
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	if url, _ := items[0].GetAttributes().Get("QueueURL"); url != "https://sqs.us-west-2.amazonaws.com/123456789012/MyQueue" {
+		t.Errorf("expected the queue URL to be resolved using GetQueueUrl, got %v", url)
+	}
+
+	if url, _ := items[1].GetAttributes().Get("QueueURL"); url != "https://sqs.us-west-2.amazonaws.com/123456789012/MySourceQueue" {
+		t.Errorf("expected the dead-letter source queue to be returned, got %v", url)
+	}
 }
 
 func TestNewQueueAdapter(t *testing.T) {
@@ -2,8 +2,14 @@ package adapters
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
 )
 
 func tags(ctx context.Context, cli sqsClient, queURL string) (map[string]string, error) {
@@ -20,3 +26,177 @@ func tags(ctx context.Context, cli sqsClient, queURL string) (map[string]string,
 
 	return output.Tags, nil
 }
+
+// sqsRedrivePolicy The RedrivePolicy attribute of a queue, which is a JSON
+// string that defines the dead-letter queue that messages are moved to
+//
+// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_SetQueueAttributes.html
+type sqsRedrivePolicy struct {
+	DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+}
+
+// sqsRedriveAllowPolicy The RedriveAllowPolicy attribute of a dead-letter
+// queue, which defines which source queues can use it
+type sqsRedriveAllowPolicy struct {
+	RedrivePermission string   `json:"redrivePermission"`
+	SourceQueueArns   []string `json:"sourceQueueArns"`
+}
+
+// sqsQueueLink Links to another queue by ARN
+func sqsQueueLink(queueARN string, blastPropagation *sdp.BlastPropagation) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(queueARN)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "sqs-queue",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  queueARN,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: blastPropagation,
+	}
+}
+
+// sqsRedriveLinks Links to the dead-letter queue from the RedrivePolicy.
+// Invalid policies are ignored
+func sqsRedriveLinks(attributes map[string]string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if raw, ok := attributes["RedrivePolicy"]; ok {
+		var redrivePolicy sqsRedrivePolicy
+
+		if err := json.Unmarshal([]byte(raw), &redrivePolicy); err == nil {
+			link := sqsQueueLink(redrivePolicy.DeadLetterTargetArn, &sdp.BlastPropagation{
+				// If the dead-letter queue is deleted, messages that can't be
+				// processed will be lost
+				In: true,
+				// Messages that can't be processed are moved to the
+				// dead-letter queue
+				Out: true,
+			})
+
+			if link != nil {
+				links = append(links, link)
+			}
+		}
+	}
+
+	return links
+}
+
+// sqsRedriveAllowLinks Links to the source queues that are explicitly allowed
+// by the RedriveAllowPolicy. Invalid policies are ignored
+func sqsRedriveAllowLinks(attributes map[string]string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if raw, ok := attributes["RedriveAllowPolicy"]; ok {
+		var redriveAllowPolicy sqsRedriveAllowPolicy
+
+		// Source queues are only listed when the permission is byQueue
+		if err := json.Unmarshal([]byte(raw), &redriveAllowPolicy); err == nil {
+			for _, sourceQueueARN := range redriveAllowPolicy.SourceQueueArns {
+				link := sqsQueueLink(sourceQueueARN, &sdp.BlastPropagation{
+					// The source queue moves messages to this queue
+					In: true,
+					// Changing this queue changes where the source queue's
+					// failed messages go
+					Out: true,
+				})
+
+				if link != nil {
+					links = append(links, link)
+				}
+			}
+		}
+	}
+
+	return links
+}
+
+// sqsPolicySourceLinks Links to the resources that are allowed to send
+// messages to the queue using the aws:SourceArn condition key. This is how
+// queue policies grant access to SNS topics, EventBridge rules and S3 buckets.
+// Principals are handled by `LinksFromPolicy()`
+func sqsPolicySourceLinks(document *policy.Policy) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if document == nil || document.Statements == nil {
+		return links
+	}
+
+	for _, statement := range document.Statements.Values() {
+		var sourceARNs []string
+		var sourceAccount string
+
+		// Condition keys are case-insensitive and can be used with any of the
+		// ARN or string operators
+		for _, condition := range statement.Condition {
+			for key, value := range condition {
+				if value == nil {
+					continue
+				}
+
+				values, _, _ := value.Values()
+
+				switch {
+				case strings.EqualFold(key, "aws:SourceArn"):
+					sourceARNs = append(sourceARNs, values...)
+				case strings.EqualFold(key, "aws:SourceAccount") && len(values) == 1:
+					sourceAccount = values[0]
+				}
+			}
+		}
+
+		for _, sourceARN := range sourceARNs {
+			a, err := adapterhelpers.ParseARN(sourceARN)
+			if err != nil || a.ContainsWildcard() {
+				continue
+			}
+
+			query := &sdp.Query{
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  sourceARN,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			}
+
+			switch a.Service {
+			case "sns":
+				query.Type = "sns-topic"
+			case "events":
+				if a.Type() != "rule" {
+					continue
+				}
+
+				query.Type = "events-rule"
+			case "s3":
+				// Bucket ARNs don't contain the account, so we can only link
+				// if the policy also restricts the source account
+				if sourceAccount == "" {
+					continue
+				}
+
+				query.Type = "s3-bucket"
+				query.Method = sdp.QueryMethod_GET
+				query.Query, _, _ = strings.Cut(a.Resource, "/")
+				query.Scope = adapterhelpers.FormatScope(sourceAccount, "")
+			default:
+				continue
+			}
+
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: query,
+				BlastPropagation: &sdp.BlastPropagation{
+					// The source sends messages to the queue
+					In: true,
+					// The queue doesn't affect the source
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return links
+}
@@ -282,3 +282,9 @@ var ssmParameterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		"dns",
 	},
 })
+
+var ssmParameterAdapterIAMActions = IAMActions.Register(ssmParameterAdapterMetadata,
+	"ssm:DescribeParameters",
+	"ssm:GetParameter",
+	"ssm:ListTagsForResource",
+)
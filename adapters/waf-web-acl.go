@@ -0,0 +1,184 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/waf"
+	"github.com/aws/aws-sdk-go-v2/service/waf/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type wafWebACL struct {
+	*types.WebACL
+	LoggingConfiguration *types.LoggingConfiguration
+}
+
+func wafWebACLGetFunc(ctx context.Context, client *waf.Client, scope string, query string) (*wafWebACL, error) {
+	out, err := client.GetWebACL(ctx, &waf.GetWebACLInput{
+		WebACLId: &query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.WebACL == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "web ACL " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	acl := wafWebACL{
+		WebACL: out.WebACL,
+	}
+
+	// Logging is optional and returns an error when it isn't configured, so we
+	// still want to return the ACL if this fails
+	logging, err := client.GetLoggingConfiguration(ctx, &waf.GetLoggingConfigurationInput{
+		ResourceArn: out.WebACL.WebACLArn,
+	})
+	if err == nil {
+		acl.LoggingConfiguration = logging.LoggingConfiguration
+	}
+
+	return &acl, nil
+}
+
+func wafWebACLListFunc(ctx context.Context, client *waf.Client, scope string) ([]*wafWebACL, error) {
+	acls := make([]*wafWebACL, 0)
+
+	input := &waf.ListWebACLsInput{}
+
+	for {
+		out, err := client.ListWebACLs(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.WebACLs {
+			if summary.WebACLId == nil {
+				continue
+			}
+
+			acl, err := wafWebACLGetFunc(ctx, client, scope, *summary.WebACLId)
+			if err != nil {
+				return nil, err
+			}
+
+			acls = append(acls, acl)
+		}
+
+		if out.NextMarker == nil || *out.NextMarker == "" {
+			break
+		}
+
+		input.NextMarker = out.NextMarker
+	}
+
+	return acls, nil
+}
+
+func wafWebACLListTagsFunc(ctx context.Context, acl *wafWebACL, client *waf.Client) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	if acl.WebACLArn == nil {
+		return tags, nil
+	}
+
+	input := &waf.ListTagsForResourceInput{
+		ResourceARN: acl.WebACLArn,
+	}
+
+	for {
+		out, err := client.ListTagsForResource(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if out.TagInfoForResource != nil {
+			for _, tag := range out.TagInfoForResource.TagList {
+				if tag.Key != nil && tag.Value != nil {
+					tags[*tag.Key] = *tag.Value
+				}
+			}
+		}
+
+		if out.NextMarker == nil || *out.NextMarker == "" {
+			break
+		}
+
+		input.NextMarker = out.NextMarker
+	}
+
+	return tags, nil
+}
+
+func wafWebACLItemMapper(_, scope string, acl *wafWebACL) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(acl)
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "waf-web-acl",
+		UniqueAttribute: "WebACLId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// WAF Classic can only log to Firehose delivery streams
+	if acl.LoggingConfiguration != nil {
+		for _, destination := range acl.LoggingConfiguration.LogDestinationConfigs {
+			if link := logDestinationLink(destination); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewWAFWebACLAdapter(client *waf.Client, accountID string) *adapterhelpers.GetListAdapter[*wafWebACL, *waf.Client, *waf.Options] {
+	return &adapterhelpers.GetListAdapter[*wafWebACL, *waf.Client, *waf.Options]{
+		ItemType:        "waf-web-acl",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // WAF Classic resources for CloudFront aren't tied to a region
+		AdapterMetadata: wafWebACLAdapterMetadata,
+		GetFunc:         wafWebACLGetFunc,
+		ListFunc:        wafWebACLListFunc,
+		ListTagsFunc:    wafWebACLListTagsFunc,
+		ItemMapper:      wafWebACLItemMapper,
+	}
+}
+
+var wafWebACLAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "waf-web-acl",
+	DescriptiveName: "WAF Classic Web ACL",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a web ACL by ID",
+		ListDescription:   "List all web ACLs",
+		SearchDescription: "Search for a web ACL by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_GET,
+			TerraformQueryMap: "aws_waf_web_acl.id",
+		},
+	},
+	PotentialLinks: []string{"firehose-delivery-stream"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var wafWebACLAdapterIAMActions = IAMActions.Register(wafWebACLAdapterMetadata,
+	"waf:GetLoggingConfiguration",
+	"waf:GetWebACL",
+	"waf:ListTagsForResource",
+	"waf:ListWebACLs",
+)
@@ -0,0 +1,75 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/waf"
+	"github.com/aws/aws-sdk-go-v2/service/waf/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestWAFWebACLItemMapper(t *testing.T) {
+	acl := &wafWebACL{
+		WebACL: &types.WebACL{
+			WebACLId:   adapterhelpers.PtrString("a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+			WebACLArn:  adapterhelpers.PtrString("arn:aws:waf::123456789012:webacl/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+			Name:       adapterhelpers.PtrString("classic-acl"),
+			MetricName: adapterhelpers.PtrString("classicacl"),
+			DefaultAction: &types.WafAction{
+				Type: types.WafActionTypeAllow,
+			},
+			Rules: []types.ActivatedRule{
+				{
+					Priority: adapterhelpers.PtrInt32(1),
+					RuleId:   adapterhelpers.PtrString("e5f6g7h8"),
+					Action: &types.WafAction{
+						Type: types.WafActionTypeBlock,
+					},
+					Type: types.WafRuleTypeRegular,
+				},
+			},
+		},
+		LoggingConfiguration: &types.LoggingConfiguration{
+			LogDestinationConfigs: []string{
+				"arn:aws:firehose:us-east-1:123456789012:deliverystream/aws-waf-logs-classic",
+			},
+		},
+	}
+
+	item, err := wafWebACLItemMapper("", "123456789012", acl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "firehose-delivery-stream",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:firehose:us-east-1:123456789012:deliverystream/aws-waf-logs-classic",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewWAFWebACLAdapter(t *testing.T) {
+	config, account, _ := adapterhelpers.GetAutoConfig(t)
+	client := waf.NewFromConfig(config)
+
+	adapter := NewWAFWebACLAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
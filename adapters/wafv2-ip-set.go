@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type wafv2IPSet struct {
+	*types.IPSet
+	Scope types.Scope
+}
+
+func wafv2IPSetGetFunc(ctx context.Context, client *wafv2.Client, scope string, query string) (*wafv2IPSet, error) {
+	identifier, err := parseWAFv2UniqueName(query)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	out, err := client.GetIPSet(ctx, &wafv2.GetIPSetInput{
+		Id:    &identifier.ID,
+		Name:  &identifier.Name,
+		Scope: identifier.Scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.IPSet == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "IP set " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return &wafv2IPSet{
+		IPSet: out.IPSet,
+		Scope: identifier.Scope,
+	}, nil
+}
+
+func wafv2IPSetListFunc(ctx context.Context, client *wafv2.Client, scope string) ([]*wafv2IPSet, error) {
+	sets := make([]*wafv2IPSet, 0)
+
+	for _, wafScope := range wafv2Scopes(scope) {
+		input := &wafv2.ListIPSetsInput{
+			Scope: wafScope,
+		}
+
+		for {
+			out, err := client.ListIPSets(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, summary := range out.IPSets {
+				set, err := wafv2IPSetGetFunc(ctx, client, scope, wafv2UniqueName(wafScope, summary.Name, summary.Id))
+				if err != nil {
+					return nil, err
+				}
+
+				sets = append(sets, set)
+			}
+
+			if out.NextMarker == nil || *out.NextMarker == "" {
+				break
+			}
+
+			input.NextMarker = out.NextMarker
+		}
+	}
+
+	return sets, nil
+}
+
+func wafv2IPSetSearchFunc(ctx context.Context, client *wafv2.Client, scope string, query string) ([]*wafv2IPSet, error) {
+	identifier, err := parseWAFv2ARN(query, "ipset")
+	if err != nil {
+		return nil, wafv2ARNQueryError(err, scope)
+	}
+
+	set, err := wafv2IPSetGetFunc(ctx, client, scope, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*wafv2IPSet{set}, nil
+}
+
+func wafv2IPSetListTagsFunc(ctx context.Context, set *wafv2IPSet, client *wafv2.Client) (map[string]string, error) {
+	return wafv2Tags(ctx, client, set.ARN)
+}
+
+func wafv2IPSetItemMapper(_, scope string, set *wafv2IPSet) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(set)
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", wafv2UniqueName(set.Scope, set.Name, set.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "wafv2-ip-set",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	return &item, nil
+}
+
+func NewWAFv2IPSetAdapter(client *wafv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*wafv2IPSet, *wafv2.Client, *wafv2.Options] {
+	return &adapterhelpers.GetListAdapter[*wafv2IPSet, *wafv2.Client, *wafv2.Options]{
+		ItemType:        "wafv2-ip-set",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: wafv2IPSetAdapterMetadata,
+		GetFunc:         wafv2IPSetGetFunc,
+		ListFunc:        wafv2IPSetListFunc,
+		SearchFunc:      wafv2IPSetSearchFunc,
+		ListTagsFunc:    wafv2IPSetListTagsFunc,
+		ItemMapper:      wafv2IPSetItemMapper,
+	}
+}
+
+var wafv2IPSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "wafv2-ip-set",
+	DescriptiveName: "WAFv2 IP Set",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an IP set by {scope}/{name}/{id}, where scope is REGIONAL or CLOUDFRONT",
+		ListDescription:   "List all IP sets. CloudFront IP sets are only listed in us-east-1",
+		SearchDescription: "Search for an IP set by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_ip_set.arn",
+		},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var wafv2IPSetAdapterIAMActions = IAMActions.Register(wafv2IPSetAdapterMetadata,
+	"wafv2:GetIPSet",
+	"wafv2:ListIPSets",
+	"wafv2:ListTagsForResource",
+)
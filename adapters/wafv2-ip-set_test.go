@@ -0,0 +1,51 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func TestWAFv2IPSetItemMapper(t *testing.T) {
+	set := &wafv2IPSet{
+		IPSet: &types.IPSet{
+			ARN:              adapterhelpers.PtrString("arn:aws:wafv2:eu-west-2:123456789012:regional/ipset/blocked/a1b2c3d4"),
+			Id:               adapterhelpers.PtrString("a1b2c3d4"),
+			Name:             adapterhelpers.PtrString("blocked"),
+			Addresses:        []string{"192.0.2.0/24", "198.51.100.7/32"},
+			IPAddressVersion: types.IPAddressVersionIpv4,
+		},
+		Scope: types.ScopeRegional,
+	}
+
+	item, err := wafv2IPSetItemMapper("", "123456789012.eu-west-2", set)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.UniqueAttributeValue() != "REGIONAL/blocked/a1b2c3d4" {
+		t.Errorf("expected unique attribute REGIONAL/blocked/a1b2c3d4, got %v", item.UniqueAttributeValue())
+	}
+}
+
+func TestNewWAFv2IPSetAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := wafv2.NewFromConfig(config)
+
+	adapter := NewWAFv2IPSetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
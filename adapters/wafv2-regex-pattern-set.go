@@ -0,0 +1,163 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type wafv2RegexPatternSet struct {
+	*types.RegexPatternSet
+	Scope types.Scope
+}
+
+func wafv2RegexPatternSetGetFunc(ctx context.Context, client *wafv2.Client, scope string, query string) (*wafv2RegexPatternSet, error) {
+	identifier, err := parseWAFv2UniqueName(query)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	out, err := client.GetRegexPatternSet(ctx, &wafv2.GetRegexPatternSetInput{
+		Id:    &identifier.ID,
+		Name:  &identifier.Name,
+		Scope: identifier.Scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.RegexPatternSet == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "regex pattern set " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return &wafv2RegexPatternSet{
+		RegexPatternSet: out.RegexPatternSet,
+		Scope:           identifier.Scope,
+	}, nil
+}
+
+func wafv2RegexPatternSetListFunc(ctx context.Context, client *wafv2.Client, scope string) ([]*wafv2RegexPatternSet, error) {
+	sets := make([]*wafv2RegexPatternSet, 0)
+
+	for _, wafScope := range wafv2Scopes(scope) {
+		input := &wafv2.ListRegexPatternSetsInput{
+			Scope: wafScope,
+		}
+
+		for {
+			out, err := client.ListRegexPatternSets(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, summary := range out.RegexPatternSets {
+				set, err := wafv2RegexPatternSetGetFunc(ctx, client, scope, wafv2UniqueName(wafScope, summary.Name, summary.Id))
+				if err != nil {
+					return nil, err
+				}
+
+				sets = append(sets, set)
+			}
+
+			if out.NextMarker == nil || *out.NextMarker == "" {
+				break
+			}
+
+			input.NextMarker = out.NextMarker
+		}
+	}
+
+	return sets, nil
+}
+
+func wafv2RegexPatternSetSearchFunc(ctx context.Context, client *wafv2.Client, scope string, query string) ([]*wafv2RegexPatternSet, error) {
+	identifier, err := parseWAFv2ARN(query, "regexpatternset")
+	if err != nil {
+		return nil, wafv2ARNQueryError(err, scope)
+	}
+
+	set, err := wafv2RegexPatternSetGetFunc(ctx, client, scope, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*wafv2RegexPatternSet{set}, nil
+}
+
+func wafv2RegexPatternSetListTagsFunc(ctx context.Context, set *wafv2RegexPatternSet, client *wafv2.Client) (map[string]string, error) {
+	return wafv2Tags(ctx, client, set.ARN)
+}
+
+func wafv2RegexPatternSetItemMapper(_, scope string, set *wafv2RegexPatternSet) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(set)
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", wafv2UniqueName(set.Scope, set.Name, set.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "wafv2-regex-pattern-set",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	return &item, nil
+}
+
+func NewWAFv2RegexPatternSetAdapter(client *wafv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*wafv2RegexPatternSet, *wafv2.Client, *wafv2.Options] {
+	return &adapterhelpers.GetListAdapter[*wafv2RegexPatternSet, *wafv2.Client, *wafv2.Options]{
+		ItemType:        "wafv2-regex-pattern-set",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: wafv2RegexPatternSetAdapterMetadata,
+		GetFunc:         wafv2RegexPatternSetGetFunc,
+		ListFunc:        wafv2RegexPatternSetListFunc,
+		SearchFunc:      wafv2RegexPatternSetSearchFunc,
+		ListTagsFunc:    wafv2RegexPatternSetListTagsFunc,
+		ItemMapper:      wafv2RegexPatternSetItemMapper,
+	}
+}
+
+var wafv2RegexPatternSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "wafv2-regex-pattern-set",
+	DescriptiveName: "WAFv2 Regex Pattern Set",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a regex pattern set by {scope}/{name}/{id}, where scope is REGIONAL or CLOUDFRONT",
+		ListDescription:   "List all regex pattern sets. CloudFront regex pattern sets are only listed in us-east-1",
+		SearchDescription: "Search for a regex pattern set by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_regex_pattern_set.arn",
+		},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var wafv2RegexPatternSetAdapterIAMActions = IAMActions.Register(wafv2RegexPatternSetAdapterMetadata,
+	"wafv2:GetRegexPatternSet",
+	"wafv2:ListRegexPatternSets",
+	"wafv2:ListTagsForResource",
+)
@@ -0,0 +1,54 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func TestWAFv2RegexPatternSetItemMapper(t *testing.T) {
+	set := &wafv2RegexPatternSet{
+		RegexPatternSet: &types.RegexPatternSet{
+			ARN:  adapterhelpers.PtrString("arn:aws:wafv2:eu-west-2:123456789012:regional/regexpatternset/paths/e5f6g7h8"),
+			Id:   adapterhelpers.PtrString("e5f6g7h8"),
+			Name: adapterhelpers.PtrString("paths"),
+			RegularExpressionList: []types.Regex{
+				{
+					RegexString: adapterhelpers.PtrString("^/admin"),
+				},
+			},
+		},
+		Scope: types.ScopeRegional,
+	}
+
+	item, err := wafv2RegexPatternSetItemMapper("", "123456789012.eu-west-2", set)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.UniqueAttributeValue() != "REGIONAL/paths/e5f6g7h8" {
+		t.Errorf("expected unique attribute REGIONAL/paths/e5f6g7h8, got %v", item.UniqueAttributeValue())
+	}
+}
+
+func TestNewWAFv2RegexPatternSetAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := wafv2.NewFromConfig(config)
+
+	adapter := NewWAFv2RegexPatternSetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,166 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type wafv2RuleGroup struct {
+	*types.RuleGroup
+	Scope types.Scope
+}
+
+func wafv2RuleGroupGetFunc(ctx context.Context, client *wafv2.Client, scope string, query string) (*wafv2RuleGroup, error) {
+	identifier, err := parseWAFv2UniqueName(query)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	out, err := client.GetRuleGroup(ctx, &wafv2.GetRuleGroupInput{
+		Id:    &identifier.ID,
+		Name:  &identifier.Name,
+		Scope: identifier.Scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.RuleGroup == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "rule group " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	return &wafv2RuleGroup{
+		RuleGroup: out.RuleGroup,
+		Scope:     identifier.Scope,
+	}, nil
+}
+
+func wafv2RuleGroupListFunc(ctx context.Context, client *wafv2.Client, scope string) ([]*wafv2RuleGroup, error) {
+	groups := make([]*wafv2RuleGroup, 0)
+
+	for _, wafScope := range wafv2Scopes(scope) {
+		input := &wafv2.ListRuleGroupsInput{
+			Scope: wafScope,
+		}
+
+		for {
+			out, err := client.ListRuleGroups(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, summary := range out.RuleGroups {
+				group, err := wafv2RuleGroupGetFunc(ctx, client, scope, wafv2UniqueName(wafScope, summary.Name, summary.Id))
+				if err != nil {
+					return nil, err
+				}
+
+				groups = append(groups, group)
+			}
+
+			if out.NextMarker == nil || *out.NextMarker == "" {
+				break
+			}
+
+			input.NextMarker = out.NextMarker
+		}
+	}
+
+	return groups, nil
+}
+
+func wafv2RuleGroupSearchFunc(ctx context.Context, client *wafv2.Client, scope string, query string) ([]*wafv2RuleGroup, error) {
+	identifier, err := parseWAFv2ARN(query, "rulegroup")
+	if err != nil {
+		return nil, wafv2ARNQueryError(err, scope)
+	}
+
+	group, err := wafv2RuleGroupGetFunc(ctx, client, scope, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*wafv2RuleGroup{group}, nil
+}
+
+func wafv2RuleGroupListTagsFunc(ctx context.Context, group *wafv2RuleGroup, client *wafv2.Client) (map[string]string, error) {
+	return wafv2Tags(ctx, client, group.ARN)
+}
+
+func wafv2RuleGroupItemMapper(_, scope string, group *wafv2RuleGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(group)
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", wafv2UniqueName(group.Scope, group.Name, group.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "wafv2-rule-group",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, wafv2RuleLinks(group.Rules)...)
+
+	return &item, nil
+}
+
+func NewWAFv2RuleGroupAdapter(client *wafv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*wafv2RuleGroup, *wafv2.Client, *wafv2.Options] {
+	return &adapterhelpers.GetListAdapter[*wafv2RuleGroup, *wafv2.Client, *wafv2.Options]{
+		ItemType:        "wafv2-rule-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: wafv2RuleGroupAdapterMetadata,
+		GetFunc:         wafv2RuleGroupGetFunc,
+		ListFunc:        wafv2RuleGroupListFunc,
+		SearchFunc:      wafv2RuleGroupSearchFunc,
+		ListTagsFunc:    wafv2RuleGroupListTagsFunc,
+		ItemMapper:      wafv2RuleGroupItemMapper,
+	}
+}
+
+var wafv2RuleGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "wafv2-rule-group",
+	DescriptiveName: "WAFv2 Rule Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a rule group by {scope}/{name}/{id}, where scope is REGIONAL or CLOUDFRONT",
+		ListDescription:   "List all rule groups. CloudFront rule groups are only listed in us-east-1",
+		SearchDescription: "Search for a rule group by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_rule_group.arn",
+		},
+	},
+	PotentialLinks: []string{"wafv2-ip-set", "wafv2-regex-pattern-set", "wafv2-rule-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var wafv2RuleGroupAdapterIAMActions = IAMActions.Register(wafv2RuleGroupAdapterMetadata,
+	"wafv2:GetRuleGroup",
+	"wafv2:ListRuleGroups",
+	"wafv2:ListTagsForResource",
+)
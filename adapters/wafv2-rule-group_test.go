@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestWAFv2RuleGroupItemMapper(t *testing.T) {
+	group := &wafv2RuleGroup{
+		RuleGroup: &types.RuleGroup{
+			ARN:      adapterhelpers.PtrString("arn:aws:wafv2:us-east-1:123456789012:global/rulegroup/custom/e5f6g7h8"),
+			Id:       adapterhelpers.PtrString("e5f6g7h8"),
+			Name:     adapterhelpers.PtrString("custom"),
+			Capacity: adapterhelpers.PtrInt64(10),
+			Rules: []types.Rule{
+				{
+					Name:     adapterhelpers.PtrString("block-ips"),
+					Priority: 0,
+					Statement: &types.Statement{
+						IPSetReferenceStatement: &types.IPSetReferenceStatement{
+							ARN: adapterhelpers.PtrString("arn:aws:wafv2:us-east-1:123456789012:global/ipset/blocked/a1b2c3d4"),
+						},
+					},
+				},
+			},
+		},
+		Scope: types.ScopeCloudfront,
+	}
+
+	item, err := wafv2RuleGroupItemMapper("", "123456789012.us-east-1", group)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.UniqueAttributeValue() != "CLOUDFRONT/custom/e5f6g7h8" {
+		t.Errorf("expected unique attribute CLOUDFRONT/custom/e5f6g7h8, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "wafv2-ip-set",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:wafv2:us-east-1:123456789012:global/ipset/blocked/a1b2c3d4",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewWAFv2RuleGroupAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := wafv2.NewFromConfig(config)
+
+	adapter := NewWAFv2RuleGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,326 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// wafv2AssociatedResourceTypes The types of regional resource that a web ACL
+// can be associated with. ListResourcesForWebACL only returns one type at a
+// time, and CloudFront associations are stored on the distribution instead
+var wafv2AssociatedResourceTypes = []types.ResourceType{
+	types.ResourceTypeApplicationLoadBalancer,
+	types.ResourceTypeApiGateway,
+	types.ResourceTypeAppsync,
+	types.ResourceTypeCognitioUserPool,
+}
+
+type wafv2WebACL struct {
+	*types.WebACL
+	Scope                types.Scope
+	LoggingConfiguration *types.LoggingConfiguration
+	AssociatedResources  []string
+}
+
+func wafv2WebACLGetFunc(ctx context.Context, client *wafv2.Client, scope string, query string) (*wafv2WebACL, error) {
+	identifier, err := parseWAFv2UniqueName(query)
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+			Scope:       scope,
+		}
+	}
+
+	out, err := client.GetWebACL(ctx, &wafv2.GetWebACLInput{
+		Id:    &identifier.ID,
+		Name:  &identifier.Name,
+		Scope: identifier.Scope,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if out.WebACL == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "web ACL " + query + " not found",
+			Scope:       scope,
+		}
+	}
+
+	acl := wafv2WebACL{
+		WebACL: out.WebACL,
+		Scope:  identifier.Scope,
+	}
+
+	// Logging is optional and returns an error when it isn't configured, so we
+	// still want to return the ACL if this fails
+	logging, err := client.GetLoggingConfiguration(ctx, &wafv2.GetLoggingConfigurationInput{
+		ResourceArn: out.WebACL.ARN,
+	})
+	if err == nil {
+		acl.LoggingConfiguration = logging.LoggingConfiguration
+	}
+
+	if identifier.Scope == types.ScopeRegional {
+		for _, resourceType := range wafv2AssociatedResourceTypes {
+			resources, err := client.ListResourcesForWebACL(ctx, &wafv2.ListResourcesForWebACLInput{
+				WebACLArn:    out.WebACL.ARN,
+				ResourceType: resourceType,
+			})
+			if err != nil {
+				// Not all resource types are supported in every region
+				continue
+			}
+
+			acl.AssociatedResources = append(acl.AssociatedResources, resources.ResourceArns...)
+		}
+	}
+
+	return &acl, nil
+}
+
+func wafv2WebACLListFunc(ctx context.Context, client *wafv2.Client, scope string) ([]*wafv2WebACL, error) {
+	acls := make([]*wafv2WebACL, 0)
+
+	for _, wafScope := range wafv2Scopes(scope) {
+		input := &wafv2.ListWebACLsInput{
+			Scope: wafScope,
+		}
+
+		for {
+			out, err := client.ListWebACLs(ctx, input)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, summary := range out.WebACLs {
+				acl, err := wafv2WebACLGetFunc(ctx, client, scope, wafv2UniqueName(wafScope, summary.Name, summary.Id))
+				if err != nil {
+					return nil, err
+				}
+
+				acls = append(acls, acl)
+			}
+
+			if out.NextMarker == nil || *out.NextMarker == "" {
+				break
+			}
+
+			input.NextMarker = out.NextMarker
+		}
+	}
+
+	return acls, nil
+}
+
+func wafv2WebACLSearchFunc(ctx context.Context, client *wafv2.Client, scope string, query string) ([]*wafv2WebACL, error) {
+	identifier, err := parseWAFv2ARN(query, "webacl")
+	if err != nil {
+		return nil, wafv2ARNQueryError(err, scope)
+	}
+
+	acl, err := wafv2WebACLGetFunc(ctx, client, scope, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return []*wafv2WebACL{acl}, nil
+}
+
+func wafv2WebACLListTagsFunc(ctx context.Context, acl *wafv2WebACL, client *wafv2.Client) (map[string]string, error) {
+	return wafv2Tags(ctx, client, acl.ARN)
+}
+
+// wafv2AssociatedResourceLink Links to a resource that the web ACL protects
+func wafv2AssociatedResourceLink(arn string, scope string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(arn)
+	if err != nil {
+		return nil
+	}
+
+	query := &sdp.Query{
+		Method: sdp.QueryMethod_SEARCH,
+		Query:  arn,
+		Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+	}
+
+	switch a.Service {
+	case "elasticloadbalancing":
+		query.Type = "elbv2-load-balancer"
+	case "appsync":
+		query.Type = "appsync-graphql-api"
+	case "cognito-idp":
+		query.Type = "cognito-idp-user-pool"
+	case "apigateway":
+		// API Gateway ARNs don't include the account, they look like
+		// arn:aws:apigateway:{region}::/restapis/{restApiId}/stages/{stageName}
+		sections := strings.Split(strings.TrimPrefix(a.Resource, "/"), "/")
+		if len(sections) != 4 || sections[0] != "restapis" || sections[2] != "stages" {
+			return nil
+		}
+
+		accountID, _, err := adapterhelpers.ParseScope(scope)
+		if err != nil {
+			return nil
+		}
+
+		query.Type = "apigateway-stage"
+		query.Method = sdp.QueryMethod_GET
+		query.Query = sections[1] + "/" + sections[3]
+		query.Scope = adapterhelpers.FormatScope(accountID, a.Region)
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the ACL changes which requests reach the resource
+			Out: true,
+			// The resource can't affect the ACL
+			In: false,
+		},
+	}
+}
+
+// wafv2LogDestinationLink Links to a log destination, which can be a log
+// group, a Firehose delivery stream or an S3 bucket
+func wafv2LogDestinationLink(arn string, scope string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(arn)
+	if err != nil {
+		return nil
+	}
+
+	if a.Service != "s3" {
+		return logDestinationLink(arn)
+	}
+
+	// Bucket ARNs don't include the account
+	accountID, _, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "s3-bucket",
+			Method: sdp.QueryMethod_GET,
+			Query:  a.Resource,
+			Scope:  adapterhelpers.FormatScope(accountID, ""),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// If the bucket is deleted, logs will be lost
+			In: true,
+			// The ACL writes logs to the bucket
+			Out: true,
+		},
+	}
+}
+
+func wafv2WebACLItemMapper(_, scope string, acl *wafv2WebACL) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(acl)
+	if err != nil {
+		return nil, err
+	}
+
+	err = attributes.Set("UniqueName", wafv2UniqueName(acl.Scope, acl.Name, acl.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "wafv2-web-acl",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// Rule groups added by Firewall Manager are evaluated the same way as
+	// rules, so treat them as rules when looking for references
+	rules := acl.Rules
+	for _, groups := range [][]types.FirewallManagerRuleGroup{acl.PreProcessFirewallManagerRuleGroups, acl.PostProcessFirewallManagerRuleGroups} {
+		for _, group := range groups {
+			if group.FirewallManagerStatement == nil {
+				continue
+			}
+
+			rules = append(rules, types.Rule{
+				Name: group.Name,
+				Statement: &types.Statement{
+					ManagedRuleGroupStatement:   group.FirewallManagerStatement.ManagedRuleGroupStatement,
+					RuleGroupReferenceStatement: group.FirewallManagerStatement.RuleGroupReferenceStatement,
+				},
+			})
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, wafv2RuleLinks(rules)...)
+
+	for _, arn := range acl.AssociatedResources {
+		if link := wafv2AssociatedResourceLink(arn, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if acl.LoggingConfiguration != nil {
+		for _, destination := range acl.LoggingConfiguration.LogDestinationConfigs {
+			if link := wafv2LogDestinationLink(destination, scope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewWAFv2WebACLAdapter(client *wafv2.Client, accountID string, region string) *adapterhelpers.GetListAdapter[*wafv2WebACL, *wafv2.Client, *wafv2.Options] {
+	return &adapterhelpers.GetListAdapter[*wafv2WebACL, *wafv2.Client, *wafv2.Options]{
+		ItemType:        "wafv2-web-acl",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: wafv2WebACLAdapterMetadata,
+		GetFunc:         wafv2WebACLGetFunc,
+		ListFunc:        wafv2WebACLListFunc,
+		SearchFunc:      wafv2WebACLSearchFunc,
+		ListTagsFunc:    wafv2WebACLListTagsFunc,
+		ItemMapper:      wafv2WebACLItemMapper,
+	}
+}
+
+var wafv2WebACLAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "wafv2-web-acl",
+	DescriptiveName: "WAFv2 Web ACL",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a web ACL by {scope}/{name}/{id}, where scope is REGIONAL or CLOUDFRONT",
+		ListDescription:   "List all web ACLs. CloudFront web ACLs are only listed in us-east-1",
+		SearchDescription: "Search for a web ACL by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_wafv2_web_acl.arn",
+		},
+	},
+	PotentialLinks: []string{"wafv2-ip-set", "wafv2-regex-pattern-set", "wafv2-rule-group", "elbv2-load-balancer", "apigateway-stage", "appsync-graphql-api", "cognito-idp-user-pool", "logs-log-group", "firehose-delivery-stream", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
+
+var wafv2WebACLAdapterIAMActions = IAMActions.Register(wafv2WebACLAdapterMetadata,
+	"wafv2:GetLoggingConfiguration",
+	"wafv2:GetWebACL",
+	"wafv2:ListResourcesForWebACL",
+	"wafv2:ListTagsForResource",
+	"wafv2:ListWebACLs",
+)
@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestWAFv2WebACLItemMapper(t *testing.T) {
+	acl := &wafv2WebACL{
+		WebACL: &types.WebACL{
+			ARN:      adapterhelpers.PtrString("arn:aws:wafv2:eu-west-2:123456789012:regional/webacl/my-acl/a1b2c3d4"),
+			Id:       adapterhelpers.PtrString("a1b2c3d4"),
+			Name:     adapterhelpers.PtrString("my-acl"),
+			Capacity: 50,
+			DefaultAction: &types.DefaultAction{
+				Allow: &types.AllowAction{},
+			},
+			Rules: []types.Rule{
+				{
+					Name:     adapterhelpers.PtrString("custom-rules"),
+					Priority: 1,
+					Statement: &types.Statement{
+						RuleGroupReferenceStatement: &types.RuleGroupReferenceStatement{
+							ARN: adapterhelpers.PtrString("arn:aws:wafv2:eu-west-2:123456789012:regional/rulegroup/custom/e5f6g7h8"),
+						},
+					},
+				},
+			},
+			PreProcessFirewallManagerRuleGroups: []types.FirewallManagerRuleGroup{
+				{
+					Name: adapterhelpers.PtrString("fms-group"),
+					FirewallManagerStatement: &types.FirewallManagerStatement{
+						RuleGroupReferenceStatement: &types.RuleGroupReferenceStatement{
+							ARN: adapterhelpers.PtrString("arn:aws:wafv2:eu-west-2:111111111111:regional/rulegroup/fms/i9j0k1l2"),
+						},
+					},
+				},
+			},
+		},
+		Scope: types.ScopeRegional,
+		LoggingConfiguration: &types.LoggingConfiguration{
+			LogDestinationConfigs: []string{
+				"arn:aws:logs:eu-west-2:123456789012:log-group:aws-waf-logs-my-acl",
+				"arn:aws:s3:::aws-waf-logs-bucket",
+			},
+		},
+		AssociatedResources: []string{
+			"arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+			"arn:aws:apigateway:eu-west-2::/restapis/abc123/stages/prod",
+			"arn:aws:appsync:eu-west-2:123456789012:apis/xyz789",
+			"arn:aws:cognito-idp:eu-west-2:123456789012:userpool/eu-west-2_abcdef",
+		},
+	}
+
+	item, err := wafv2WebACLItemMapper("", "123456789012.eu-west-2", acl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.UniqueAttributeValue() != "REGIONAL/my-acl/a1b2c3d4" {
+		t.Errorf("expected unique attribute REGIONAL/my-acl/a1b2c3d4, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "wafv2-rule-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:wafv2:eu-west-2:123456789012:regional/rulegroup/custom/e5f6g7h8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "wafv2-rule-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:wafv2:eu-west-2:111111111111:regional/rulegroup/fms/i9j0k1l2",
+			ExpectedScope:  "111111111111.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "apigateway-stage",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "abc123/prod",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "appsync-graphql-api",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:appsync:eu-west-2:123456789012:apis/xyz789",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:cognito-idp:eu-west-2:123456789012:userpool/eu-west-2_abcdef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:123456789012:log-group:aws-waf-logs-my-acl",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "aws-waf-logs-bucket",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewWAFv2WebACLAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := wafv2.NewFromConfig(config)
+
+	adapter := NewWAFv2WebACLAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,274 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// wafv2Identifier Every WAFv2 resource needs a scope, name and ID to be
+// retrieved. The scope is REGIONAL for resources that protect regional
+// resources, or CLOUDFRONT for resources that protect CloudFront
+// distributions, which only exist in us-east-1
+type wafv2Identifier struct {
+	Scope types.Scope
+	Name  string
+	ID    string
+}
+
+// String Returns the unique name of the resource in the format
+// {scope}/{name}/{id}. Names can't contain slashes so this can always be split
+// safely
+func (w wafv2Identifier) String() string {
+	return string(w.Scope) + "/" + w.Name + "/" + w.ID
+}
+
+// wafv2UniqueName Returns the unique name of a WAFv2 resource, see
+// wafv2Identifier.String
+func wafv2UniqueName(scope types.Scope, name *string, id *string) string {
+	identifier := wafv2Identifier{
+		Scope: scope,
+	}
+
+	if name != nil {
+		identifier.Name = *name
+	}
+
+	if id != nil {
+		identifier.ID = *id
+	}
+
+	return identifier.String()
+}
+
+// parseWAFv2UniqueName Parses a query in the format {scope}/{name}/{id}
+func parseWAFv2UniqueName(query string) (wafv2Identifier, error) {
+	sections := strings.Split(query, "/")
+
+	if len(sections) != 3 {
+		return wafv2Identifier{}, fmt.Errorf("query must be in the format {scope}/{name}/{id}, got: %v", query)
+	}
+
+	scope := types.Scope(sections[0])
+
+	switch scope {
+	case types.ScopeRegional, types.ScopeCloudfront:
+	default:
+		return wafv2Identifier{}, fmt.Errorf("scope must be %v or %v, got: %v", types.ScopeRegional, types.ScopeCloudfront, sections[0])
+	}
+
+	return wafv2Identifier{
+		Scope: scope,
+		Name:  sections[1],
+		ID:    sections[2],
+	}, nil
+}
+
+// parseWAFv2ARN Parses the ARN of a WAFv2 resource and checks that it is of the
+// expected type. The ARNs look like:
+//
+// arn:aws:wafv2:{region}:{account}:{regional|global}/{type}/{name}/{id}
+func parseWAFv2ARN(arn string, resourceType string) (wafv2Identifier, error) {
+	a, err := adapterhelpers.ParseARN(arn)
+	if err != nil {
+		return wafv2Identifier{}, err
+	}
+
+	if a.Service != "wafv2" {
+		return wafv2Identifier{}, fmt.Errorf("ARN is not a WAFv2 ARN: %v", arn)
+	}
+
+	sections := strings.Split(a.Resource, "/")
+
+	if len(sections) != 4 {
+		return wafv2Identifier{}, fmt.Errorf("unexpected WAFv2 ARN resource: %v", a.Resource)
+	}
+
+	if sections[1] != resourceType {
+		return wafv2Identifier{}, fmt.Errorf("ARN is for a %v, not a %v", sections[1], resourceType)
+	}
+
+	identifier := wafv2Identifier{
+		Name: sections[2],
+		ID:   sections[3],
+	}
+
+	switch sections[0] {
+	case "regional":
+		identifier.Scope = types.ScopeRegional
+	case "global":
+		identifier.Scope = types.ScopeCloudfront
+	default:
+		return wafv2Identifier{}, fmt.Errorf("unexpected WAFv2 ARN scope: %v", sections[0])
+	}
+
+	return identifier, nil
+}
+
+// wafv2ARNQueryError Returns the error to use when a search query isn't a
+// valid ARN
+func wafv2ARNQueryError(err error, scope string) error {
+	return &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: err.Error(),
+		Scope:       scope,
+	}
+}
+
+// wafv2Scopes Returns the WAF scopes that should be listed for a given adapter
+// scope. CloudFront resources can only be accessed through us-east-1, so they
+// are only returned by the adapter in that region
+func wafv2Scopes(scope string) []types.Scope {
+	scopes := []types.Scope{types.ScopeRegional}
+
+	if _, region, err := adapterhelpers.ParseScope(scope); err == nil && region == "us-east-1" {
+		scopes = append(scopes, types.ScopeCloudfront)
+	}
+
+	return scopes
+}
+
+// wafv2Tags Returns the tags for any WAFv2 resource
+func wafv2Tags(ctx context.Context, client *wafv2.Client, arn *string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	if arn == nil {
+		return tags, nil
+	}
+
+	input := &wafv2.ListTagsForResourceInput{
+		ResourceARN: arn,
+	}
+
+	for {
+		out, err := client.ListTagsForResource(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		if out.TagInfoForResource != nil {
+			for _, tag := range out.TagInfoForResource.TagList {
+				if tag.Key != nil && tag.Value != nil {
+					tags[*tag.Key] = *tag.Value
+				}
+			}
+		}
+
+		if out.NextMarker == nil || *out.NextMarker == "" {
+			break
+		}
+
+		input.NextMarker = out.NextMarker
+	}
+
+	return tags, nil
+}
+
+// wafv2ReferenceLink Links to an IP set, regex pattern set or rule group that
+// is referenced by ARN from a rule
+func wafv2ReferenceLink(itemType string, arn *string) *sdp.LinkedItemQuery {
+	if arn == nil {
+		return nil
+	}
+
+	a, err := adapterhelpers.ParseARN(*arn)
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   itemType,
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *arn,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the referenced resource changes which requests the
+			// rule matches
+			In: true,
+			// The rule can't affect the referenced resource
+			Out: false,
+		},
+	}
+}
+
+// wafv2StatementLinks Returns links to the resources referenced by a
+// statement, including those referenced by any nested statements
+func wafv2StatementLinks(statement *types.Statement) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if statement == nil {
+		return links
+	}
+
+	if statement.IPSetReferenceStatement != nil {
+		if link := wafv2ReferenceLink("wafv2-ip-set", statement.IPSetReferenceStatement.ARN); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	if statement.RegexPatternSetReferenceStatement != nil {
+		if link := wafv2ReferenceLink("wafv2-regex-pattern-set", statement.RegexPatternSetReferenceStatement.ARN); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	if statement.RuleGroupReferenceStatement != nil {
+		if link := wafv2ReferenceLink("wafv2-rule-group", statement.RuleGroupReferenceStatement.ARN); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	if statement.AndStatement != nil {
+		for i := range statement.AndStatement.Statements {
+			links = append(links, wafv2StatementLinks(&statement.AndStatement.Statements[i])...)
+		}
+	}
+
+	if statement.OrStatement != nil {
+		for i := range statement.OrStatement.Statements {
+			links = append(links, wafv2StatementLinks(&statement.OrStatement.Statements[i])...)
+		}
+	}
+
+	if statement.NotStatement != nil {
+		links = append(links, wafv2StatementLinks(statement.NotStatement.Statement)...)
+	}
+
+	if statement.RateBasedStatement != nil {
+		links = append(links, wafv2StatementLinks(statement.RateBasedStatement.ScopeDownStatement)...)
+	}
+
+	if statement.ManagedRuleGroupStatement != nil {
+		links = append(links, wafv2StatementLinks(statement.ManagedRuleGroupStatement.ScopeDownStatement)...)
+	}
+
+	return links
+}
+
+// wafv2RuleLinks Returns links to everything referenced by a set of rules. The
+// same set is often referenced by more than one rule so duplicates are removed
+func wafv2RuleLinks(rules []types.Rule) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+	seen := make(map[string]bool)
+
+	for i := range rules {
+		for _, link := range wafv2StatementLinks(rules[i].Statement) {
+			key := link.GetQuery().GetType() + link.GetQuery().GetQuery()
+
+			if !seen[key] {
+				seen[key] = true
+				links = append(links, link)
+			}
+		}
+	}
+
+	return links
+}
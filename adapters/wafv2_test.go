@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestParseWAFv2UniqueName(t *testing.T) {
+	identifier, err := parseWAFv2UniqueName("CLOUDFRONT/my-acl/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if identifier.Scope != types.ScopeCloudfront {
+		t.Errorf("expected scope CLOUDFRONT, got %v", identifier.Scope)
+	}
+
+	if identifier.Name != "my-acl" {
+		t.Errorf("expected name my-acl, got %v", identifier.Name)
+	}
+
+	if identifier.ID != "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111" {
+		t.Errorf("expected ID a1b2c3d4-5678-90ab-cdef-EXAMPLE11111, got %v", identifier.ID)
+	}
+
+	for _, query := range []string{"my-acl", "my-acl/a1b2c3d4", "GLOBAL/my-acl/a1b2c3d4"} {
+		if _, err := parseWAFv2UniqueName(query); err == nil {
+			t.Errorf("expected error for query %v", query)
+		}
+	}
+}
+
+func TestParseWAFv2ARN(t *testing.T) {
+	identifier, err := parseWAFv2ARN("arn:aws:wafv2:us-east-1:123456789012:global/webacl/my-acl/a1b2c3d4", "webacl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if identifier.String() != "CLOUDFRONT/my-acl/a1b2c3d4" {
+		t.Errorf("expected CLOUDFRONT/my-acl/a1b2c3d4, got %v", identifier.String())
+	}
+
+	identifier, err = parseWAFv2ARN("arn:aws:wafv2:eu-west-2:123456789012:regional/ipset/my-set/a1b2c3d4", "ipset")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if identifier.String() != "REGIONAL/my-set/a1b2c3d4" {
+		t.Errorf("expected REGIONAL/my-set/a1b2c3d4, got %v", identifier.String())
+	}
+
+	if _, err = parseWAFv2ARN("arn:aws:wafv2:eu-west-2:123456789012:regional/ipset/my-set/a1b2c3d4", "webacl"); err == nil {
+		t.Error("expected error for an ARN of the wrong type")
+	}
+}
+
+func TestWAFv2Scopes(t *testing.T) {
+	if scopes := wafv2Scopes("123456789012.eu-west-2"); len(scopes) != 1 {
+		t.Errorf("expected 1 scope, got %v", scopes)
+	}
+
+	if scopes := wafv2Scopes("123456789012.us-east-1"); len(scopes) != 2 {
+		t.Errorf("expected 2 scopes, got %v", scopes)
+	}
+}
+
+func TestWAFv2RuleLinks(t *testing.T) {
+	ipSetARN := "arn:aws:wafv2:eu-west-2:123456789012:regional/ipset/blocked/a1b2c3d4"
+
+	rules := []types.Rule{
+		{
+			Name: adapterhelpers.PtrString("block-ips"),
+			Statement: &types.Statement{
+				IPSetReferenceStatement: &types.IPSetReferenceStatement{
+					ARN: &ipSetARN,
+				},
+			},
+		},
+		{
+			Name: adapterhelpers.PtrString("rate-limit"),
+			Statement: &types.Statement{
+				RateBasedStatement: &types.RateBasedStatement{
+					ScopeDownStatement: &types.Statement{
+						AndStatement: &types.AndStatement{
+							Statements: []types.Statement{
+								{
+									NotStatement: &types.NotStatement{
+										Statement: &types.Statement{
+											IPSetReferenceStatement: &types.IPSetReferenceStatement{
+												ARN: &ipSetARN,
+											},
+										},
+									},
+								},
+								{
+									RegexPatternSetReferenceStatement: &types.RegexPatternSetReferenceStatement{
+										ARN: adapterhelpers.PtrString("arn:aws:wafv2:eu-west-2:123456789012:regional/regexpatternset/paths/e5f6g7h8"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	links := wafv2RuleLinks(rules)
+
+	// The IP set is referenced twice but should only be linked once
+	if len(links) != 2 {
+		t.Fatalf("expected 2 links, got %v", len(links))
+	}
+
+	if links[0].GetQuery().GetType() != "wafv2-ip-set" {
+		t.Errorf("expected wafv2-ip-set, got %v", links[0].GetQuery().GetType())
+	}
+
+	if links[1].GetQuery().GetType() != "wafv2-regex-pattern-set" {
+		t.Errorf("expected wafv2-regex-pattern-set, got %v", links[1].GetQuery().GetType())
+	}
+
+	if links[1].GetQuery().GetMethod() != sdp.QueryMethod_SEARCH {
+		t.Errorf("expected SEARCH, got %v", links[1].GetQuery().GetMethod())
+	}
+}
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/overmindtech/aws-source/adapters"
+	"github.com/overmindtech/sdp-go"
+	"github.com/spf13/cobra"
+)
+
+// metadataCmd represents the metadata command
+var metadataCmd = &cobra.Command{
+	Use:   "metadata",
+	Short: "Export adapter metadata and the IAM policy that the adapters need",
+	Long: `Exports the metadata for all adapters in this source, including the types,
+supported query methods, potential links, Terraform mappings and the IAM actions
+that each adapter calls.
+
+Valid formats are:
+
+  json        The metadata for each adapter as JSON
+  markdown    The metadata for each adapter as Markdown, for documentation
+  iam-policy  The minimal IAM policy required for the selected adapters
+
+Use --type to limit the output to a subset of adapters.
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, err := cmd.Flags().GetString("format")
+		if err != nil {
+			return err
+		}
+
+		types, err := cmd.Flags().GetStringSlice("type")
+		if err != nil {
+			return err
+		}
+
+		metadata, err := selectAdapterMetadata(types)
+		if err != nil {
+			return err
+		}
+
+		switch format {
+		case "json":
+			return writeMetadataJSON(os.Stdout, metadata)
+		case "markdown":
+			return writeMetadataMarkdown(os.Stdout, metadata)
+		case "iam-policy":
+			return writeIAMPolicy(os.Stdout, metadata)
+		default:
+			return fmt.Errorf("unknown format %q, valid formats are json, markdown and iam-policy", format)
+		}
+	},
+}
+
+// adapterMetadataExport The exported form of an adapter's metadata, including
+// the IAM actions that it calls
+type adapterMetadataExport struct {
+	Type                  string                   `json:"type"`
+	DescriptiveName       string                   `json:"descriptiveName"`
+	Category              string                   `json:"category"`
+	SupportedQueryMethods queryMethodsExport       `json:"supportedQueryMethods"`
+	PotentialLinks        []string                 `json:"potentialLinks"`
+	TerraformMappings     []terraformMappingExport `json:"terraformMappings"`
+	IAMActions            []string                 `json:"iamActions"`
+}
+
+type queryMethodsExport struct {
+	Get               bool   `json:"get"`
+	GetDescription    string `json:"getDescription,omitempty"`
+	List              bool   `json:"list"`
+	ListDescription   string `json:"listDescription,omitempty"`
+	Search            bool   `json:"search"`
+	SearchDescription string `json:"searchDescription,omitempty"`
+}
+
+type terraformMappingExport struct {
+	Method   string `json:"method"`
+	QueryMap string `json:"queryMap"`
+}
+
+// selectAdapterMetadata Returns the metadata for the given types, sorted by
+// type. If no types are given then all adapters are returned
+func selectAdapterMetadata(types []string) ([]*sdp.AdapterMetadata, error) {
+	all := adapters.Metadata.AllAdapterMetadata()
+
+	selected := make([]*sdp.AdapterMetadata, 0, len(all))
+	if len(types) == 0 {
+		selected = append(selected, all...)
+	} else {
+		byType := make(map[string]*sdp.AdapterMetadata, len(all))
+		for _, m := range all {
+			byType[m.GetType()] = m
+		}
+
+		for _, t := range types {
+			m, ok := byType[t]
+			if !ok {
+				return nil, fmt.Errorf("unknown adapter type %q", t)
+			}
+
+			selected = append(selected, m)
+		}
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].GetType() < selected[j].GetType()
+	})
+
+	return selected, nil
+}
+
+func exportAdapterMetadata(m *sdp.AdapterMetadata) adapterMetadataExport {
+	e := adapterMetadataExport{
+		Type:              m.GetType(),
+		DescriptiveName:   m.GetDescriptiveName(),
+		Category:          m.GetCategory().String(),
+		PotentialLinks:    m.GetPotentialLinks(),
+		TerraformMappings: make([]terraformMappingExport, 0),
+		IAMActions:        adapters.IAMActions.ForType(m.GetType()),
+	}
+
+	if methods := m.GetSupportedQueryMethods(); methods != nil {
+		e.SupportedQueryMethods = queryMethodsExport{
+			Get:               methods.GetGet(),
+			GetDescription:    methods.GetGetDescription(),
+			List:              methods.GetList(),
+			ListDescription:   methods.GetListDescription(),
+			Search:            methods.GetSearch(),
+			SearchDescription: methods.GetSearchDescription(),
+		}
+	}
+
+	for _, tm := range m.GetTerraformMappings() {
+		e.TerraformMappings = append(e.TerraformMappings, terraformMappingExport{
+			Method:   tm.GetTerraformMethod().String(),
+			QueryMap: tm.GetTerraformQueryMap(),
+		})
+	}
+
+	if e.PotentialLinks == nil {
+		e.PotentialLinks = []string{}
+	}
+
+	if e.IAMActions == nil {
+		e.IAMActions = []string{}
+	}
+
+	return e
+}
+
+func writeMetadataJSON(w io.Writer, metadata []*sdp.AdapterMetadata) error {
+	exports := make([]adapterMetadataExport, len(metadata))
+	for i, m := range metadata {
+		exports[i] = exportAdapterMetadata(m)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(exports)
+}
+
+func writeMetadataMarkdown(w io.Writer, metadata []*sdp.AdapterMetadata) error {
+	var b strings.Builder
+
+	b.WriteString("# AWS Source Adapters\n\n")
+	b.WriteString("| Type | Name | Category | Get | List | Search |\n")
+	b.WriteString("|------|------|----------|-----|------|--------|\n")
+
+	for _, m := range metadata {
+		e := exportAdapterMetadata(m)
+		fmt.Fprintf(&b, "| `%v` | %v | %v | %v | %v | %v |\n",
+			e.Type,
+			e.DescriptiveName,
+			markdownCategory(e.Category),
+			markdownCheck(e.SupportedQueryMethods.Get),
+			markdownCheck(e.SupportedQueryMethods.List),
+			markdownCheck(e.SupportedQueryMethods.Search),
+		)
+	}
+
+	for _, m := range metadata {
+		e := exportAdapterMetadata(m)
+
+		fmt.Fprintf(&b, "\n## `%v`\n\n%v\n\n", e.Type, e.DescriptiveName)
+
+		methods := []struct {
+			name        string
+			supported   bool
+			description string
+		}{
+			{"Get", e.SupportedQueryMethods.Get, e.SupportedQueryMethods.GetDescription},
+			{"List", e.SupportedQueryMethods.List, e.SupportedQueryMethods.ListDescription},
+			{"Search", e.SupportedQueryMethods.Search, e.SupportedQueryMethods.SearchDescription},
+		}
+
+		for _, method := range methods {
+			if method.supported {
+				fmt.Fprintf(&b, "* **%v:** %v\n", method.name, method.description)
+			}
+		}
+
+		if len(e.PotentialLinks) > 0 {
+			fmt.Fprintf(&b, "\n**Potential links:** %v\n", markdownCodeList(e.PotentialLinks))
+		}
+
+		if len(e.TerraformMappings) > 0 {
+			b.WriteString("\n**Terraform mappings:**\n\n")
+			for _, tm := range e.TerraformMappings {
+				fmt.Fprintf(&b, "* `%v` (%v)\n", tm.QueryMap, tm.Method)
+			}
+		}
+
+		if len(e.IAMActions) > 0 {
+			fmt.Fprintf(&b, "\n**IAM actions:** %v\n", markdownCodeList(e.IAMActions))
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func writeIAMPolicy(w io.Writer, metadata []*sdp.AdapterMetadata) error {
+	types := make([]string, len(metadata))
+	for i, m := range metadata {
+		types[i] = m.GetType()
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(adapters.IAMActions.Policy(types...))
+}
+
+func markdownCategory(category string) string {
+	return strings.ReplaceAll(strings.TrimPrefix(category, "ADAPTER_CATEGORY_"), "_", " ")
+}
+
+func markdownCheck(supported bool) string {
+	if supported {
+		return "✅"
+	}
+
+	return ""
+}
+
+func markdownCodeList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("`%v`", v)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func init() {
+	rootCmd.AddCommand(metadataCmd)
+
+	metadataCmd.Flags().String("format", "json", "The format to export, valid values: json, markdown, iam-policy")
+	metadataCmd.Flags().StringSlice("type", nil, "Only export the given adapter types. Can be specified multiple times or as a comma-separated list")
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -11,6 +12,8 @@ import (
 	"time"
 
 	"github.com/getsentry/sentry-go"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/aws-source/metrics"
 	"github.com/overmindtech/aws-source/proc"
 	"github.com/overmindtech/aws-source/tracing"
 	"github.com/overmindtech/discovery"
@@ -85,36 +88,71 @@ var rootCmd = &cobra.Command{
 			log.WithError(err).Fatal("Could not create AWS configs")
 		}
 
-		// Initialize the engine
-		e, err := proc.InitializeAwsSourceEngine(
-			rateLimitContext,
-			engineConfig,
-			999_999, // Very high max retries as it'll time out after 15min anyway
-			configs...,
-		)
-		if err != nil {
-			log.WithError(err).Fatal("Could not initialize AWS source")
-		}
+		// Start the HTTP server for health checks before initializing the
+		// engine. Initialization can back off for up to 15 minutes and we don't
+		// want the pod to be restarted for failing liveness checks while it
+		// does
+		status := proc.NewSourceStatus(configs...)
 
-		// Start HTTP server for status
-		healthCheckPath := "/healthz"
+		// Liveness only tells the caller that the process is up and serving
+		// requests, it doesn't depend on AWS or NATS
+		livenessHandler := func(rw http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(rw, "ok")
+		}
+		livenessPath := "/livez"
+		http.HandleFunc(livenessPath, livenessHandler)
 
-		http.HandleFunc(healthCheckPath, func(rw http.ResponseWriter, r *http.Request) {
+		// Readiness requires the adapters to have been initialized and NATS to
+		// be connected
+		readinessHandler := func(rw http.ResponseWriter, r *http.Request) {
 			ctx, span := healthCheckTracer().Start(r.Context(), "healthcheck")
 			defer span.End()
 
-			err := e.HealthCheck(ctx)
+			err := status.CheckReady(ctx)
 			if err != nil {
-				http.Error(rw, err.Error(), http.StatusInternalServerError)
+				http.Error(rw, err.Error(), http.StatusServiceUnavailable)
 				return
 			}
 
 			fmt.Fprint(rw, "ok")
+		}
+		readinessPath := "/readyz"
+		http.HandleFunc(readinessPath, readinessHandler)
+
+		// Kept for backwards compatibility. Existing deployments use this as
+		// their liveness probe, so it must not fail while adapters are still
+		// initializing or the pod would be restarted. This is the same as
+		// /livez
+		healthCheckPath := "/healthz"
+		http.HandleFunc(healthCheckPath, livenessHandler)
+
+		// Detailed status as JSON, for humans and debugging
+		statusPath := "/status"
+		http.HandleFunc(statusPath, func(rw http.ResponseWriter, r *http.Request) {
+			ctx, span := healthCheckTracer().Start(r.Context(), "status")
+			defer span.End()
+
+			ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+			defer cancel()
+
+			rw.Header().Set("Content-Type", "application/json")
+
+			err := json.NewEncoder(rw).Encode(status.Report(ctx))
+			if err != nil {
+				log.WithError(err).Error("Could not encode status")
+			}
 		})
 
+		// Serve Prometheus metrics from the same server
+		metricsPath := "/metrics"
+		http.Handle(metricsPath, metrics.Handler())
+
 		log.WithFields(log.Fields{
-			"port": healthCheckPort,
-			"path": healthCheckPath,
+			"port":           healthCheckPort,
+			"liveness-path":  livenessPath,
+			"readiness-path": readinessPath,
+			"status-path":    statusPath,
+			"metrics-path":   metricsPath,
 		}).Debug("Starting healthcheck server")
 
 		go func() {
@@ -130,10 +168,21 @@ var rootCmd = &cobra.Command{
 
 			log.WithError(err).WithFields(log.Fields{
 				"port": healthCheckPort,
-				"path": healthCheckPath,
-			}).Error("Could not start HTTP server for /healthz health checks")
+			}).Error("Could not start HTTP server for health checks")
 		}()
 
+		// Initialize the engine
+		e, err := proc.InitializeAwsSourceEngine(
+			rateLimitContext,
+			engineConfig,
+			999_999, // Very high max retries as it'll time out after 15min anyway
+			status,
+			configs...,
+		)
+		if err != nil {
+			log.WithError(err).Fatal("Could not initialize AWS source")
+		}
+
 		err = e.Start()
 		if err != nil {
 			log.WithFields(log.Fields{
@@ -147,8 +196,41 @@ var rootCmd = &cobra.Command{
 
 		<-sigs
 
+		// Drain before stopping the engine so that long-running queries such
+		// as S3 or IAM LIST queries can finish rather than being cut off. The
+		// engine stays subscribed until it is stopped, so queries that arrive
+		// while draining are still run and waited for. A second signal
+		// cancels anything that is still running
+		drainTimeout := viper.GetDuration("drain-timeout")
+		status.SetDraining()
+
+		log.WithFields(log.Fields{
+			"drain-timeout": drainTimeout.String(),
+			"in-flight":     adapterhelpers.InFlightQueries(),
+		}).Info("Draining in-flight queries")
+
+		drainCtx, drainCancel := context.WithTimeout(context.Background(), drainTimeout)
+		go func() {
+			select {
+			case <-sigs:
+				log.Warn("Received second signal, cancelling in-flight queries")
+				drainCancel()
+			case <-drainCtx.Done():
+			}
+		}()
+
+		cancelled := adapterhelpers.Drain(drainCtx)
+		drainCancel()
+
+		if cancelled > 0 {
+			log.WithField("cancelled", cancelled).Warn("Cancelled queries that did not finish before the drain timeout")
+		} else {
+			log.Info("All in-flight queries finished")
+		}
+
 		log.Info("Stopping engine")
 
+		exitCode := 0
 		err = e.Stop()
 
 		if err != nil {
@@ -156,11 +238,15 @@ var rootCmd = &cobra.Command{
 				"error": err,
 			}).Error("Could not stop engine")
 
-			os.Exit(1)
+			exitCode = 1
+		} else {
+			log.Info("Stopped")
 		}
-		log.Info("Stopped")
 
-		os.Exit(0)
+		// os.Exit skips PersistentPostRun, so flush tracing and sentry here
+		tracing.ShutdownTracing()
+
+		os.Exit(exitCode)
 	},
 }
 
@@ -198,6 +284,7 @@ func init() {
 	rootCmd.PersistentFlags().String("aws-regions", "", "Comma-separated list of AWS regions that this source should operate in")
 	rootCmd.PersistentFlags().BoolP("auto-config", "a", false, "Use the local AWS config, the same as the AWS CLI could use. This can be set up with \"aws configure\"")
 	rootCmd.PersistentFlags().IntP("health-check-port", "", 8080, "The port that the health check should run on")
+	rootCmd.PersistentFlags().Duration("drain-timeout", 20*time.Second, "How long to wait for in-flight queries to finish when shutting down before cancelling them")
 
 	// tracing
 	rootCmd.PersistentFlags().String("honeycomb-api-key", "", "If specified, configures opentelemetry libraries to submit traces to honeycomb")
@@ -11,36 +11,55 @@ require (
 	github.com/aws/aws-sdk-go-v2 v1.33.0
 	github.com/aws/aws-sdk-go-v2/config v1.29.1
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.54
+	github.com/aws/aws-sdk-go-v2/service/acm v1.30.13
+	github.com/aws/aws-sdk-go-v2/service/acmpca v1.37.13
 	github.com/aws/aws-sdk-go-v2/service/apigateway v1.28.7
+	github.com/aws/aws-sdk-go-v2/service/apigatewayv2 v1.24.13
 	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.51.7
 	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.44.5
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.43.9
+	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.45.7
 	github.com/aws/aws-sdk-go-v2/service/directconnect v1.30.7
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.39.5
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.201.0
+	github.com/aws/aws-sdk-go-v2/service/ecr v1.38.6
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.53.8
 	github.com/aws/aws-sdk-go-v2/service/efs v1.34.6
 	github.com/aws/aws-sdk-go-v2/service/eks v1.56.5
+	github.com/aws/aws-sdk-go-v2/service/elasticache v1.44.7
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing v1.28.12
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.43.7
+	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.36.6
+	github.com/aws/aws-sdk-go-v2/service/firehose v1.35.6
 	github.com/aws/aws-sdk-go-v2/service/iam v1.38.7
+	github.com/aws/aws-sdk-go-v2/service/kinesis v1.32.13
 	github.com/aws/aws-sdk-go-v2/service/kms v1.37.13
 	github.com/aws/aws-sdk-go-v2/service/lambda v1.69.7
 	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.44.10
 	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.32.6
+	github.com/aws/aws-sdk-go-v2/service/opensearch v1.45.6
+	github.com/aws/aws-sdk-go-v2/service/pipes v1.18.9
 	github.com/aws/aws-sdk-go-v2/service/rds v1.93.7
+	github.com/aws/aws-sdk-go-v2/service/redshift v1.53.7
+	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.25.4
 	github.com/aws/aws-sdk-go-v2/service/route53 v1.48.2
 	github.com/aws/aws-sdk-go-v2/service/s3 v1.74.0
+	github.com/aws/aws-sdk-go-v2/service/scheduler v1.12.12
+	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.34.12
+	github.com/aws/aws-sdk-go-v2/service/sfn v1.34.7
 	github.com/aws/aws-sdk-go-v2/service/sns v1.33.14
 	github.com/aws/aws-sdk-go-v2/service/sqs v1.37.9
 	github.com/aws/aws-sdk-go-v2/service/ssm v1.56.7
 	github.com/aws/aws-sdk-go-v2/service/sts v1.33.9
+	github.com/aws/aws-sdk-go-v2/service/waf v1.25.11
+	github.com/aws/aws-sdk-go-v2/service/wafv2 v1.55.10
 	github.com/aws/smithy-go v1.22.1
 	github.com/getsentry/sentry-go v0.31.1
 	github.com/micahhausler/aws-iam-policy v0.4.2
 	github.com/overmindtech/discovery v0.33.4
 	github.com/overmindtech/sdp-go v0.106.0
 	github.com/overmindtech/sdpcache v1.6.4
+	github.com/prometheus/client_golang v1.20.5
 	github.com/sirupsen/logrus v1.9.3
 	github.com/sourcegraph/conc v0.3.0
 	github.com/spf13/cobra v1.8.1
@@ -75,7 +94,9 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.18.9 // indirect
 	github.com/aws/aws-sdk-go-v2/service/sso v1.24.11 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.28.10 // indirect
+	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cenkalti/backoff/v4 v4.3.0
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/felixge/httpsnoop v1.0.4 // indirect
 	github.com/fsnotify/fsnotify v1.7.0 // indirect
 	github.com/go-jose/go-jose/v4 v4.0.4 // indirect
@@ -90,11 +111,15 @@ require (
 	github.com/klauspost/compress v1.17.11 // indirect
 	github.com/magiconair/properties v1.8.7 // indirect
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
+	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
 	github.com/nats-io/jwt/v2 v2.7.3 // indirect
 	github.com/nats-io/nats.go v1.38.0 // indirect
 	github.com/nats-io/nkeys v0.4.9 // indirect
 	github.com/nats-io/nuid v1.0.1 // indirect
 	github.com/pelletier/go-toml/v2 v2.2.2 // indirect
+	github.com/prometheus/client_model v0.6.1 // indirect
+	github.com/prometheus/common v0.55.0 // indirect
+	github.com/prometheus/procfs v0.15.1 // indirect
 	github.com/sagikazarmark/locafero v0.4.0 // indirect
 	github.com/sagikazarmark/slog-shim v0.1.0 // indirect
 	github.com/spf13/afero v1.11.0 // indirect
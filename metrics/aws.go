@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// throttleChecks Determines whether an error returned by an API attempt was
+// caused by AWS throttling us. These are the same checks the SDK uses when
+// deciding whether to back off
+var throttleChecks = retry.IsErrorThrottles(retry.DefaultThrottles)
+
+// lastSuccess The time of the last successful call to each AWS service, keyed
+// by service ID
+var lastSuccess sync.Map
+
+// LastSuccessfulCalls Returns the time of the last successful call to each AWS
+// service, keyed by service ID e.g. "EC2"
+func LastSuccessfulCalls() map[string]time.Time {
+	calls := make(map[string]time.Time)
+
+	lastSuccess.Range(func(key, value any) bool {
+		calls[key.(string)] = value.(time.Time)
+		return true
+	})
+
+	return calls
+}
+
+// awsAPIMetricsMiddleware Counts calls to the AWS API. This sits in the
+// initialize step so that it sees each operation exactly once, and reads the
+// results of every attempt that the retry middleware made from the metadata
+type awsAPIMetricsMiddleware struct{}
+
+func (m awsAPIMetricsMiddleware) ID() string {
+	return "OvermindAPIMetrics"
+}
+
+func (m awsAPIMetricsMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	labels := []string{
+		awsmiddleware.GetServiceID(ctx),
+		awsmiddleware.GetOperationName(ctx),
+		awsmiddleware.GetRegion(ctx),
+	}
+
+	awsAPICalls.WithLabelValues(labels...).Inc()
+
+	if err != nil {
+		awsAPIErrors.WithLabelValues(labels...).Inc()
+	} else {
+		lastSuccess.Store(labels[0], time.Now())
+	}
+
+	if results, ok := retry.GetAttemptResults(metadata); ok {
+		for i, result := range results.Results {
+			if i > 0 {
+				awsAPIRetries.WithLabelValues(labels...).Inc()
+			}
+
+			if result.Err != nil && throttleChecks.IsErrorThrottle(result.Err) == aws.TrueTernary {
+				awsAPIThrottles.WithLabelValues(labels...).Inc()
+			}
+		}
+	}
+
+	return out, metadata, err
+}
+
+// AddAWSMiddleware Adds middleware that records AWS API call, retry and
+// throttle metrics to an AWS SDK stack. This should be appended to the
+// `APIOptions` of an `aws.Config` so that it applies to all clients created
+// from that config
+func AddAWSMiddleware(stack *middleware.Stack) error {
+	// This needs to be added after the service metadata has been registered
+	// so that the service and operation names are available in the context
+	return stack.Initialize.Add(awsAPIMetricsMiddleware{}, middleware.After)
+}
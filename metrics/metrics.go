@@ -0,0 +1,140 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/overmindtech/sdp-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "aws_source"
+
+// registry is the registry that all of the source's metrics are registered
+// with. We use our own rather than the global default so that we have full
+// control over what is exposed on `/metrics`
+var registry = prometheus.NewRegistry()
+
+var (
+	adapterQueries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "adapter",
+		Name:      "queries_total",
+		Help:      "Number of queries executed by each adapter, by query method",
+	}, []string{"type", "scope", "method"})
+
+	adapterQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "adapter",
+		Name:      "query_duration_seconds",
+		Help:      "How long each adapter took to execute a query, by query method",
+		// LIST queries against large accounts can take minutes, so the
+		// default buckets are not wide enough
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"type", "scope", "method"})
+
+	adapterQueryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "adapter",
+		Name:      "query_errors_total",
+		Help:      "Number of errors returned by each adapter, by query method and sdp.QueryError type",
+	}, []string{"type", "scope", "method", "error_type"})
+
+	adapterCacheLookups = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "adapter",
+		Name:      "cache_lookups_total",
+		Help:      "Number of lookups against each adapter's cache, by result (hit or miss)",
+	}, []string{"type", "scope", "result"})
+
+	awsAPICalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "aws",
+		Name:      "api_calls_total",
+		Help:      "Number of AWS API operations called. Each operation is counted once however many times it was retried, retries are counted by api_retries_total",
+	}, []string{"service", "operation", "region"})
+
+	awsAPIErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "aws",
+		Name:      "api_errors_total",
+		Help:      "Number of AWS API operations that failed after all retries had been exhausted",
+	}, []string{"service", "operation", "region"})
+
+	awsAPIRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "aws",
+		Name:      "api_retries_total",
+		Help:      "Number of times the AWS SDK retried an API operation",
+	}, []string{"service", "operation", "region"})
+
+	awsAPIThrottles = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "aws",
+		Name:      "api_throttles_total",
+		Help:      "Number of API attempts that were throttled by AWS",
+	}, []string{"service", "operation", "region"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		adapterQueries,
+		adapterQueryDuration,
+		adapterQueryErrors,
+		adapterCacheLookups,
+		awsAPICalls,
+		awsAPIErrors,
+		awsAPIRetries,
+		awsAPIThrottles,
+	)
+}
+
+// Handler Returns an HTTP handler that serves all metrics in the Prometheus
+// exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+		Registry: registry,
+	})
+}
+
+// ObserveQuery Records that an adapter has finished executing a query, and how
+// long it took. Errors should be recorded separately using `ObserveQueryError`
+// since streaming queries can return many of them
+func ObserveQuery(itemType string, scope string, method sdp.QueryMethod, duration time.Duration) {
+	adapterQueries.WithLabelValues(itemType, scope, method.String()).Inc()
+	adapterQueryDuration.WithLabelValues(itemType, scope, method.String()).Observe(duration.Seconds())
+}
+
+// ObserveQueryError Records an error returned by an adapter. The error is
+// labelled with its `sdp.QueryError` type, errors of any other type are
+// counted as `OTHER`
+func ObserveQueryError(itemType string, scope string, method sdp.QueryMethod, err error) {
+	if err == nil {
+		return
+	}
+
+	errorType := sdp.QueryError_OTHER
+
+	var qErr *sdp.QueryError
+	if errors.As(err, &qErr) {
+		errorType = qErr.GetErrorType()
+	}
+
+	adapterQueryErrors.WithLabelValues(itemType, scope, method.String(), errorType.String()).Inc()
+}
+
+// ObserveCacheLookup Records the result of looking up a query in an adapter's
+// cache. The hit ratio can be calculated from the `result` label
+func ObserveCacheLookup(itemType string, scope string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+
+	adapterCacheLookups.WithLabelValues(itemType, scope, result).Inc()
+}
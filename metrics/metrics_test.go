@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/overmindtech/sdp-go"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestObserveQueryError(t *testing.T) {
+	t.Run("with a QueryError", func(t *testing.T) {
+		ObserveQueryError("test-type", "test-scope", sdp.QueryMethod_GET, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "not found",
+		})
+
+		count := testutil.ToFloat64(adapterQueryErrors.WithLabelValues("test-type", "test-scope", "GET", "NOTFOUND"))
+		if count != 1 {
+			t.Errorf("expected 1 NOTFOUND error, got %v", count)
+		}
+	})
+
+	t.Run("with a regular error", func(t *testing.T) {
+		ObserveQueryError("test-type", "test-scope", sdp.QueryMethod_LIST, errors.New("oops"))
+
+		count := testutil.ToFloat64(adapterQueryErrors.WithLabelValues("test-type", "test-scope", "LIST", "OTHER"))
+		if count != 1 {
+			t.Errorf("expected 1 OTHER error, got %v", count)
+		}
+	})
+
+	t.Run("with a nil error", func(t *testing.T) {
+		ObserveQueryError("test-type", "test-scope", sdp.QueryMethod_SEARCH, nil)
+
+		count := testutil.ToFloat64(adapterQueryErrors.WithLabelValues("test-type", "test-scope", "SEARCH", "OTHER"))
+		if count != 0 {
+			t.Errorf("expected no errors, got %v", count)
+		}
+	})
+}
+
+func TestHandler(t *testing.T) {
+	ObserveQuery("handler-type", "handler-scope", sdp.QueryMethod_GET, time.Second)
+	ObserveCacheLookup("handler-type", "handler-scope", true)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %v", rec.Code)
+	}
+
+	body := rec.Body.String()
+
+	for _, expected := range []string{
+		`aws_source_adapter_queries_total{method="GET",scope="handler-scope",type="handler-type"} 1`,
+		`aws_source_adapter_cache_lookups_total{result="hit",scope="handler-scope",type="handler-type"} 1`,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("expected metrics output to contain %v", expected)
+		}
+	}
+}
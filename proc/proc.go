@@ -10,28 +10,46 @@ import (
 	"sync/atomic"
 	"time"
 
+	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
+	awsacmpca "github.com/aws/aws-sdk-go-v2/service/acmpca"
 	awsapigateway "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	awsapigatewayv2 "github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	awsautoscaling "github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	awscloudfront "github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awscloudwatch "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	awscloudwatchlogs "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	awsdirectconnect "github.com/aws/aws-sdk-go-v2/service/directconnect"
 	awsdynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
 	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
 	awsefs "github.com/aws/aws-sdk-go-v2/service/efs"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awselasticache "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awselasticloadbalancing "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	awselasticloadbalancingv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awseventbridge "github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	awsfirehose "github.com/aws/aws-sdk-go-v2/service/firehose"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awskinesis "github.com/aws/aws-sdk-go-v2/service/kinesis"
 	awskms "github.com/aws/aws-sdk-go-v2/service/kms"
 	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
 	awsnetworkfirewall "github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awsnetworkmanager "github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	awsopensearch "github.com/aws/aws-sdk-go-v2/service/opensearch"
+	awspipes "github.com/aws/aws-sdk-go-v2/service/pipes"
 	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	awsredshift "github.com/aws/aws-sdk-go-v2/service/redshift"
+	awsredshiftserverless "github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
 	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	awsscheduler "github.com/aws/aws-sdk-go-v2/service/scheduler"
+	awssecretsmanager "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	awssfn "github.com/aws/aws-sdk-go-v2/service/sfn"
 	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
 	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awswaf "github.com/aws/aws-sdk-go-v2/service/waf"
+	awswafv2 "github.com/aws/aws-sdk-go-v2/service/wafv2"
 	"github.com/cenkalti/backoff/v4"
 	"github.com/sourcegraph/conc/pool"
 
@@ -41,6 +59,8 @@ import (
 	stscredsv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/overmindtech/aws-source/adapters"
+	"github.com/overmindtech/aws-source/metrics"
+	"github.com/overmindtech/aws-source/tracing"
 	"github.com/overmindtech/discovery"
 	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -187,6 +207,13 @@ func CreateAWSConfigs(awsAuthConfig AwsAuthConfig) ([]aws.Config, error) {
 			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		}
 
+		// Trace each AWS API operation, and record API call, retry and
+		// throttle metrics for every client created from this config
+		cfg.APIOptions = append(cfg.APIOptions,
+			tracing.AddAWSMiddleware,
+			metrics.AddAWSMiddleware,
+		)
+
 		configs = append(configs, cfg)
 	}
 
@@ -197,12 +224,14 @@ func CreateAWSConfigs(awsAuthConfig AwsAuthConfig) ([]aws.Config, error) {
 // engine, and an error if any. The context provided will be used for the rate
 // limit buckets and should not be cancelled until the source is shut down. AWS
 // configs should be provided for each region that is enabled
-func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig, maxRetries uint64, configs ...aws.Config) (*discovery.Engine, error) {
+func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig, maxRetries uint64, status *SourceStatus, configs ...aws.Config) (*discovery.Engine, error) {
 	e, err := discovery.NewEngine(ec)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing Engine: %w", err)
 	}
 
+	status.setEngine(e)
+
 	var startupErrorMutex sync.Mutex
 	startupError := errors.New("source is starting")
 	if ec.HeartbeatOptions != nil {
@@ -241,10 +270,17 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 			p := pool.New().WithContext(ctx)
 
 			for _, cfg := range configs {
-				p.Go(func(ctx context.Context) error {
+				p.Go(func(ctx context.Context) (err error) {
 					configCtx, configCancel := context.WithTimeout(ctx, 10*time.Second)
 					defer configCancel()
 
+					// Record the outcome of this attempt for the status endpoint
+					var credentialsHealthy bool
+					var adapterCount int
+					defer func() {
+						status.recordAttempt(cfg.Region, credentialsHealthy, adapterCount, err)
+					}()
+
 					log.WithFields(log.Fields{
 						"region": cfg.Region,
 					}).Info("Initializing AWS source")
@@ -261,275 +297,30 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 						return fmt.Errorf("error getting caller identity for region %v: %w", cfg.Region, err)
 					}
 
-					// Create shared clients for each API
-					autoscalingClient := awsautoscaling.NewFromConfig(cfg, func(o *awsautoscaling.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					cloudfrontClient := awscloudfront.NewFromConfig(cfg, func(o *awscloudfront.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					cloudwatchClient := awscloudwatch.NewFromConfig(cfg, func(o *awscloudwatch.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					directconnectClient := awsdirectconnect.NewFromConfig(cfg, func(o *awsdirectconnect.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					dynamodbClient := awsdynamodb.NewFromConfig(cfg, func(o *awsdynamodb.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					ec2Client := awsec2.NewFromConfig(cfg, func(o *awsec2.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					ecsClient := awsecs.NewFromConfig(cfg, func(o *awsecs.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					efsClient := awsefs.NewFromConfig(cfg, func(o *awsefs.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					eksClient := awseks.NewFromConfig(cfg, func(o *awseks.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					elbClient := awselasticloadbalancing.NewFromConfig(cfg, func(o *awselasticloadbalancing.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					elbv2Client := awselasticloadbalancingv2.NewFromConfig(cfg, func(o *awselasticloadbalancingv2.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					lambdaClient := awslambda.NewFromConfig(cfg, func(o *awslambda.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					networkfirewallClient := awsnetworkfirewall.NewFromConfig(cfg, func(o *awsnetworkfirewall.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					rdsClient := awsrds.NewFromConfig(cfg, func(o *awsrds.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					snsClient := awssns.NewFromConfig(cfg, func(o *awssns.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					sqsClient := awssqs.NewFromConfig(cfg, func(o *awssqs.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					route53Client := awsroute53.NewFromConfig(cfg, func(o *awsroute53.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					networkmanagerClient := awsnetworkmanager.NewFromConfig(cfg, func(o *awsnetworkmanager.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					iamClient := awsiam.NewFromConfig(cfg, func(o *awsiam.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-						// Increase this from the default of 3 since IAM as such low rate limits
-						o.RetryMaxAttempts = 5
-					})
-					kmsClient := awskms.NewFromConfig(cfg, func(o *awskms.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					apigatewayClient := awsapigateway.NewFromConfig(cfg, func(o *awsapigateway.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-					ssmClient := ssm.NewFromConfig(cfg, func(o *ssm.Options) {
-						o.RetryMode = aws.RetryModeAdaptive
-					})
-
-					configuredAdapters := []discovery.Adapter{
-						// EC2
-						adapters.NewEC2AddressAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2CapacityReservationFleetAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2CapacityReservationAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2EgressOnlyInternetGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2IamInstanceProfileAssociationAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2ImageAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InstanceEventWindowAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InstanceAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InstanceStatusAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InternetGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2KeyPairAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2LaunchTemplateAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2LaunchTemplateVersionAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2NatGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2NetworkAclAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2NetworkInterfacePermissionAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2NetworkInterfaceAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2PlacementGroupAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2ReservedInstanceAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2RouteTableAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2SecurityGroupRuleAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2SecurityGroupAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2SnapshotAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2SubnetAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VolumeAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VolumeStatusAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VpcEndpointAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VpcPeeringConnectionAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2VpcAdapter(ec2Client, *callerID.Account, cfg.Region),
-
-						// EFS (I'm assuming it shares its rate limit with EC2))
-						adapters.NewEFSAccessPointAdapter(efsClient, *callerID.Account, cfg.Region),
-						adapters.NewEFSBackupPolicyAdapter(efsClient, *callerID.Account, cfg.Region),
-						adapters.NewEFSFileSystemAdapter(efsClient, *callerID.Account, cfg.Region),
-						adapters.NewEFSMountTargetAdapter(efsClient, *callerID.Account, cfg.Region),
-						adapters.NewEFSReplicationConfigurationAdapter(efsClient, *callerID.Account, cfg.Region),
-
-						// EKS
-						adapters.NewEKSAddonAdapter(eksClient, *callerID.Account, cfg.Region),
-						adapters.NewEKSClusterAdapter(eksClient, *callerID.Account, cfg.Region),
-						adapters.NewEKSFargateProfileAdapter(eksClient, *callerID.Account, cfg.Region),
-						adapters.NewEKSNodegroupAdapter(eksClient, *callerID.Account, cfg.Region),
-
-						// Route 53
-						adapters.NewRoute53HealthCheckAdapter(route53Client, *callerID.Account, cfg.Region),
-						adapters.NewRoute53HostedZoneAdapter(route53Client, *callerID.Account, cfg.Region),
-						adapters.NewRoute53ResourceRecordSetAdapter(route53Client, *callerID.Account, cfg.Region),
-
-						// Cloudwatch
-						adapters.NewCloudwatchAlarmAdapter(cloudwatchClient, *callerID.Account, cfg.Region),
-
-						// Lambda
-						adapters.NewLambdaFunctionAdapter(lambdaClient, *callerID.Account, cfg.Region),
-						adapters.NewLambdaLayerAdapter(lambdaClient, *callerID.Account, cfg.Region),
-						adapters.NewLambdaLayerVersionAdapter(lambdaClient, *callerID.Account, cfg.Region),
-
-						// ECS
-						adapters.NewECSCapacityProviderAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSClusterAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSContainerInstanceAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSServiceAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSTaskDefinitionAdapter(ecsClient, *callerID.Account, cfg.Region),
-						adapters.NewECSTaskAdapter(ecsClient, *callerID.Account, cfg.Region),
-
-						// DynamoDB
-						adapters.NewDynamoDBBackupAdapter(dynamodbClient, *callerID.Account, cfg.Region),
-						adapters.NewDynamoDBTableAdapter(dynamodbClient, *callerID.Account, cfg.Region),
-
-						// RDS
-						adapters.NewRDSDBClusterParameterGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSDBClusterAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSDBInstanceAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSDBParameterGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSDBSubnetGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
-						adapters.NewRDSOptionGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
-
-						// Autoscaling
-						adapters.NewAutoScalingGroupAdapter(autoscalingClient, *callerID.Account, cfg.Region),
-
-						// ELB
-						adapters.NewELBInstanceHealthAdapter(elbClient, *callerID.Account, cfg.Region),
-						adapters.NewELBLoadBalancerAdapter(elbClient, *callerID.Account, cfg.Region),
-
-						// ELBv2
-						adapters.NewELBv2ListenerAdapter(elbv2Client, *callerID.Account, cfg.Region),
-						adapters.NewELBv2LoadBalancerAdapter(elbv2Client, *callerID.Account, cfg.Region),
-						adapters.NewELBv2RuleAdapter(elbv2Client, *callerID.Account, cfg.Region),
-						adapters.NewELBv2TargetGroupAdapter(elbv2Client, *callerID.Account, cfg.Region),
-						adapters.NewELBv2TargetHealthAdapter(elbv2Client, *callerID.Account, cfg.Region),
-
-						// Network Firewall
-						adapters.NewNetworkFirewallFirewallAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkFirewallFirewallPolicyAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkFirewallRuleGroupAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkFirewallTLSInspectionConfigurationAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
-
-						// Direct Connect
-						adapters.NewDirectConnectGatewayAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectGatewayAssociationAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectGatewayAssociationProposalAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectConnectionAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectGatewayAttachmentAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectVirtualInterfaceAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectVirtualGatewayAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectCustomerMetadataAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectLagAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectLocationAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectHostedConnectionAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectInterconnectAdapter(directconnectClient, *callerID.Account, cfg.Region),
-						adapters.NewDirectConnectRouterConfigurationAdapter(directconnectClient, *callerID.Account, cfg.Region),
-
-						// Network Manager
-						adapters.NewNetworkManagerConnectAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerConnectPeerAssociationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerConnectPeerAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerCoreNetworkPolicyAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerCoreNetworkAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerNetworkResourceRelationshipsAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerSiteToSiteVpnAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerTransitGatewayConnectPeerAssociationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerTransitGatewayPeeringAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerTransitGatewayRegistrationAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerTransitGatewayRouteTableAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-						adapters.NewNetworkManagerVPCAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
-
-						// SQS
-						adapters.NewSQSQueueAdapter(sqsClient, *callerID.Account, cfg.Region),
-
-						// SNS
-						adapters.NewSNSSubscriptionAdapter(snsClient, *callerID.Account, cfg.Region),
-						adapters.NewSNSTopicAdapter(snsClient, *callerID.Account, cfg.Region),
-						adapters.NewSNSPlatformApplicationAdapter(snsClient, *callerID.Account, cfg.Region),
-						adapters.NewSNSEndpointAdapter(snsClient, *callerID.Account, cfg.Region),
-						adapters.NewSNSDataProtectionPolicyAdapter(snsClient, *callerID.Account, cfg.Region),
-
-						// KMS
-						adapters.NewKMSKeyAdapter(kmsClient, *callerID.Account, cfg.Region),
-						adapters.NewKMSCustomKeyStoreAdapter(kmsClient, *callerID.Account, cfg.Region),
-						adapters.NewKMSAliasAdapter(kmsClient, *callerID.Account, cfg.Region),
-						adapters.NewKMSGrantAdapter(kmsClient, *callerID.Account, cfg.Region),
-						adapters.NewKMSKeyPolicyAdapter(kmsClient, *callerID.Account, cfg.Region),
-
-						// ApiGateway
-						adapters.NewAPIGatewayRestApiAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayResourceAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayDomainNameAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayMethodAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-						adapters.NewAPIGatewayMethodResponseAdapter(apigatewayClient, *callerID.Account, cfg.Region),
-
-						// SSM
-						adapters.NewSSMParameterAdapter(ssmClient, *callerID.Account, cfg.Region),
-					}
+					credentialsHealthy = true
+
+					configuredAdapters := newRegionalAdapters(cfg, *callerID.Account)
 
 					err = e.AddAdapters(configuredAdapters...)
 					if err != nil {
 						return err
 					}
 
+					adapterCount = len(configuredAdapters)
+
 					// Add "global" sources (those that aren't tied to a region, like
 					// cloudfront). but only do this once for the first region. For
 					// these APIs it doesn't matter which region we call them from, we
 					// get global results
 					if globalDone.CompareAndSwap(false, true) {
-						err = e.AddAdapters(
-							// Cloudfront
-							adapters.NewCloudfrontCachePolicyAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontContinuousDeploymentPolicyAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontDistributionAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontCloudfrontFunctionAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontKeyGroupAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontOriginAccessControlAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontOriginRequestPolicyAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontResponseHeadersPolicyAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontRealtimeLogConfigsAdapter(cloudfrontClient, *callerID.Account),
-							adapters.NewCloudfrontStreamingDistributionAdapter(cloudfrontClient, *callerID.Account),
-
-							// S3
-							adapters.NewS3Adapter(cfg, *callerID.Account),
-
-							// Networkmanager
-							adapters.NewNetworkManagerGlobalNetworkAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerSiteAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerLinkAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerDeviceAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerLinkAssociationAdapter(networkmanagerClient, *callerID.Account),
-							adapters.NewNetworkManagerConnectionAdapter(networkmanagerClient, *callerID.Account),
-
-							// IAM
-							adapters.NewIAMPolicyAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMGroupAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMInstanceProfileAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMRoleAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMUserAdapter(iamClient, *callerID.Account),
-						)
+						globalAdapters := newGlobalAdapters(cfg, *callerID.Account)
+
+						err = e.AddAdapters(globalAdapters...)
 						if err != nil {
 							return err
 						}
+
+						status.recordGlobalAdapters(len(globalAdapters))
 					}
 					return nil
 				})
@@ -548,9 +339,438 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 				log.WithError(err).Debug("Error initializing sources")
 			} else {
 				log.Debug("Sources initialized")
+				status.setInitialised()
 				// If there is no error then return the engine
 				return e, nil
 			}
 		}
 	}
 }
+
+// newRegionalAdapters Creates the adapters for a single region, using shared
+// clients for each API
+func newRegionalAdapters(cfg aws.Config, accountID string) []discovery.Adapter {
+	// Create shared clients for each API
+	autoscalingClient := awsautoscaling.NewFromConfig(cfg, func(o *awsautoscaling.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	cloudwatchClient := awscloudwatch.NewFromConfig(cfg, func(o *awscloudwatch.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	cloudwatchlogsClient := awscloudwatchlogs.NewFromConfig(cfg, func(o *awscloudwatchlogs.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	directconnectClient := awsdirectconnect.NewFromConfig(cfg, func(o *awsdirectconnect.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	dynamodbClient := awsdynamodb.NewFromConfig(cfg, func(o *awsdynamodb.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ec2Client := awsec2.NewFromConfig(cfg, func(o *awsec2.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ecrClient := awsecr.NewFromConfig(cfg, func(o *awsecr.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ecsClient := awsecs.NewFromConfig(cfg, func(o *awsecs.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	efsClient := awsefs.NewFromConfig(cfg, func(o *awsefs.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	eksClient := awseks.NewFromConfig(cfg, func(o *awseks.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	elasticacheClient := awselasticache.NewFromConfig(cfg, func(o *awselasticache.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	elbClient := awselasticloadbalancing.NewFromConfig(cfg, func(o *awselasticloadbalancing.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	elbv2Client := awselasticloadbalancingv2.NewFromConfig(cfg, func(o *awselasticloadbalancingv2.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	lambdaClient := awslambda.NewFromConfig(cfg, func(o *awslambda.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	networkfirewallClient := awsnetworkfirewall.NewFromConfig(cfg, func(o *awsnetworkfirewall.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	rdsClient := awsrds.NewFromConfig(cfg, func(o *awsrds.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	redshiftClient := awsredshift.NewFromConfig(cfg, func(o *awsredshift.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	redshiftserverlessClient := awsredshiftserverless.NewFromConfig(cfg, func(o *awsredshiftserverless.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	secretsmanagerClient := awssecretsmanager.NewFromConfig(cfg, func(o *awssecretsmanager.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	sfnClient := awssfn.NewFromConfig(cfg, func(o *awssfn.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	snsClient := awssns.NewFromConfig(cfg, func(o *awssns.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	sqsClient := awssqs.NewFromConfig(cfg, func(o *awssqs.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	route53Client := awsroute53.NewFromConfig(cfg, func(o *awsroute53.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	networkmanagerClient := awsnetworkmanager.NewFromConfig(cfg, func(o *awsnetworkmanager.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	opensearchClient := awsopensearch.NewFromConfig(cfg, func(o *awsopensearch.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	kmsClient := awskms.NewFromConfig(cfg, func(o *awskms.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	acmClient := awsacm.NewFromConfig(cfg, func(o *awsacm.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	acmpcaClient := awsacmpca.NewFromConfig(cfg, func(o *awsacmpca.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	eventbridgeClient := awseventbridge.NewFromConfig(cfg, func(o *awseventbridge.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	pipesClient := awspipes.NewFromConfig(cfg, func(o *awspipes.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	schedulerClient := awsscheduler.NewFromConfig(cfg, func(o *awsscheduler.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	kinesisClient := awskinesis.NewFromConfig(cfg, func(o *awskinesis.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	firehoseClient := awsfirehose.NewFromConfig(cfg, func(o *awsfirehose.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	wafv2Client := awswafv2.NewFromConfig(cfg, func(o *awswafv2.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	apigatewayClient := awsapigateway.NewFromConfig(cfg, func(o *awsapigateway.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	apigatewayv2Client := awsapigatewayv2.NewFromConfig(cfg, func(o *awsapigatewayv2.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	ssmClient := ssm.NewFromConfig(cfg, func(o *ssm.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+
+	return []discovery.Adapter{
+		// EC2
+		adapters.NewEC2AddressAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2CapacityReservationFleetAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2CapacityReservationAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2CustomerGatewayAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2DhcpOptionsAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2EgressOnlyInternetGatewayAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2FleetAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2FlowLogAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2HostAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2IamInstanceProfileAssociationAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2ImageAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2InstanceEventWindowAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2InstanceAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2InstanceStatusAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2InternetGatewayAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2KeyPairAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2LaunchTemplateAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2LaunchTemplateVersionAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2ManagedPrefixListAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2NatGatewayAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2NetworkAclAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2NetworkInterfacePermissionAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2NetworkInterfaceAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2PlacementGroupAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2ReservedInstanceAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2RouteTableAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2SecurityGroupRuleAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2SecurityGroupAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2SnapshotAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2SpotFleetRequestAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2SpotInstanceRequestAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2SubnetAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2TransitGatewayAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2TransitGatewayAttachmentAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2TransitGatewayConnectPeerAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2TransitGatewayRouteAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2TransitGatewayRouteTableAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VolumeAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VolumeStatusAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VpcEndpointAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VpcEndpointConnectionAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VpcEndpointServiceAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VpcPeeringConnectionAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VpcAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VpnConnectionAdapter(ec2Client, accountID, cfg.Region),
+		adapters.NewEC2VpnGatewayAdapter(ec2Client, accountID, cfg.Region),
+
+		// EFS (I'm assuming it shares its rate limit with EC2))
+		adapters.NewEFSAccessPointAdapter(efsClient, accountID, cfg.Region),
+		adapters.NewEFSBackupPolicyAdapter(efsClient, accountID, cfg.Region),
+		adapters.NewEFSFileSystemAdapter(efsClient, accountID, cfg.Region),
+		adapters.NewEFSMountTargetAdapter(efsClient, accountID, cfg.Region),
+		adapters.NewEFSReplicationConfigurationAdapter(efsClient, accountID, cfg.Region),
+
+		// EKS
+		adapters.NewEKSAddonAdapter(eksClient, accountID, cfg.Region),
+		adapters.NewEKSClusterAdapter(eksClient, accountID, cfg.Region),
+		adapters.NewEKSFargateProfileAdapter(eksClient, accountID, cfg.Region),
+		adapters.NewEKSNodegroupAdapter(eksClient, accountID, cfg.Region),
+
+		// Route 53
+		adapters.NewRoute53HealthCheckAdapter(route53Client, accountID, cfg.Region),
+		adapters.NewRoute53HostedZoneAdapter(route53Client, accountID, cfg.Region),
+		adapters.NewRoute53ResourceRecordSetAdapter(route53Client, accountID, cfg.Region),
+
+		// Cloudwatch
+		adapters.NewCloudwatchAlarmAdapter(cloudwatchClient, accountID, cfg.Region),
+
+		// CloudWatch Logs
+		adapters.NewLogsLogGroupAdapter(cloudwatchlogsClient, accountID, cfg.Region),
+		adapters.NewLogsLogStreamAdapter(cloudwatchlogsClient, accountID, cfg.Region),
+		adapters.NewLogsMetricFilterAdapter(cloudwatchlogsClient, accountID, cfg.Region),
+		adapters.NewLogsResourcePolicyAdapter(cloudwatchlogsClient, accountID, cfg.Region),
+		adapters.NewLogsSubscriptionFilterAdapter(cloudwatchlogsClient, accountID, cfg.Region),
+
+		// Lambda
+		adapters.NewLambdaFunctionAdapter(lambdaClient, accountID, cfg.Region),
+		adapters.NewLambdaLayerAdapter(lambdaClient, accountID, cfg.Region),
+		adapters.NewLambdaLayerVersionAdapter(lambdaClient, accountID, cfg.Region),
+
+		// ECS
+		adapters.NewECSCapacityProviderAdapter(ecsClient, accountID, cfg.Region),
+		adapters.NewECSClusterAdapter(ecsClient, accountID, cfg.Region),
+		adapters.NewECSContainerInstanceAdapter(ecsClient, accountID, cfg.Region),
+		adapters.NewECSServiceAdapter(ecsClient, accountID, cfg.Region),
+		adapters.NewECSTaskDefinitionAdapter(ecsClient, accountID, cfg.Region),
+		adapters.NewECSTaskAdapter(ecsClient, accountID, cfg.Region),
+
+		// ECR
+		adapters.NewECRRepositoryAdapter(ecrClient, accountID, cfg.Region),
+		adapters.NewECRImageAdapter(ecrClient, accountID, cfg.Region),
+		adapters.NewECRReplicationConfigurationAdapter(ecrClient, accountID, cfg.Region),
+
+		// DynamoDB
+		adapters.NewDynamoDBBackupAdapter(dynamodbClient, accountID, cfg.Region),
+		adapters.NewDynamoDBTableAdapter(dynamodbClient, accountID, cfg.Region),
+
+		// RDS
+		adapters.NewRDSDBClusterParameterGroupAdapter(rdsClient, accountID, cfg.Region),
+		adapters.NewRDSDBClusterAdapter(rdsClient, accountID, cfg.Region),
+		adapters.NewRDSDBInstanceAdapter(rdsClient, accountID, cfg.Region),
+		adapters.NewRDSDBParameterGroupAdapter(rdsClient, accountID, cfg.Region),
+		adapters.NewRDSDBSubnetGroupAdapter(rdsClient, accountID, cfg.Region),
+		adapters.NewRDSOptionGroupAdapter(rdsClient, accountID, cfg.Region),
+
+		// Redshift
+		adapters.NewRedshiftClusterAdapter(redshiftClient, accountID, cfg.Region),
+		adapters.NewRedshiftClusterSubnetGroupAdapter(redshiftClient, accountID, cfg.Region),
+		adapters.NewRedshiftClusterParameterGroupAdapter(redshiftClient, accountID, cfg.Region),
+
+		// Redshift Serverless
+		adapters.NewRedshiftServerlessNamespaceAdapter(redshiftserverlessClient, accountID, cfg.Region),
+		adapters.NewRedshiftServerlessWorkgroupAdapter(redshiftserverlessClient, accountID, cfg.Region),
+
+		// ElastiCache
+		adapters.NewElastiCacheCacheClusterAdapter(elasticacheClient, accountID, cfg.Region),
+		adapters.NewElastiCacheReplicationGroupAdapter(elasticacheClient, accountID, cfg.Region),
+		adapters.NewElastiCacheServerlessCacheAdapter(elasticacheClient, accountID, cfg.Region),
+		adapters.NewElastiCacheSubnetGroupAdapter(elasticacheClient, accountID, cfg.Region),
+		adapters.NewElastiCacheParameterGroupAdapter(elasticacheClient, accountID, cfg.Region),
+		adapters.NewElastiCacheUserGroupAdapter(elasticacheClient, accountID, cfg.Region),
+
+		// Autoscaling
+		adapters.NewAutoScalingGroupAdapter(autoscalingClient, accountID, cfg.Region),
+		adapters.NewAutoScalingLaunchConfigurationAdapter(autoscalingClient, accountID, cfg.Region),
+		adapters.NewAutoScalingLifecycleHookAdapter(autoscalingClient, accountID, cfg.Region),
+		adapters.NewAutoScalingPolicyAdapter(autoscalingClient, accountID, cfg.Region),
+		adapters.NewAutoScalingScheduledActionAdapter(autoscalingClient, accountID, cfg.Region),
+		adapters.NewAutoScalingWarmPoolAdapter(autoscalingClient, accountID, cfg.Region),
+
+		// ELB
+		adapters.NewELBInstanceHealthAdapter(elbClient, accountID, cfg.Region),
+		adapters.NewELBLoadBalancerAdapter(elbClient, accountID, cfg.Region),
+
+		// ELBv2
+		adapters.NewELBv2ListenerAdapter(elbv2Client, accountID, cfg.Region),
+		adapters.NewELBv2LoadBalancerAdapter(elbv2Client, accountID, cfg.Region),
+		adapters.NewELBv2RuleAdapter(elbv2Client, accountID, cfg.Region),
+		adapters.NewELBv2TargetGroupAdapter(elbv2Client, accountID, cfg.Region),
+		adapters.NewELBv2TargetHealthAdapter(elbv2Client, accountID, cfg.Region),
+
+		// Network Firewall
+		adapters.NewNetworkFirewallFirewallAdapter(networkfirewallClient, accountID, cfg.Region),
+		adapters.NewNetworkFirewallFirewallPolicyAdapter(networkfirewallClient, accountID, cfg.Region),
+		adapters.NewNetworkFirewallRuleGroupAdapter(networkfirewallClient, accountID, cfg.Region),
+		adapters.NewNetworkFirewallTLSInspectionConfigurationAdapter(networkfirewallClient, accountID, cfg.Region),
+
+		// Direct Connect
+		adapters.NewDirectConnectGatewayAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectGatewayAssociationAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectGatewayAssociationProposalAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectConnectionAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectGatewayAttachmentAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectVirtualInterfaceAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectVirtualGatewayAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectCustomerMetadataAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectLagAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectLocationAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectHostedConnectionAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectInterconnectAdapter(directconnectClient, accountID, cfg.Region),
+		adapters.NewDirectConnectRouterConfigurationAdapter(directconnectClient, accountID, cfg.Region),
+
+		// Network Manager
+		adapters.NewNetworkManagerConnectAttachmentAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerConnectPeerAssociationAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerConnectPeerAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerCoreNetworkPolicyAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerCoreNetworkAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerNetworkResourceRelationshipsAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerSiteToSiteVpnAttachmentAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerTransitGatewayConnectPeerAssociationAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerTransitGatewayPeeringAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerTransitGatewayRegistrationAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerTransitGatewayRouteTableAttachmentAdapter(networkmanagerClient, accountID, cfg.Region),
+		adapters.NewNetworkManagerVPCAttachmentAdapter(networkmanagerClient, accountID, cfg.Region),
+
+		// SQS
+		adapters.NewSQSQueueAdapter(sqsClient, accountID, cfg.Region),
+
+		// SNS
+		adapters.NewSNSSubscriptionAdapter(snsClient, accountID, cfg.Region),
+		adapters.NewSNSTopicAdapter(snsClient, accountID, cfg.Region),
+		adapters.NewSNSPlatformApplicationAdapter(snsClient, accountID, cfg.Region),
+		adapters.NewSNSEndpointAdapter(snsClient, accountID, cfg.Region),
+		adapters.NewSNSDataProtectionPolicyAdapter(snsClient, accountID, cfg.Region),
+
+		// EventBridge
+		adapters.NewEventsEventBusAdapter(eventbridgeClient, accountID, cfg.Region),
+		adapters.NewEventsRuleAdapter(eventbridgeClient, accountID, cfg.Region),
+		adapters.NewEventsArchiveAdapter(eventbridgeClient, accountID, cfg.Region),
+		adapters.NewPipesPipeAdapter(pipesClient, accountID, cfg.Region),
+		adapters.NewSchedulerScheduleAdapter(schedulerClient, accountID, cfg.Region),
+
+		// Kinesis
+		adapters.NewKinesisStreamAdapter(kinesisClient, accountID, cfg.Region),
+		adapters.NewKinesisStreamConsumerAdapter(kinesisClient, accountID, cfg.Region),
+		adapters.NewFirehoseDeliveryStreamAdapter(firehoseClient, accountID, cfg.Region),
+
+		// WAF
+		adapters.NewWAFv2WebACLAdapter(wafv2Client, accountID, cfg.Region),
+		adapters.NewWAFv2RuleGroupAdapter(wafv2Client, accountID, cfg.Region),
+		adapters.NewWAFv2IPSetAdapter(wafv2Client, accountID, cfg.Region),
+		adapters.NewWAFv2RegexPatternSetAdapter(wafv2Client, accountID, cfg.Region),
+
+		// ACM
+		adapters.NewACMCertificateAdapter(acmClient, accountID, cfg.Region),
+		adapters.NewACMPCACertificateAuthorityAdapter(acmpcaClient, accountID, cfg.Region),
+
+		// Secrets Manager
+		adapters.NewSecretsManagerSecretAdapter(secretsmanagerClient, accountID, cfg.Region),
+
+		// KMS
+		adapters.NewKMSKeyAdapter(kmsClient, accountID, cfg.Region),
+		adapters.NewKMSCustomKeyStoreAdapter(kmsClient, accountID, cfg.Region),
+		adapters.NewKMSAliasAdapter(kmsClient, accountID, cfg.Region),
+		adapters.NewKMSGrantAdapter(kmsClient, accountID, cfg.Region),
+		adapters.NewKMSKeyPolicyAdapter(kmsClient, accountID, cfg.Region),
+
+		// ApiGateway
+		adapters.NewAPIGatewayRestApiAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayResourceAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayDomainNameAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayBasePathMappingAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayMethodAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayMethodResponseAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayIntegrationAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayStageAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayDeploymentAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayAuthorizerAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayRequestValidatorAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayVpcLinkAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayUsagePlanAdapter(apigatewayClient, accountID, cfg.Region),
+		adapters.NewAPIGatewayApiKeyAdapter(apigatewayClient, accountID, cfg.Region),
+
+		// ApiGateway V2
+		adapters.NewAPIGatewayV2ApiAdapter(apigatewayv2Client, accountID, cfg.Region),
+		adapters.NewAPIGatewayV2RouteAdapter(apigatewayv2Client, accountID, cfg.Region),
+		adapters.NewAPIGatewayV2IntegrationAdapter(apigatewayv2Client, accountID, cfg.Region),
+		adapters.NewAPIGatewayV2AuthorizerAdapter(apigatewayv2Client, accountID, cfg.Region),
+		adapters.NewAPIGatewayV2StageAdapter(apigatewayv2Client, accountID, cfg.Region),
+		adapters.NewAPIGatewayV2DomainNameAdapter(apigatewayv2Client, accountID, cfg.Region),
+		adapters.NewAPIGatewayV2ApiMappingAdapter(apigatewayv2Client, accountID, cfg.Region),
+		adapters.NewAPIGatewayV2VpcLinkAdapter(apigatewayv2Client, accountID, cfg.Region),
+
+		// OpenSearch
+		adapters.NewOpenSearchDomainAdapter(opensearchClient, accountID, cfg.Region),
+
+		// Step Functions
+		adapters.NewSFNStateMachineAdapter(sfnClient, accountID, cfg.Region),
+		adapters.NewSFNActivityAdapter(sfnClient, accountID, cfg.Region),
+
+		// SSM
+		adapters.NewSSMParameterAdapter(ssmClient, accountID, cfg.Region),
+	}
+}
+
+// newGlobalAdapters Creates the adapters for APIs that aren't tied to a region,
+// like cloudfront. These should only be added once
+func newGlobalAdapters(cfg aws.Config, accountID string) []discovery.Adapter {
+	cloudfrontClient := awscloudfront.NewFromConfig(cfg, func(o *awscloudfront.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	iamClient := awsiam.NewFromConfig(cfg, func(o *awsiam.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+		// Increase this from the default of 3 since IAM as such low rate limits
+		o.RetryMaxAttempts = 5
+	})
+	networkmanagerClient := awsnetworkmanager.NewFromConfig(cfg, func(o *awsnetworkmanager.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+	wafClient := awswaf.NewFromConfig(cfg, func(o *awswaf.Options) {
+		o.RetryMode = aws.RetryModeAdaptive
+	})
+
+	return []discovery.Adapter{
+		// Cloudfront
+		adapters.NewCloudfrontCachePolicyAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontContinuousDeploymentPolicyAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontDistributionAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontCloudfrontFunctionAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontKeyGroupAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontOriginAccessControlAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontOriginRequestPolicyAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontResponseHeadersPolicyAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontRealtimeLogConfigsAdapter(cloudfrontClient, accountID),
+		adapters.NewCloudfrontStreamingDistributionAdapter(cloudfrontClient, accountID),
+
+		// S3
+		adapters.NewS3Adapter(cfg, accountID),
+
+		// Networkmanager
+		adapters.NewNetworkManagerGlobalNetworkAdapter(networkmanagerClient, accountID),
+		adapters.NewNetworkManagerSiteAdapter(networkmanagerClient, accountID),
+		adapters.NewNetworkManagerLinkAdapter(networkmanagerClient, accountID),
+		adapters.NewNetworkManagerDeviceAdapter(networkmanagerClient, accountID),
+		adapters.NewNetworkManagerLinkAssociationAdapter(networkmanagerClient, accountID),
+		adapters.NewNetworkManagerConnectionAdapter(networkmanagerClient, accountID),
+
+		// IAM
+		adapters.NewIAMPolicyAdapter(iamClient, accountID),
+		adapters.NewIAMGroupAdapter(iamClient, accountID),
+		adapters.NewIAMInstanceProfileAdapter(iamClient, accountID),
+		adapters.NewIAMRoleAdapter(iamClient, accountID),
+		adapters.NewIAMUserAdapter(iamClient, accountID),
+
+		// WAF Classic
+		adapters.NewWAFWebACLAdapter(wafClient, accountID),
+	}
+}
@@ -0,0 +1,197 @@
+package proc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/overmindtech/discovery"
+	"github.com/overmindtech/sdp-go"
+
+	"github.com/overmindtech/aws-source/adapters"
+)
+
+// iamPrefixes Maps the SDK's service IDs to the prefix that IAM uses for the
+// service's actions, where they are different
+var iamPrefixes = map[string]string{
+	"ACM PCA":                   "acm-pca",
+	"API Gateway":               "apigateway",
+	"ApiGatewayV2":              "apigateway",
+	"Auto Scaling":              "autoscaling",
+	"CloudWatch Logs":           "logs",
+	"Direct Connect":            "directconnect",
+	"EFS":                       "elasticfilesystem",
+	"Elastic Load Balancing":    "elasticloadbalancing",
+	"Elastic Load Balancing v2": "elasticloadbalancing",
+	"EventBridge":               "events",
+	"Network Firewall":          "network-firewall",
+	"OpenSearch":                "es",
+	"Redshift Serverless":       "redshift-serverless",
+	"Route 53":                  "route53",
+	"Secrets Manager":           "secretsmanager",
+	"SFN":                       "states",
+}
+
+// s3Actions Maps S3 operations to their IAM actions where they are named
+// differently
+var s3Actions = map[string]string{
+	"GetBucketAnalyticsConfiguration":          "GetAnalyticsConfiguration",
+	"GetBucketCors":                            "GetBucketCORS",
+	"GetBucketEncryption":                      "GetEncryptionConfiguration",
+	"GetBucketIntelligentTieringConfiguration": "GetIntelligentTieringConfiguration",
+	"GetBucketInventoryConfiguration":          "GetInventoryConfiguration",
+	"GetBucketLifecycleConfiguration":          "GetLifecycleConfiguration",
+	"GetBucketMetricsConfiguration":            "GetMetricsConfiguration",
+	"GetBucketNotificationConfiguration":       "GetBucketNotification",
+	"GetBucketReplication":                     "GetReplicationConfiguration",
+	"ListBuckets":                              "ListAllMyBuckets",
+}
+
+// iamAction Returns the IAM action that is needed to call an operation
+func iamAction(serviceID string, operation string) string {
+	prefix, ok := iamPrefixes[serviceID]
+	if !ok {
+		prefix = strings.ToLower(serviceID)
+	}
+
+	switch prefix {
+	case "apigateway":
+		// API Gateway uses HTTP methods rather than operations, and the
+		// adapters only read
+		return "apigateway:GET"
+	case "s3":
+		if action, ok := s3Actions[operation]; ok {
+			operation = action
+		}
+	}
+
+	return prefix + ":" + operation
+}
+
+// operationRecorder Records the IAM actions for every operation that is
+// called, then fails it as if the action had been denied. Failing the call
+// means that no requests leave the test, and that the adapters are exercised
+// the same way as they would be with a policy that is missing actions
+type operationRecorder struct {
+	mutex   sync.Mutex
+	actions map[string]bool
+}
+
+func (r *operationRecorder) Reset() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	actions := make([]string, 0, len(r.actions))
+	for action := range r.actions {
+		actions = append(actions, action)
+	}
+	slices.Sort(actions)
+
+	r.actions = make(map[string]bool)
+
+	return actions
+}
+
+func (r *operationRecorder) AddMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("RecordOperation", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		action := iamAction(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx))
+
+		r.mutex.Lock()
+		r.actions[action] = true
+		r.mutex.Unlock()
+
+		return middleware.InitializeOutput{}, middleware.Metadata{}, &smithy.GenericAPIError{
+			Code:    "AccessDeniedException",
+			Message: fmt.Sprintf("not authorized to perform: %v", action),
+		}
+	}), middleware.After)
+}
+
+// query Runs every query method that the adapter supports
+func query(ctx context.Context, adapter discovery.Adapter, scope string) {
+	// Global scopes are just the account ID
+	accountID, region, _ := strings.Cut(scope, ".")
+
+	arn := fmt.Sprintf("arn:aws:test:%v:%v:test/test", region, accountID)
+
+	stream := discovery.NewQueryResultStream(
+		func(item *sdp.Item) {},
+		func(err error) {},
+	)
+	defer stream.Close()
+
+	_, _ = adapter.Get(ctx, scope, "test", true)
+
+	if streaming, ok := adapter.(discovery.StreamingAdapter); ok {
+		streaming.ListStream(ctx, scope, true, stream)
+		streaming.SearchStream(ctx, scope, arn, true, stream)
+		return
+	}
+
+	if listable, ok := adapter.(discovery.ListableAdapter); ok {
+		_, _ = listable.List(ctx, scope, true)
+	}
+
+	if searchable, ok := adapter.(discovery.SearchableAdapter); ok {
+		_, _ = searchable.Search(ctx, scope, arn, true)
+	}
+}
+
+// TestAdapterIAMActions Makes sure that every operation an adapter calls is
+// covered by the IAM actions it has registered, so that the generated policy
+// doesn't cause AccessDenied errors. Since every call fails, this only covers
+// the operations that are called before the first failure, and those that are
+// called regardless of errors
+func TestAdapterIAMActions(t *testing.T) {
+	recorder := &operationRecorder{
+		actions: make(map[string]bool),
+	}
+
+	cfg := aws.Config{
+		Region:      "eu-west-2",
+		Credentials: credentials.NewStaticCredentialsProvider("AKIDEXAMPLE", "SECRET", ""),
+		Retryer: func() aws.Retryer {
+			return aws.NopRetryer{}
+		},
+		APIOptions: []func(*middleware.Stack) error{
+			recorder.AddMiddleware,
+		},
+	}
+
+	all := append(newRegionalAdapters(cfg, "123456789012"), newGlobalAdapters(cfg, "123456789012")...)
+
+	for _, adapter := range all {
+		t.Run(adapter.Type(), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			for _, scope := range adapter.Scopes() {
+				func() {
+					defer func() {
+						if r := recover(); r != nil {
+							t.Errorf("adapter panicked: %v", r)
+						}
+					}()
+
+					query(ctx, adapter, scope)
+				}()
+			}
+
+			registered := adapters.IAMActions.ForType(adapter.Type())
+
+			for _, action := range recorder.Reset() {
+				if !slices.Contains(registered, action) {
+					t.Errorf("%v called %v which is not in its registered IAM actions %v", adapter.Type(), action, registered)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package proc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/aws-source/metrics"
+	"github.com/overmindtech/discovery"
+)
+
+// InitState The initialisation state of the adapters for a single AWS config
+type InitState string
+
+const (
+	// InitStatePending Initialisation has not been attempted yet
+	InitStatePending InitState = "pending"
+	// InitStateFailed The last attempt at initialisation failed, it will be
+	// retried until the backoff gives up
+	InitStateFailed InitState = "failed"
+	// InitStateReady The adapters for this config have been added to the
+	// engine
+	InitStateReady InitState = "ready"
+)
+
+// CredentialsState Whether the credentials for an AWS config could be used
+type CredentialsState string
+
+const (
+	// CredentialsStateUnknown Initialisation has not been attempted yet
+	CredentialsStateUnknown CredentialsState = "unknown"
+	// CredentialsStateHealthy The credentials were used successfully to look
+	// up the account
+	CredentialsStateHealthy CredentialsState = "healthy"
+	// CredentialsStateUnhealthy The credentials could not be used to look up
+	// the account, the reason is logged
+	CredentialsStateUnhealthy CredentialsState = "unhealthy"
+)
+
+// RegionStatus The initialisation status of a single AWS config. There is one
+// of these per region, and each region belongs to exactly one account. Since
+// this is served without authentication it doesn't include account IDs or
+// errors, which are logged instead
+type RegionStatus struct {
+	Region      string     `json:"region"`
+	State       InitState  `json:"state"`
+	Attempts    int        `json:"attempts"`
+	LastAttempt *time.Time `json:"lastAttempt,omitempty"`
+	// The number of regional adapters that were added to the engine
+	Adapters int `json:"adapters"`
+	// The state of the credentials for the account, as of the last attempt
+	// at initialisation
+	Credentials CredentialsState `json:"credentials"`
+}
+
+// StatusReport A point-in-time report of the state of the source, suitable for
+// serialising as JSON
+type StatusReport struct {
+	// Whether the source is ready to serve queries
+	Ready bool `json:"ready"`
+	// The reason that the source is not ready, if any
+	Error         string `json:"error,omitempty"`
+	NATSConnected bool   `json:"natsConnected"`
+	// The number of queries that adapters are currently running
+	InFlightQueries int `json:"inFlightQueries"`
+	// The total number of adapters, regional and global
+	Adapters int `json:"adapters"`
+	// The number of global adapters, these are only added once regardless of
+	// the number of regions
+	GlobalAdapters int            `json:"globalAdapters"`
+	Regions        []RegionStatus `json:"regions"`
+	// The last time that each AWS service (by service ID) responded to a
+	// call successfully
+	LastSuccessfulAPICalls map[string]time.Time `json:"lastSuccessfulAPICalls"`
+}
+
+// SourceStatus Tracks the initialisation of the source so that readiness can
+// be reported separately from liveness. This is safe for concurrent use, and
+// the zero value is not usable, use `NewSourceStatus` instead
+type SourceStatus struct {
+	mu          sync.RWMutex
+	regions     []*RegionStatus
+	engine      *discovery.Engine
+	initialised bool
+	draining    bool
+	globals     int
+}
+
+// NewSourceStatus Creates a status tracker for the given AWS configs. These
+// should be the same configs that are passed to `InitializeAwsSourceEngine`
+func NewSourceStatus(configs ...aws.Config) *SourceStatus {
+	s := &SourceStatus{
+		regions: make([]*RegionStatus, len(configs)),
+	}
+
+	for i, cfg := range configs {
+		s.regions[i] = &RegionStatus{
+			Region:      cfg.Region,
+			State:       InitStatePending,
+			Credentials: CredentialsStateUnknown,
+		}
+	}
+
+	return s
+}
+
+// setEngine Records the engine once it has been created so that its NATS
+// connection can be checked
+func (s *SourceStatus) setEngine(e *discovery.Engine) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.engine = e
+}
+
+// setInitialised Marks the source as fully initialised
+func (s *SourceStatus) setInitialised() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.initialised = true
+}
+
+// SetDraining Marks the source as shutting down, after which it will no longer
+// report itself as ready
+func (s *SourceStatus) SetDraining() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.draining = true
+}
+
+// recordAttempt Records the outcome of an attempt to initialise the adapters
+// for a region. `credentialsHealthy` is whether the credentials could be used
+// to look up the account
+func (s *SourceStatus) recordAttempt(region string, credentialsHealthy bool, adapters int, err error) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.regions {
+		if r.Region != region {
+			continue
+		}
+
+		now := time.Now()
+		r.Attempts++
+		r.LastAttempt = &now
+
+		if credentialsHealthy {
+			r.Credentials = CredentialsStateHealthy
+		} else {
+			r.Credentials = CredentialsStateUnhealthy
+		}
+
+		if err != nil {
+			r.State = InitStateFailed
+		} else {
+			r.State = InitStateReady
+			r.Adapters = adapters
+		}
+	}
+}
+
+// recordGlobalAdapters Records the number of global adapters that were added
+// to the engine
+func (s *SourceStatus) recordGlobalAdapters(n int) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.globals = n
+}
+
+// CheckReady Returns an error if the source is not ready to serve queries. The
+// source is ready once all adapters have been initialised and the engine is
+// healthy
+func (s *SourceStatus) CheckReady(ctx context.Context) error {
+	s.mu.RLock()
+	e := s.engine
+	initialised := s.initialised
+	draining := s.draining
+	s.mu.RUnlock()
+
+	if draining {
+		return errors.New("source is shutting down")
+	}
+
+	if e == nil || !initialised {
+		return errors.New("source is initialising")
+	}
+
+	return e.HealthCheck(ctx)
+}
+
+// Report Returns the current status of the source. This doesn't call AWS, the
+// state of the credentials is recorded when the adapters are initialised
+func (s *SourceStatus) Report(ctx context.Context) StatusReport {
+	report := StatusReport{
+		InFlightQueries:        adapterhelpers.InFlightQueries(),
+		LastSuccessfulAPICalls: metrics.LastSuccessfulCalls(),
+	}
+
+	if err := s.CheckReady(ctx); err != nil {
+		report.Error = err.Error()
+	} else {
+		report.Ready = true
+	}
+
+	s.mu.RLock()
+	e := s.engine
+	report.GlobalAdapters = s.globals
+	report.Adapters = s.globals
+	report.Regions = make([]RegionStatus, len(s.regions))
+	for i, r := range s.regions {
+		report.Regions[i] = *r
+	}
+	s.mu.RUnlock()
+
+	if e != nil {
+		report.NATSConnected = e.IsNATSConnected()
+	}
+
+	for _, r := range report.Regions {
+		report.Adapters += r.Adapters
+	}
+
+	return report
+}
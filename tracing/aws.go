@@ -0,0 +1,122 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	awsInstrumentationName    = "github.com/overmindtech/aws-source/tracing"
+	awsInstrumentationVersion = "0.0.1"
+)
+
+// throttleChecks Determines whether an error returned by an API attempt was
+// caused by AWS throttling us
+var throttleChecks = retry.IsErrorThrottles(retry.DefaultThrottles)
+
+type pageKey struct{}
+
+// WithPage Returns a context that marks any AWS API calls made with it as
+// fetching the given page (starting at 1) of a paginated query. The API spans
+// are already children of the query's span, so this only records the page
+// number
+func WithPage(ctx context.Context, page int) context.Context {
+	return context.WithValue(ctx, pageKey{}, page)
+}
+
+// awsTracingMiddleware Creates a span for each AWS API operation. This sits in
+// the initialize step so that there is one span for the operation as a whole,
+// with the individual attempts that the retry middleware made recorded as
+// events on it. The raw HTTP requests will appear as children of this span
+type awsTracingMiddleware struct{}
+
+func (m awsTracingMiddleware) ID() string {
+	return "OvermindAPITracing"
+}
+
+func (m awsTracingMiddleware) HandleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	service := awsmiddleware.GetServiceID(ctx)
+	operation := awsmiddleware.GetOperationName(ctx)
+
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCSystemKey.String("aws-api"),
+			semconv.RPCService(service),
+			semconv.RPCMethod(operation),
+			semconv.CloudRegion(awsmiddleware.GetRegion(ctx)),
+		),
+	}
+
+	if page, ok := ctx.Value(pageKey{}).(int); ok {
+		opts = append(opts, trace.WithAttributes(attribute.Int("ovm.aws.page", page)))
+	}
+
+	tracer := otel.GetTracerProvider().Tracer(
+		awsInstrumentationName,
+		trace.WithInstrumentationVersion(awsInstrumentationVersion),
+		trace.WithSchemaURL(semconv.SchemaURL),
+	)
+
+	ctx, span := tracer.Start(ctx, fmt.Sprintf("%v.%v", service, operation), opts...)
+	defer span.End()
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	if requestID, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+		span.SetAttributes(semconv.AWSRequestID(requestID))
+	}
+
+	var throttled bool
+	if results, ok := retry.GetAttemptResults(metadata); ok {
+		for i, result := range results.Results {
+			attemptThrottled := result.Err != nil && throttleChecks.IsErrorThrottle(result.Err) == aws.TrueTernary
+			throttled = throttled || attemptThrottled
+
+			attrs := []attribute.KeyValue{
+				attribute.Int("ovm.aws.attempt", i+1),
+				attribute.Bool("ovm.aws.throttled", attemptThrottled),
+				attribute.Bool("ovm.aws.retried", result.Retried),
+			}
+
+			if result.Err != nil {
+				attrs = append(attrs, attribute.String("error", result.Err.Error()))
+			}
+
+			span.AddEvent("attempt", trace.WithAttributes(attrs...))
+		}
+
+		span.SetAttributes(
+			attribute.Int("ovm.aws.attempts", len(results.Results)),
+			attribute.Int("ovm.aws.retries", max(len(results.Results)-1, 0)),
+		)
+	}
+
+	span.SetAttributes(attribute.Bool("ovm.aws.throttled", throttled))
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return out, metadata, err
+}
+
+// AddAWSMiddleware Adds middleware that creates a span for each AWS API
+// operation. This should be appended to the `APIOptions` of an `aws.Config`
+// so that it applies to all clients created from that config
+func AddAWSMiddleware(stack *middleware.Stack) error {
+	// This needs to be added after the service metadata has been registered
+	// so that the service and operation names are available in the context
+	return stack.Initialize.Add(awsTracingMiddleware{}, middleware.After)
+}
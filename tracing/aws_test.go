@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAWSMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(original)
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+
+	err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "EC2",
+		OperationName: "DescribeInstances",
+		Region:        "eu-west-2",
+	}, middleware.Before)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = AddAWSMiddleware(stack)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+		return nil, middleware.Metadata{}, nil
+	}), stack)
+
+	ctx, querySpan := provider.Tracer("test").Start(context.Background(), "ListStream")
+
+	_, _, err = handler.Handle(WithPage(ctx, 2), struct{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	querySpan.End()
+
+	var apiSpan sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "EC2.DescribeInstances" {
+			apiSpan = span
+		}
+	}
+
+	if apiSpan == nil {
+		t.Fatal("could not find span for EC2.DescribeInstances")
+	}
+
+	if apiSpan.Parent().SpanID() != querySpan.SpanContext().SpanID() {
+		t.Error("expected API span to be a child of the query span")
+	}
+
+	// The parent is already the query span, so linking to it would be
+	// redundant
+	if links := apiSpan.Links(); len(links) != 0 {
+		t.Errorf("expected no links, got %v", len(links))
+	}
+
+	expected := map[attribute.Key]attribute.Value{
+		"rpc.service":       attribute.StringValue("EC2"),
+		"rpc.method":        attribute.StringValue("DescribeInstances"),
+		"cloud.region":      attribute.StringValue("eu-west-2"),
+		"ovm.aws.page":      attribute.IntValue(2),
+		"ovm.aws.throttled": attribute.BoolValue(false),
+	}
+
+	for _, attr := range apiSpan.Attributes() {
+		if want, ok := expected[attr.Key]; ok {
+			if attr.Value != want {
+				t.Errorf("expected %v to be %v, got %v", attr.Key, want.Emit(), attr.Value.Emit())
+			}
+			delete(expected, attr.Key)
+		}
+	}
+
+	for key := range expected {
+		t.Errorf("missing attribute %v", key)
+	}
+}